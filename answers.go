@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"gopkg.in/yaml.v3"                       // Parses YAML answers files
+)
+
+// AnswerableModel is implemented by models that can be answered without user interaction, e.g. to make programs
+// scriptable in CI.
+type AnswerableModel interface {
+	// Key returns the key identifying the prompt, which is its ID if set and its label otherwise.
+	Key() string
+	// SetAnswer applies the given answer as if the user had entered it.
+	SetAnswer(v any) error
+	// Answer returns the current answer in a form accepted by SetAnswer.
+	Answer() any
+}
+
+// WithAnswers presets answers keyed by prompt ID or label. Prompts with a preset answer are resolved instantly
+// without rendering anything. Answers given here take precedence over answers from the environment, which are read
+// as a JSON object from the variable UI_ANSWERS or from the file named by UI_ANSWERS_FILE (see LoadAnswers).
+func WithAnswers(answers map[string]any) tea.ProgramOption {
+	return option(func(s *settings) {
+		if s.answers == nil {
+			s.answers = make(map[string]any)
+		}
+		for k, v := range answers {
+			s.answers[k] = v
+		}
+	})
+}
+
+// WithEchoAnswers enables or disables printing a line with the answer of each prompt resolved from a preset answer,
+// so the output shows which values were assumed. Answers of prompts implementing SecretModel are masked.
+func WithEchoAnswers(echo bool) tea.ProgramOption {
+	return option(func(s *settings) {
+		s.echoAnswers = echo
+	})
+}
+
+// LoadAnswers reads preset answers keyed by prompt ID or label from a YAML (.yaml or .yml) or JSON file, e.g. for
+// repeatable installs. The answers are passed to WithAnswers; prompts without an answer are run interactively:
+//
+//	answers, err := ui.LoadAnswers("install.yaml")
+//	if err != nil {
+//		return err
+//	}
+//	opts := []tea.ProgramOption{ui.WithAnswers(answers), ui.WithEchoAnswers(true)}
+func LoadAnswers(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	answers := make(map[string]any)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &answers)
+	default:
+		err = json.Unmarshal(data, &answers)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return answers, nil
+}
+
+// envAnswers returns the answers configured via the environment.
+func envAnswers() (map[string]any, error) {
+	answers := make(map[string]any)
+	if path := os.Getenv("UI_ANSWERS_FILE"); path != "" {
+		var err error
+		if answers, err = LoadAnswers(path); err != nil {
+			return nil, err
+		}
+	}
+	if s := os.Getenv("UI_ANSWERS"); s != "" {
+		if err := json.Unmarshal([]byte(s), &answers); err != nil {
+			return nil, fmt.Errorf("UI_ANSWERS: %w", err)
+		}
+	}
+	return answers, nil
+}
+
+// lookupAnswer returns the answer for the given key. Keys are matched exactly first, then ignoring surrounding
+// whitespace and a trailing colon or question mark, so that a label like "Name: " can be answered using "Name".
+func lookupAnswer(answers map[string]any, key string) (any, bool) {
+	if v, ok := answers[key]; ok {
+		return v, true
+	}
+	key = normalizeKey(key)
+	for k, v := range answers {
+		if normalizeKey(k) == key {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// normalizeKey strips surrounding whitespace and trailing punctuation from a prompt key.
+func normalizeKey(key string) string {
+	return strings.TrimSpace(strings.TrimRight(strings.TrimSpace(key), ":?"))
+}
+
+// answerSummary returns a line showing the answer of the prompt, masking secret answers.
+func answerSummary(am AnswerableModel) string {
+	return fmt.Sprintf("%s: %v", normalizeKey(am.Key()), displayAnswer(am, am.Answer()))
+}
+
+// displayAnswer returns the answer of the model for display, masking it if the model implements SecretModel.
+func displayAnswer(m any, v any) any {
+	if sm, ok := m.(SecretModel); ok && sm.Secret() {
+		return "********"
+	}
+	return v
+}
@@ -0,0 +1,25 @@
+package ui
+
+import "github.com/charmbracelet/lipgloss"
+
+// The colors of the theme set by SetTheme, initially those of ThemeDefault. They adapt to the background of the
+// terminal, which is detected when first rendering, unless overridden using ForceDark or ForceLight. SetTheme
+// replaces them with the colors of a theme for code reading them directly; components build their styles from
+// CurrentTheme instead.
+var (
+	AccentColor  = lipgloss.AdaptiveColor{Light: "57", Dark: "63"}           // Purple
+	LabelColor   = lipgloss.AdaptiveColor{Light: "#B8860B", Dark: "#FFD700"} // Gold
+	TextColor    = lipgloss.AdaptiveColor{Light: "#1A1A1A", Dark: "#FFFFFF"} // Black on light, white on dark
+	SuccessColor = lipgloss.AdaptiveColor{Light: "#008700", Dark: "#00FF00"} // Green
+	FailureColor = lipgloss.AdaptiveColor{Light: "#D03000", Dark: "#FF4500"} // OrangeRed
+)
+
+// ForceDark makes all components use the colors for dark backgrounds, regardless of the detected background.
+func ForceDark() {
+	lipgloss.SetHasDarkBackground(true)
+}
+
+// ForceLight makes all components use the colors for light backgrounds, regardless of the detected background.
+func ForceLight() {
+	lipgloss.SetHasDarkBackground(false)
+}
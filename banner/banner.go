@@ -0,0 +1,225 @@
+package banner
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/lucasb-eyer/go-colorful"     // Blends colors for gradients
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/styles"
+)
+
+// Model represents the header of an application: a title, optionally in a large block font or colored with a
+// gradient, followed by a subtitle and a version, optionally surrounded by a box. It is not interactive; use View or
+// Print to render it, or embed it in another model.
+type Model struct {
+	title        string            // title is the name of the application.
+	subtitle     string            // subtitle is shown below the title, e.g. a short description.
+	version      string            // version is shown below the title, after the subtitle.
+	large        bool              // large determines if the title is rendered in the large block font.
+	gradient     [2]string         // gradient holds the colors of the title gradient, empty for none.
+	boxed        bool              // boxed determines if the banner is surrounded by a box.
+	align        lipgloss.Position // align is the horizontal alignment of the lines.
+	fixedWidth   int               // fixedWidth is the width the banner is aligned in, 0 to use the window width.
+	width        int               // width is the window width, updated from window size messages.
+	styles       Styles            // styles holds the styles of the model.
+	customStyles bool              // customStyles is set if styles were set explicitly, which a theme switch keeps
+}
+
+// New creates and returns a new Model with the given title, configured by the given options.
+func New(title string, opts ...Option) *Model {
+	m := &Model{
+		title:  title,
+		align:  lipgloss.Left,
+		styles: DefaultStyles(),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithSubtitle sets the subtitle and returns a new Model with the updated subtitle.
+func (m *Model) WithSubtitle(subtitle string) *Model {
+	return m.With(Subtitle(subtitle))
+}
+
+// WithVersion sets the version and returns a new Model with the updated version.
+func (m *Model) WithVersion(version string) *Model {
+	return m.With(Version(version))
+}
+
+// WithLarge sets whether the title is rendered in the large block font and returns a new Model with the updated flag.
+func (m *Model) WithLarge(large bool) *Model {
+	return m.With(Large(large))
+}
+
+// WithGradient colors the title with a gradient between the given colors and returns a new Model with the updated
+// colors.
+func (m *Model) WithGradient(colorA, colorB string) *Model {
+	return m.With(Gradient(colorA, colorB))
+}
+
+// WithBoxed sets whether the banner is surrounded by a box and returns a new Model with the updated flag.
+func (m *Model) WithBoxed(boxed bool) *Model {
+	return m.With(Boxed(boxed))
+}
+
+// WithAlign sets the horizontal alignment of the lines and returns a new Model with the updated alignment.
+func (m *Model) WithAlign(align lipgloss.Position) *Model {
+	return m.With(Align(align))
+}
+
+// WithWidth sets the width the banner is aligned in and returns a new Model with the updated width.
+func (m *Model) WithWidth(width int) *Model {
+	return m.With(Width(width))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Init initializes the Model.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles window size messages, which determine the width the banner is aligned in unless a width is set.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	if msg, ok := msg.(tea.WindowSizeMsg); ok {
+		m.width = msg.Width
+	}
+	return m, nil
+}
+
+// titleLines renders the title, colored with the gradient if one is set.
+func (m *Model) titleLines() []string {
+	lines := []string{m.title}
+	if m.large {
+		lines = large(m.title, ui.Glyphs().Block)
+	}
+
+	from, err1 := colorful.Hex(m.gradient[0])
+	to, err2 := colorful.Hex(m.gradient[1])
+	if err1 != nil || err2 != nil {
+		for i, line := range lines {
+			lines[i] = m.styles.Title.Render(line)
+		}
+		return lines
+	}
+
+	width := 0
+	for _, line := range lines {
+		width = max(width, styles.Width(line))
+	}
+	// The color depends on the column, so that the gradient runs straight across all lines of a large title.
+	colors := make([]lipgloss.Style, width)
+	for x := range colors {
+		t := 0.0
+		if width > 1 {
+			t = float64(x) / float64(width-1)
+		}
+		colors[x] = m.styles.Title.Foreground(lipgloss.Color(from.BlendLuv(to, t).Clamped().Hex()))
+	}
+	for i, line := range lines {
+		var b strings.Builder
+		x := 0
+		styles.Graphemes(line, func(_ int, g string, w int) bool {
+			if strings.TrimSpace(g) == "" {
+				b.WriteString(g)
+			} else {
+				b.WriteString(colors[min(x, width-1)].Render(g))
+			}
+			x += w
+			return true
+		})
+		lines[i] = b.String()
+	}
+	return lines
+}
+
+// View renders the banner.
+func (m *Model) View() string {
+	lines := m.titleLines()
+
+	var info []string
+	if m.subtitle != "" {
+		info = append(info, m.styles.Subtitle.Render(m.subtitle))
+	}
+	if m.version != "" {
+		version := m.version
+		if r := []rune(version)[0]; r >= '0' && r <= '9' {
+			version = "v" + version
+		}
+		info = append(info, m.styles.Version.Render(version))
+	}
+	if len(info) > 0 {
+		if m.large {
+			lines = append(lines, "")
+		}
+		lines = append(lines, strings.Join(info, "  "))
+	}
+
+	width := m.fixedWidth
+	if width <= 0 {
+		width = m.width
+	}
+	box := m.styles.Box.Border(ui.Glyphs().Border).Padding(0, 2)
+	if m.boxed {
+		width = styles.InnerWidth(box, width)
+	}
+	block := lipgloss.JoinVertical(m.align, lines...)
+	if width > lipgloss.Width(block) {
+		block = lipgloss.PlaceHorizontal(width, m.align, block)
+	}
+	if m.boxed {
+		block = box.Render(block)
+	}
+	return block
+}
+
+// String renders the banner like View.
+func (m *Model) String() string {
+	return m.View()
+}
+
+// Fprint writes the banner followed by a newline to w.
+func (m *Model) Fprint(w io.Writer) error {
+	_, err := fmt.Fprintln(w, m.View())
+	return err
+}
+
+// Print writes the banner followed by a newline to standard output.
+func (m *Model) Print() error {
+	return m.Fprint(os.Stdout)
+}
+
+// Showcase demonstrates the Model component in its plain, large, gradient and boxed variants.
+func Showcase() {
+	fmt.Println("=== Banner Showcase ===")
+	fmt.Println()
+
+	_ = New("deployctl").WithSubtitle("Deploy services to your clusters").WithVersion("1.4.2").Print()
+	fmt.Println()
+
+	_ = New("go-ui").WithLarge(true).WithGradient("#5A56E0", "#EE6FF8").
+		WithSubtitle("Terminal UI components for Go").WithVersion("0.9.0").Print()
+	fmt.Println()
+
+	_ = New("Backup").WithLarge(true).WithBoxed(true).WithAlign(lipgloss.Center).
+		WithSubtitle("Nightly snapshot tool").Print()
+}
@@ -0,0 +1,87 @@
+package banner
+
+import "strings"
+
+// fontHeight is the number of lines of a large character.
+const fontHeight = 5
+
+// font maps characters to their large form, five rows of pixels each. A '#' is a filled pixel. Lowercase letters are
+// rendered as uppercase, and unknown characters as '?'.
+var font = map[rune][fontHeight]string{
+	'A':  {".###.", "#...#", "#####", "#...#", "#...#"},
+	'B':  {"####.", "#...#", "####.", "#...#", "####."},
+	'C':  {".####", "#....", "#....", "#....", ".####"},
+	'D':  {"####.", "#...#", "#...#", "#...#", "####."},
+	'E':  {"#####", "#....", "####.", "#....", "#####"},
+	'F':  {"#####", "#....", "####.", "#....", "#...."},
+	'G':  {".####", "#....", "#..##", "#...#", ".####"},
+	'H':  {"#...#", "#...#", "#####", "#...#", "#...#"},
+	'I':  {"###", ".#.", ".#.", ".#.", "###"},
+	'J':  {"..###", "...#.", "...#.", "#..#.", ".##.."},
+	'K':  {"#...#", "#..#.", "###..", "#..#.", "#...#"},
+	'L':  {"#....", "#....", "#....", "#....", "#####"},
+	'M':  {"#...#", "##.##", "#.#.#", "#...#", "#...#"},
+	'N':  {"#...#", "##..#", "#.#.#", "#..##", "#...#"},
+	'O':  {".###.", "#...#", "#...#", "#...#", ".###."},
+	'P':  {"####.", "#...#", "####.", "#....", "#...."},
+	'Q':  {".###.", "#...#", "#.#.#", "#..#.", ".##.#"},
+	'R':  {"####.", "#...#", "####.", "#..#.", "#...#"},
+	'S':  {".####", "#....", ".###.", "....#", "####."},
+	'T':  {"#####", "..#..", "..#..", "..#..", "..#.."},
+	'U':  {"#...#", "#...#", "#...#", "#...#", ".###."},
+	'V':  {"#...#", "#...#", "#...#", ".#.#.", "..#.."},
+	'W':  {"#...#", "#...#", "#.#.#", "##.##", "#...#"},
+	'X':  {"#...#", ".#.#.", "..#..", ".#.#.", "#...#"},
+	'Y':  {"#...#", ".#.#.", "..#..", "..#..", "..#.."},
+	'Z':  {"#####", "...#.", "..#..", ".#...", "#####"},
+	'0':  {".###.", "#..##", "#.#.#", "##..#", ".###."},
+	'1':  {".#.", "##.", ".#.", ".#.", "###"},
+	'2':  {"####.", "....#", ".###.", "#....", "#####"},
+	'3':  {"####.", "....#", ".###.", "....#", "####."},
+	'4':  {"#...#", "#...#", "#####", "....#", "....#"},
+	'5':  {"#####", "#....", "####.", "....#", "####."},
+	'6':  {".###.", "#....", "####.", "#...#", ".###."},
+	'7':  {"#####", "....#", "...#.", "..#..", "..#.."},
+	'8':  {".###.", "#...#", ".###.", "#...#", ".###."},
+	'9':  {".###.", "#...#", ".####", "....#", ".###."},
+	' ':  {"...", "...", "...", "...", "..."},
+	'-':  {"....", "....", "####", "....", "...."},
+	'_':  {"....", "....", "....", "....", "####"},
+	'.':  {".", ".", ".", ".", "#"},
+	',':  {"..", "..", "..", ".#", "#."},
+	':':  {".", "#", ".", "#", "."},
+	'!':  {"#", "#", "#", ".", "#"},
+	'?':  {"###.", "...#", ".##.", "....", ".#.."},
+	'/':  {"....#", "...#.", "..#..", ".#...", "#...."},
+	'+':  {".....", "..#..", "#####", "..#..", "....."},
+	'\'': {"#", "#", ".", ".", "."},
+}
+
+// large renders the text in the large font, using the given string for filled pixels. The lines are padded to the
+// same width.
+func large(text, pixel string) []string {
+	var rows [fontHeight]strings.Builder
+	for i, r := range strings.ToUpper(text) {
+		glyph, ok := font[r]
+		if !ok {
+			glyph = font['?']
+		}
+		for y, row := range glyph {
+			if i > 0 {
+				rows[y].WriteByte(' ')
+			}
+			for _, c := range row {
+				if c == '#' {
+					rows[y].WriteString(pixel)
+				} else {
+					rows[y].WriteByte(' ')
+				}
+			}
+		}
+	}
+	lines := make([]string, fontHeight)
+	for y := range rows {
+		lines[y] = rows[y].String()
+	}
+	return lines
+}
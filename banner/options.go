@@ -0,0 +1,78 @@
+package banner
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Subtitle sets the subtitle shown below the title, e.g. a short description of the application.
+func Subtitle(subtitle string) Option {
+	return func(m *Model) {
+		m.subtitle = subtitle
+	}
+}
+
+// Version sets the version shown after the subtitle. Versions starting with a digit are prefixed with "v".
+func Version(version string) Option {
+	return func(m *Model) {
+		m.version = version
+	}
+}
+
+// Large sets whether the title is rendered in a large block font, five lines high. The font covers letters, digits
+// and common punctuation; other characters are rendered as '?'.
+func Large(large bool) Option {
+	return func(m *Model) {
+		m.large = large
+	}
+}
+
+// Gradient colors the title with a gradient between the given colors, e.g. "#5A56E0" and "#EE6FF8". Invalid colors
+// disable the gradient.
+func Gradient(colorA, colorB string) Option {
+	return func(m *Model) {
+		m.gradient = [2]string{colorA, colorB}
+	}
+}
+
+// Boxed sets whether the banner is surrounded by a box.
+func Boxed(boxed bool) Option {
+	return func(m *Model) {
+		m.boxed = boxed
+	}
+}
+
+// Align sets the horizontal alignment of the lines, e.g. lipgloss.Center. The default is lipgloss.Left.
+func Align(align lipgloss.Position) Option {
+	return func(m *Model) {
+		m.align = align
+	}
+}
+
+// Width sets the width the banner is aligned in, including the box. By default, the window width is used if the
+// banner is embedded, and the width of the widest line otherwise.
+func Width(width int) Option {
+	return func(m *Model) {
+		m.fixedWidth = width
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
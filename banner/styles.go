@@ -0,0 +1,37 @@
+package banner
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model. The foreground of the title is replaced by the colors of the gradient if
+// one is set.
+type Styles struct {
+	Title    lipgloss.Style // Title is the style of the title.
+	Subtitle lipgloss.Style // Subtitle is the style of the subtitle.
+	Version  lipgloss.Style // Version is the style of the version.
+	Box      lipgloss.Style // Box is the style of the box; its border is set from the glyphs of the ui package.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Title:    lipgloss.NewStyle().Foreground(t.Accent).Bold(true),
+		Subtitle: lipgloss.NewStyle().Foreground(t.Text),
+		Version:  lipgloss.NewStyle().Faint(true),
+		Box:      lipgloss.NewStyle().BorderForeground(t.Accent),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
@@ -0,0 +1,465 @@
+package breadcrumb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/answer"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/styles"
+)
+
+var _ ui.Prompt[int] = (*Model)(nil)
+
+var (
+	firstKey = key.NewBinding(key.WithKeys("home", "g"), key.WithHelp("home", "first"))
+	lastKey  = key.NewBinding(key.WithKeys("end", "G"), key.WithHelp("end", "last"))
+)
+
+// defaultWidth is the width available to the path until the window size is known.
+const defaultWidth = 80
+
+// ActivateMsg is emitted in embedded mode when the user activates a segment of the path, usually to navigate back to
+// it. The path is not changed; call Truncate to drop the segments after the activated one.
+type ActivateMsg struct {
+	Model *Model // Model is the model the segment was activated in.
+	Index int    // Index is the index of the activated segment.
+	Label string // Label is the label of the activated segment.
+}
+
+// Model represents a breadcrumb bar showing a navigable path, e.g. "Home › Clusters › prod › Nodes". The cursor moves
+// between the segments, and activating a segment emits an ActivateMsg in embedded mode or finishes the model otherwise.
+// If the path does not fit, segments in the middle are replaced by an ellipsis, keeping the first segment, the cursor
+// and as many of the last segments as possible.
+type Model struct {
+	label          string              // label is shown before the path.
+	path           []string            // path holds the labels of the segments, from the root.
+	cursor         int                 // cursor is the index of the focused segment.
+	activated      int                 // activated is the index of the activated segment, -1 if none.
+	fixedWidth     int                 // fixedWidth is the width available to the bar, 0 to use the window width.
+	width          int                 // width is the window width, updated from window size messages.
+	cancelable     bool                // cancelable determines if the selection can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	id             string              // id identifies the prompt, e.g. for preset answers
+	embedded       bool                // embedded determines if an ActivateMsg is emitted instead of quitting the program
+	focused        bool                // focused determines if the model handles key messages
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error               // err is shown below the path, e.g. why the previous answer was rejected
+
+	canceled bool // canceled indicates whether the selection was canceled
+	quit     bool // quit indicates whether the selection was quit
+}
+
+// New creates and returns a new Model showing the given path, configured by the given options. The cursor starts on
+// the last segment.
+func New(path []string, opts ...Option) *Model {
+	m := &Model{
+		path:       append([]string(nil), path...),
+		activated:  -1,
+		cancelable: true,
+		quitable:   true,
+		focused:    true,
+		keymap:     ui.DefaultKeyMap(),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	m.cursor = max(0, len(m.path)-1)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithLabel sets the label shown before the path and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithPath sets the path and returns a new Model with the updated path. The cursor is moved to the last segment.
+func (m *Model) WithPath(path ...string) *Model {
+	return m.With(Path(path...))
+}
+
+// WithWidth sets the width available to the bar and returns a new Model with the updated width.
+func (m *Model) WithWidth(width int) *Model {
+	return m.With(Width(width))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, an ActivateMsg is emitted instead of quitting the program when the user activates a segment.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Path returns a copy of the path.
+func (m *Model) Path() []string {
+	return append([]string(nil), m.path...)
+}
+
+// SetPath replaces the path and moves the cursor to the last segment.
+func (m *Model) SetPath(path ...string) {
+	m.path = append(m.path[:0:0], path...)
+	m.cursor = max(0, len(m.path)-1)
+}
+
+// Push appends a segment to the path, e.g. when drilling down, and moves the cursor to it.
+func (m *Model) Push(label string) {
+	m.path = append(m.path[:len(m.path):len(m.path)], label)
+	m.cursor = len(m.path) - 1
+}
+
+// Pop removes the last segment from the path and returns it, or returns an empty string if the path is empty.
+func (m *Model) Pop() string {
+	if len(m.path) == 0 {
+		return ""
+	}
+	last := m.path[len(m.path)-1]
+	m.Truncate(len(m.path) - 2)
+	return last
+}
+
+// Truncate drops all segments after the one with the given index and moves the cursor to the new last segment.
+func (m *Model) Truncate(i int) {
+	m.path = m.path[:max(0, min(i+1, len(m.path)))]
+	m.cursor = max(0, len(m.path)-1)
+}
+
+// Cursor returns the index of the focused segment.
+func (m *Model) Cursor() int {
+	return m.cursor
+}
+
+// Activated returns the index of the segment activated last, or -1 if none was activated.
+func (m *Model) Activated() int {
+	return m.activated
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer activates the segment given by its index or label. It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	i, err := answer.Index(v, m.path)
+	if err != nil {
+		return err
+	}
+	m.cursor, m.activated = i, i
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the label of the activated segment. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	if m.activated < 0 || m.activated >= len(m.path) {
+		return ""
+	}
+	return m.path[m.activated]
+}
+
+// Choices returns the labels of the segments. It implements ui.ChoiceModel.
+func (m *Model) Choices() []string {
+	return m.Path()
+}
+
+// Focus focuses the model, so that it handles key messages.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages. The cursor is not shown while blurred.
+func (m *Model) Blur() {
+	m.focused = false
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the path, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// activate activates the segment under the cursor and returns the command emitting an ActivateMsg in embedded mode,
+// or tea.Quit otherwise.
+func (m *Model) activate() tea.Cmd {
+	m.activated, m.err = m.cursor, nil
+	m.canceled, m.quit = false, false
+	if m.embedded {
+		msg := ActivateMsg{Model: m, Index: m.cursor, Label: m.path[m.cursor]}
+		return func() tea.Msg { return msg }
+	}
+	return tea.Quit
+}
+
+// Update handles window size messages and key messages, moving the cursor and activating segments. In embedded
+// mode, canceling and quitting are left to the embedding model.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	case tea.KeyMsg:
+		if !m.focused {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, firstKey):
+			m.cursor = 0
+		case key.Matches(msg, lastKey):
+			m.cursor = max(0, len(m.path)-1)
+		case key.Matches(msg, m.keymap.Prev):
+			m.cursor = max(0, m.cursor-1)
+		case key.Matches(msg, m.keymap.Next):
+			m.cursor = max(0, min(m.cursor+1, len(m.path)-1))
+		case key.Matches(msg, m.keymap.Confirm):
+			if len(m.path) > 0 {
+				return m, m.activate()
+			}
+		case m.embedded:
+			// Canceling and quitting are left to the embedding model.
+		case key.Matches(msg, m.keymap.Cancel):
+			if m.cancelable {
+				m.canceled, m.quit = true, false
+				return m, tea.Quit
+			}
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, tea.Quit
+			}
+		}
+	}
+	return m, nil
+}
+
+// barWidth returns the width available to the bar.
+func (m *Model) barWidth() int {
+	switch {
+	case m.fixedWidth > 0:
+		return m.fixedWidth
+	case m.width > 0:
+		return m.width
+	default:
+		return defaultWidth
+	}
+}
+
+// visible returns the indexes of the segments shown within the given width, with -1 standing for an ellipsis. The
+// first segment and the cursor are always included; the remaining space is given to the segments following the
+// cursor, then to those preceding it.
+func (m *Model) visible(width int) []int {
+	n := len(m.path)
+	sep := styles.Width(m.separator())
+	ellipsis := styles.Width(ui.Glyphs().Ellipsis)
+	widthOf := func(lo, hi int) int {
+		w := styles.Width(m.path[0])
+		if lo > 1 {
+			w += sep + ellipsis
+		}
+		for i := max(lo, 1); i <= hi; i++ {
+			w += sep + styles.Width(m.path[i])
+		}
+		if hi < n-1 {
+			w += sep + ellipsis
+		}
+		return w
+	}
+
+	lo, hi := max(1, m.cursor), max(1, m.cursor)
+	for hi < n-1 && widthOf(lo, hi+1) <= width {
+		hi++
+	}
+	for lo > 1 && widthOf(lo-1, hi) <= width {
+		lo--
+	}
+
+	idx := []int{0}
+	if lo > 1 {
+		idx = append(idx, -1)
+	}
+	for i := lo; i <= hi && i < n; i++ {
+		idx = append(idx, i)
+	}
+	if hi < n-1 {
+		idx = append(idx, -1)
+	}
+	return idx
+}
+
+// separator returns the separator shown between segments.
+func (m *Model) separator() string {
+	return " " + ui.Glyphs().Submenu + " "
+}
+
+// View renders the label and the path. Segments that do not fit are replaced by an ellipsis, and if even the
+// remaining segments do not fit, their labels are truncated.
+func (m *Model) View() string {
+	var b strings.Builder
+	width := m.barWidth()
+	if m.label != "" {
+		b.WriteString(m.styles.Label.Render(m.label) + " ")
+		width -= styles.Width(m.label) + 1
+	}
+	if len(m.path) == 0 {
+		return b.String()
+	}
+
+	idx := m.visible(width)
+	// Remaining overflow is spread over the labels of the shown segments.
+	limit := width
+	for _, i := range idx {
+		if i >= 0 {
+			width -= styles.Width(m.path[i])
+		} else {
+			width -= styles.Width(ui.Glyphs().Ellipsis)
+		}
+	}
+	width -= (len(idx) - 1) * styles.Width(m.separator())
+	if width < 0 {
+		limit = max(1, (limit-(len(idx)-1)*styles.Width(m.separator()))/len(idx))
+	}
+
+	for k, i := range idx {
+		if k > 0 {
+			b.WriteString(m.styles.Separator.Render(m.separator()))
+		}
+		if i < 0 {
+			b.WriteString(m.styles.Separator.Render(ui.Glyphs().Ellipsis))
+			continue
+		}
+		text := styles.Truncate(m.path[i], limit)
+		switch {
+		case i == m.cursor && m.focused:
+			b.WriteString(m.styles.Cursor.Render(text))
+		case i == len(m.path)-1:
+			b.WriteString(m.styles.Current.Render(text))
+		default:
+			b.WriteString(m.styles.Segment.Render(text))
+		}
+	}
+	if m.err != nil {
+		b.WriteString("\n" + ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return b.String()
+}
+
+// Run runs the model and returns the index of the activated segment. It implements ui.Prompt[int].
+func (m *Model) Run(ctx context.Context) (int, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return -1, err
+	}
+	return m.activated, nil
+}
+
+// RunAccessible asks for the segment to activate using a numbered list instead of the terminal UI. It implements
+// ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	if len(m.path) == 0 {
+		return nil
+	}
+	i, err := plain.New(in, out).Choice(m.label, m.path, m.cursor)
+	switch {
+	case errors.Is(err, io.EOF):
+		m.canceled, m.quit = true, false
+		return nil
+	case err != nil:
+		return err
+	}
+	m.cursor, m.activated = i, i
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Showcase demonstrates the Model component with a deep path that is truncated in narrow terminals.
+func Showcase() {
+	fmt.Println("=== Breadcrumb Showcase ===")
+
+	path := []string{"Home", "Clusters", "prod-eu-west-1", "Namespaces", "payments", "Deployments", "api-gateway"}
+	m := New(path).WithLabel("Go to:")
+	if _, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Navigating to %s\n", strings.Join(path[:m.Activated()+1], "/"))
+	}
+
+	narrow := New(path).WithLabel("Narrow:").WithWidth(40)
+	if _, err := narrow.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Activated: %s\n", narrow.Answer())
+	}
+}
@@ -0,0 +1,91 @@
+package breadcrumb
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Label sets the label shown before the path.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Path sets the path and moves the cursor to the last segment.
+func Path(path ...string) Option {
+	return func(m *Model) {
+		m.SetPath(path...)
+	}
+}
+
+// Width sets the width available to the bar, including the label. By default, the window width is used.
+func Width(width int) Option {
+	return func(m *Model) {
+		m.fixedWidth = width
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, an ActivateMsg is emitted instead of quitting the
+// program when the user activates a segment, and canceling and quitting are left to the embedding model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
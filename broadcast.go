@@ -0,0 +1,37 @@
+package ui
+
+import (
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// programs holds the running programs, see broadcast.
+var programs struct {
+	sync.Mutex
+	set map[*tea.Program]struct{}
+}
+
+// track adds the program to the running programs until the returned function is called.
+func track(p *tea.Program) (untrack func()) {
+	programs.Lock()
+	defer programs.Unlock()
+	if programs.set == nil {
+		programs.set = make(map[*tea.Program]struct{})
+	}
+	programs.set[p] = struct{}{}
+	return func() {
+		programs.Lock()
+		defer programs.Unlock()
+		delete(programs.set, p)
+	}
+}
+
+// broadcast sends msg to all running programs without waiting for them to receive it.
+func broadcast(msg tea.Msg) {
+	programs.Lock()
+	defer programs.Unlock()
+	for p := range programs.set {
+		go p.Send(msg)
+	}
+}
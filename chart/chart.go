@@ -0,0 +1,465 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/styles"
+)
+
+// defaultWidth is the width of the chart until the window size is known.
+const defaultWidth = 60
+
+// statsWidth is the width reserved for the statistics after a sparkline.
+const statsWidth = 30
+
+// Kind is the way a chart renders its values.
+type Kind int
+
+const (
+	Sparkline Kind = iota // Sparkline renders the values as a single line of cells of varying height.
+	Columns               // Columns renders the values as vertical columns with a value axis.
+	Bars                  // Bars renders each value as a labeled horizontal bar.
+)
+
+// String returns the name of the kind.
+func (k Kind) String() string {
+	switch k {
+	case Sparkline:
+		return "sparkline"
+	case Columns:
+		return "columns"
+	case Bars:
+		return "bars"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// PushMsg appends values to the chart with the given ID, dropping the oldest values beyond its capacity. Send it
+// using tea.Program.Send, or return it from a command using Push.
+type PushMsg struct {
+	ID     string    // ID identifies the chart.
+	Values []float64 // Values are appended to the values of the chart.
+}
+
+// SetMsg replaces the values and labels of the chart with the given ID.
+type SetMsg struct {
+	ID     string    // ID identifies the chart.
+	Values []float64 // Values replace the values of the chart.
+	Labels []string  // Labels replace the labels of the chart, used by bar charts.
+}
+
+// Push returns a command appending values to the chart with the given ID.
+func Push(id string, values ...float64) tea.Cmd {
+	return func() tea.Msg { return PushMsg{ID: id, Values: values} }
+}
+
+// Set returns a command replacing the values and labels of the chart with the given ID.
+func Set(id string, values []float64, labels []string) tea.Cmd {
+	return func() tea.Msg { return SetMsg{ID: id, Values: values, Labels: labels} }
+}
+
+// Every returns a command calling fn every interval and appending the value it returns to the chart with the given
+// ID, e.g. to sample a metric. The command repeats until fn returns false.
+func Every(id string, interval time.Duration, fn func() (float64, bool)) tea.Cmd {
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		v, ok := fn()
+		if !ok {
+			return nil
+		}
+		return sampleMsg{push: PushMsg{ID: id, Values: []float64{v}}, next: Every(id, interval, fn)}
+	})
+}
+
+// sampleMsg carries a value sampled by Every and the command sampling the next one.
+type sampleMsg struct {
+	push PushMsg
+	next tea.Cmd
+}
+
+// Model represents a small chart of numeric values: a sparkline, a column chart with a value axis, or a bar chart
+// with a label per value. It is not interactive; its values are set by the embedding model or updated by messages.
+type Model struct {
+	id           string               // id identifies the chart for updates.
+	title        string               // title is shown above the chart, or before a sparkline.
+	kind         Kind                 // kind is the way the values are rendered.
+	values       []float64            // values holds the values, the oldest first.
+	labels       []string             // labels holds the labels of the values of a bar chart.
+	capacity     int                  // capacity is the number of values kept, 0 to keep as many as fit.
+	height       int                  // height is the number of lines of a column chart.
+	fixedWidth   int                  // fixedWidth is the width of the chart, 0 to use the window width.
+	width        int                  // width is the window width, updated from window size messages.
+	min, max     float64              // min and max are the fixed bounds of the value range.
+	fixedMin     bool                 // fixedMin determines if min is used instead of the smallest value.
+	fixedMax     bool                 // fixedMax determines if max is used instead of the largest value.
+	format       func(float64) string // format formats values for the axis, statistics and bars.
+	showStats    bool                 // showStats determines if the statistics are shown after a sparkline.
+	styles       Styles               // styles holds the styles of the model.
+	customStyles bool                 // customStyles is set if styles were set explicitly, which a theme switch keeps
+}
+
+// New creates and returns a new Model of the given kind with the given title, configured by the given options.
+func New(kind Kind, title string, opts ...Option) *Model {
+	m := &Model{
+		title:     title,
+		kind:      kind,
+		height:    8,
+		format:    FormatValue,
+		showStats: true,
+		styles:    DefaultStyles(),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithID sets the ID identifying the chart for updates and returns a new Model with the updated ID.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithTitle sets the title and returns a new Model with the updated title.
+func (m *Model) WithTitle(title string) *Model {
+	return m.With(Title(title))
+}
+
+// WithValues sets the values and returns a new Model with the updated values.
+func (m *Model) WithValues(values ...float64) *Model {
+	return m.With(Values(values...))
+}
+
+// WithLabels sets the labels of the values of a bar chart and returns a new Model with the updated labels.
+func (m *Model) WithLabels(labels ...string) *Model {
+	return m.With(Labels(labels...))
+}
+
+// WithCapacity sets the number of values kept and returns a new Model with the updated capacity.
+func (m *Model) WithCapacity(n int) *Model {
+	return m.With(Capacity(n))
+}
+
+// WithHeight sets the number of lines of a column chart and returns a new Model with the updated height.
+func (m *Model) WithHeight(height int) *Model {
+	return m.With(Height(height))
+}
+
+// WithWidth sets the width of the chart and returns a new Model with the updated width.
+func (m *Model) WithWidth(width int) *Model {
+	return m.With(Width(width))
+}
+
+// WithRange sets fixed bounds of the value range and returns a new Model with the updated range.
+func (m *Model) WithRange(min, max float64) *Model {
+	return m.With(Range(min, max))
+}
+
+// WithFormat sets the function formatting values and returns a new Model with the updated function.
+func (m *Model) WithFormat(fn func(float64) string) *Model {
+	return m.With(Format(fn))
+}
+
+// WithStats sets whether the statistics are shown after a sparkline and returns a new Model with the updated flag.
+func (m *Model) WithStats(show bool) *Model {
+	return m.With(Stats(show))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Values returns a copy of the values, the oldest first.
+func (m *Model) Values() []float64 {
+	return append([]float64(nil), m.values...)
+}
+
+// SetValues replaces the values.
+func (m *Model) SetValues(values ...float64) {
+	m.values = append(m.values[:0:0], values...)
+}
+
+// SetLabels replaces the labels of the values of a bar chart.
+func (m *Model) SetLabels(labels ...string) {
+	m.labels = append(m.labels[:0:0], labels...)
+}
+
+// Push appends values, dropping the oldest values beyond the capacity.
+func (m *Model) Push(values ...float64) {
+	m.values = append(m.values, values...)
+	m.trim()
+}
+
+// trim drops the oldest values beyond the capacity, or beyond the width of the chart if no capacity is set.
+func (m *Model) trim() {
+	limit := m.capacity
+	if limit <= 0 && m.kind != Bars {
+		limit = max(m.chartWidth(), 1)
+	}
+	if limit > 0 && len(m.values) > limit {
+		m.values = append(m.values[:0:0], m.values[len(m.values)-limit:]...)
+	}
+}
+
+// Init initializes the Model.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles window size messages and the messages updating the values of the chart.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	case PushMsg:
+		if msg.ID == m.id {
+			m.Push(msg.Values...)
+		}
+	case SetMsg:
+		if msg.ID == m.id {
+			m.SetValues(msg.Values...)
+			m.SetLabels(msg.Labels...)
+		}
+	case sampleMsg:
+		if msg.push.ID == m.id {
+			m.Push(msg.push.Values...)
+			return m, msg.next
+		}
+	}
+	return m, nil
+}
+
+// FormatValue formats a value with up to two decimals, omitting trailing zeros, and with k, M or G suffixes for
+// large values. It is the default format of the charts.
+func FormatValue(v float64) string {
+	suffix := ""
+	for _, s := range []string{"k", "M", "G"} {
+		if math.Abs(v) < 10000 {
+			break
+		}
+		v /= 1000
+		suffix = s
+	}
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64) + suffix
+}
+
+// bounds returns the range of the values, using the fixed bounds if set.
+func (m *Model) bounds() (float64, float64) {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range m.values {
+		if math.IsNaN(v) {
+			continue
+		}
+		lo, hi = math.Min(lo, v), math.Max(hi, v)
+	}
+	if m.kind == Bars || m.kind == Columns {
+		// Bars and columns grow from zero unless the values are all negative or a bound is set.
+		lo = math.Min(lo, 0)
+	}
+	if m.fixedMin {
+		lo = m.min
+	}
+	if m.fixedMax {
+		hi = m.max
+	}
+	if math.IsInf(lo, 0) || math.IsInf(hi, 0) {
+		return 0, 1
+	}
+	if hi <= lo {
+		hi = lo + 1
+	}
+	return lo, hi
+}
+
+// scale returns the position of the value in the range as a fraction between 0 and 1.
+func scale(v, lo, hi float64) float64 {
+	if math.IsNaN(v) {
+		return 0
+	}
+	return math.Max(0, math.Min(1, (v-lo)/(hi-lo)))
+}
+
+// chartWidth returns the number of cells available to the values.
+func (m *Model) chartWidth() int {
+	width := m.fixedWidth
+	if width <= 0 {
+		width = m.width
+	}
+	if width <= 0 {
+		width = defaultWidth
+	}
+	switch m.kind {
+	case Columns:
+		lo, hi := m.bounds()
+		width -= max(styles.Width(m.format(lo)), styles.Width(m.format(hi))) + 2
+	case Sparkline:
+		if m.title != "" {
+			width -= styles.Width(m.title) + 1
+		}
+		if m.showStats {
+			width -= statsWidth
+		}
+	}
+	return max(1, width)
+}
+
+// View renders the chart.
+func (m *Model) View() string {
+	switch m.kind {
+	case Columns:
+		return m.columnsView()
+	case Bars:
+		return m.barsView()
+	default:
+		return m.sparklineView()
+	}
+}
+
+// sparklineView renders the title, the latest values as a line of cells and the statistics.
+func (m *Model) sparklineView() string {
+	levels := []rune(ui.Glyphs().Levels)
+	lo, hi := m.bounds()
+
+	var b strings.Builder
+	if m.title != "" {
+		b.WriteString(m.styles.Title.Render(m.title) + " ")
+	}
+	values := m.values[max(0, len(m.values)-m.chartWidth()):]
+	var line strings.Builder
+	for _, v := range values {
+		line.WriteRune(levels[int(math.Round(scale(v, lo, hi)*float64(len(levels)-1)))])
+	}
+	b.WriteString(m.styles.Chart.Render(line.String()))
+
+	if m.showStats && len(values) > 0 {
+		vlo, vhi := math.Inf(1), math.Inf(-1)
+		for _, v := range values {
+			if !math.IsNaN(v) {
+				vlo, vhi = math.Min(vlo, v), math.Max(vhi, v)
+			}
+		}
+		stats := fmt.Sprintf("%s · min %s · max %s", m.format(values[len(values)-1]), m.format(vlo), m.format(vhi))
+		b.WriteString(" " + m.styles.Axis.Render(stats))
+	}
+	return b.String()
+}
+
+// columnsView renders the title, and the latest values as columns with a value axis on the left. Each cell is
+// divided into levels, so that the columns grow in steps smaller than a line.
+func (m *Model) columnsView() string {
+	g := ui.Glyphs()
+	levels := []rune(g.Levels)
+	lo, hi := m.bounds()
+	loLabel, hiLabel := m.format(lo), m.format(hi)
+	axisWidth := max(styles.Width(loLabel), styles.Width(hiLabel))
+	values := m.values[max(0, len(m.values)-m.chartWidth()):]
+	height := max(1, m.height)
+
+	var b strings.Builder
+	if m.title != "" {
+		b.WriteString(m.styles.Title.Render(m.title) + "\n")
+	}
+	for row := height - 1; row >= 0; row-- {
+		label := ""
+		switch row {
+		case height - 1:
+			label = hiLabel
+		case 0:
+			label = loLabel
+		}
+		b.WriteString(m.styles.Axis.Render(styles.Pad(label, axisWidth, lipgloss.Right) + " " + g.Border.Left))
+
+		var line strings.Builder
+		for _, v := range values {
+			// The fill of this cell in levels, from 0 (empty) to len(levels) (full).
+			fill := int(math.Round(scale(v, lo, hi)*float64(height*len(levels)))) - row*len(levels)
+			switch {
+			case fill <= 0:
+				line.WriteByte(' ')
+			case fill >= len(levels):
+				line.WriteRune(levels[len(levels)-1])
+			default:
+				line.WriteRune(levels[fill-1])
+			}
+		}
+		b.WriteString(m.styles.Chart.Render(line.String()))
+		if row > 0 {
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// barsView renders the title, and each value as a horizontal bar with its label on the left and its value on the
+// right.
+func (m *Model) barsView() string {
+	g := ui.Glyphs()
+	lo, hi := m.bounds()
+
+	labelWidth, valueWidth := 0, 0
+	for i, v := range m.values {
+		if i < len(m.labels) {
+			labelWidth = max(labelWidth, styles.Width(m.labels[i]))
+		}
+		valueWidth = max(valueWidth, styles.Width(m.format(v)))
+	}
+	barWidth := max(1, m.chartWidth()-labelWidth-valueWidth-4)
+
+	var b strings.Builder
+	if m.title != "" {
+		b.WriteString(m.styles.Title.Render(m.title))
+	}
+	for i, v := range m.values {
+		if b.Len() > 0 {
+			b.WriteByte('\n')
+		}
+		label := ""
+		if i < len(m.labels) {
+			label = m.labels[i]
+		}
+		n := int(math.Round(scale(v, lo, hi) * float64(barWidth)))
+		fmt.Fprintf(&b, "%s %s %s%s %s",
+			m.styles.Label.Render(styles.Pad(label, labelWidth, lipgloss.Left)),
+			m.styles.Axis.Render(g.Border.Left),
+			m.styles.Chart.Render(strings.Repeat(g.Block, n)),
+			strings.Repeat(" ", barWidth-n),
+			m.styles.Value.Render(m.format(v)))
+	}
+	return b.String()
+}
+
+// Showcase demonstrates the Model component as a sparkline, a column chart and a bar chart.
+func Showcase() {
+	fmt.Println("=== Chart Showcase ===")
+	fmt.Println()
+
+	values := make([]float64, 48)
+	for i := range values {
+		values[i] = 50 + 30*math.Sin(float64(i)/4) + 10*math.Sin(float64(i)*1.7)
+	}
+	fmt.Println(New(Sparkline, "CPU %").WithValues(values...).WithRange(0, 100).View())
+	fmt.Println()
+
+	fmt.Println(New(Columns, "Requests per minute").WithValues(values...).WithHeight(6).WithWidth(56).View())
+	fmt.Println()
+
+	fmt.Println(New(Bars, "Disk usage (GB)").
+		WithLabels("/", "/home", "/var", "/tmp").
+		WithValues(41.5, 212, 18.25, 0.4).WithWidth(56).View())
+}
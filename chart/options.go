@@ -0,0 +1,102 @@
+package chart
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	newModel.values = append([]float64(nil), m.values...)
+	newModel.labels = append([]string(nil), m.labels...)
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// ID sets the ID identifying the chart for updates by PushMsg and SetMsg. Charts without ID receive the messages
+// without ID.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Title sets the title shown above the chart, or before a sparkline.
+func Title(title string) Option {
+	return func(m *Model) {
+		m.title = title
+	}
+}
+
+// Values sets the values, the oldest first.
+func Values(values ...float64) Option {
+	return func(m *Model) {
+		m.values = append(m.values[:0:0], values...)
+	}
+}
+
+// Labels sets the labels of the values of a bar chart.
+func Labels(labels ...string) Option {
+	return func(m *Model) {
+		m.labels = append(m.labels[:0:0], labels...)
+	}
+}
+
+// Capacity sets the number of values kept when values are pushed. By default, sparklines and column charts keep as
+// many values as fit, and bar charts keep all values. Values that are set are never dropped; only the latest values
+// that fit are shown.
+func Capacity(n int) Option {
+	return func(m *Model) {
+		m.capacity = max(0, n)
+	}
+}
+
+// Height sets the number of lines of a column chart. The default is 8.
+func Height(height int) Option {
+	return func(m *Model) {
+		m.height = max(1, height)
+	}
+}
+
+// Width sets the width of the chart, including the axis, labels and statistics of bar charts. By default, the window
+// width is used if the chart is embedded, and a width of 60 otherwise.
+func Width(width int) Option {
+	return func(m *Model) {
+		m.fixedWidth = width
+	}
+}
+
+// Range sets fixed bounds of the value range, e.g. 0 and 100 for percentages. Values outside the range are clamped.
+// By default, the range spans the values, and bars and columns start at zero.
+func Range(min, max float64) Option {
+	return func(m *Model) {
+		m.min, m.max, m.fixedMin, m.fixedMax = min, max, true, true
+	}
+}
+
+// Format sets the function formatting values for the axis, the statistics and the bars. The default is FormatValue.
+func Format(fn func(float64) string) Option {
+	return func(m *Model) {
+		if fn == nil {
+			fn = FormatValue
+		}
+		m.format = fn
+	}
+}
+
+// Stats sets whether the latest, smallest and largest values are shown after a sparkline. It is enabled by default.
+func Stats(show bool) Option {
+	return func(m *Model) {
+		m.showStats = show
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
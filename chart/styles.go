@@ -0,0 +1,38 @@
+package chart
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Title lipgloss.Style // Title is the style of the title.
+	Chart lipgloss.Style // Chart is the style of the sparkline, the columns and the bars.
+	Axis  lipgloss.Style // Axis is the style of the value axis, the separators and the statistics.
+	Label lipgloss.Style // Label is the style of the labels of a bar chart.
+	Value lipgloss.Style // Value is the style of the values after the bars of a bar chart.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Title: lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Chart: lipgloss.NewStyle().Foreground(t.Accent),
+		Axis:  lipgloss.NewStyle().Faint(true),
+		Label: lipgloss.NewStyle().Foreground(t.Text),
+		Value: lipgloss.NewStyle().Foreground(t.Text).Bold(true),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
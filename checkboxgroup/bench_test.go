@@ -0,0 +1,12 @@
+package checkboxgroup
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui/internal/benchview"
+)
+
+func BenchmarkView1000(b *testing.B) {
+	benchview.Run(b, func() tea.Model { return New("Check", Strings(benchview.Items(1000)...)) })
+}
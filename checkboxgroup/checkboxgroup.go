@@ -0,0 +1,637 @@
+package checkboxgroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/internal/render"
+	"github.com/nmeilick/go-ui/styles"
+)
+
+var _ ui.Prompt[[]*Item] = (*Model)(nil)
+
+var (
+	checkKey = key.NewBinding(key.WithKeys(" ", "x"), key.WithHelp("space", "check"))
+	allKey   = key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "check all"))
+	noneKey  = key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "check none"))
+	homeKey  = key.NewBinding(key.WithKeys("home", "g"), key.WithHelp("home", "first"))
+	endKey   = key.NewBinding(key.WithKeys("end", "G"), key.WithHelp("end", "last"))
+)
+
+// Item is an option of a checkbox group.
+type Item struct {
+	Label    string // Label is shown next to the checkbox.
+	Value    any    // Value is returned for the checked item; the label is used if it is nil.
+	Disabled bool   // Disabled prevents changing the state of the item.
+	Checked  bool   // Checked is the state of the item. It is updated while the user makes the selection.
+}
+
+// value returns the value of the item, or its label if no value is set.
+func (i *Item) value() any {
+	if i.Value != nil {
+		return i.Value
+	}
+	return i.Label
+}
+
+// Strings returns unchecked items with the given labels.
+func Strings(labels ...string) []*Item {
+	items := make([]*Item, len(labels))
+	for i, label := range labels {
+		items[i] = &Item{Label: label}
+	}
+	return items
+}
+
+// Model represents a group of labeled checkboxes, e.g. as part of a form.
+type Model struct {
+	label          string                 // label is shown above the checkboxes.
+	items          []*Item                // items are the options of the group.
+	cursor         int                    // cursor is the index of the focused item.
+	min            int                    // min is the minimum number of checked items.
+	max            int                    // max is the maximum number of checked items, 0 if unlimited.
+	cancelable     bool                   // cancelable determines if selection can be canceled with escape key
+	quitable       bool                   // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption    // programOptions are passed to the program running the model
+	id             string                 // id identifies the prompt, e.g. for preset answers
+	embedded       bool                   // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                   // focused determines if the model handles key messages
+	width          int                    // width is the width of the terminal the label is wrapped at, 0 if unknown.
+	keymap         ui.KeyMap              // keymap holds the key bindings of the model.
+	zones          ui.Zones               // zones marks the items, so they can be clicked.
+	cache          *render.Cache[itemKey] // cache holds the rendered items.
+	styles         Styles                 // styles holds the styles of the model.
+	customStyles   bool                   // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error                  // err is shown below the model, e.g. why the previous answer was rejected
+
+	canceled bool // canceled indicates whether the selection was canceled
+	quit     bool // quit indicates whether the selection was quit
+}
+
+// New creates and returns a new Model with the given label and items, configured by the given options. The state of
+// the items is taken from their Checked field and updated while the user makes the selection.
+func New(label string, items []*Item, opts ...Option) *Model {
+	m := &Model{
+		label:      label,
+		items:      items,
+		cancelable: true,
+		quitable:   true,
+		focused:    true,
+		keymap:     ui.DefaultKeyMap(),
+		zones:      ui.NewZones(),
+		cache:      render.New[itemKey](),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.cursor = m.nextEnabled(-1, 1)
+	return m
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithMin sets the minimum number of checked items and returns a new Model with the updated constraint.
+func (m *Model) WithMin(n int) *Model {
+	return m.With(Min(n))
+}
+
+// WithMax sets the maximum number of checked items and returns a new Model with the updated constraint.
+func (m *Model) WithMax(n int) *Model {
+	return m.With(Max(n))
+}
+
+// WithChecked checks the items with the given indices and returns a new Model with the updated state, leaving the items
+// of the model unchanged.
+func (m *Model) WithChecked(indices ...int) *Model {
+	return m.With(Checked(indices...))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Items returns all items of the group.
+func (m *Model) Items() []*Item {
+	return m.items
+}
+
+// Checked returns the checked items.
+func (m *Model) Checked() []*Item {
+	var checked []*Item
+	for _, item := range m.items {
+		if item.Checked {
+			checked = append(checked, item)
+		}
+	}
+	return checked
+}
+
+// Values returns the values of the checked items, using the label of items without value.
+func (m *Model) Values() []any {
+	var values []any
+	for _, item := range m.Checked() {
+		values = append(values, item.value())
+	}
+	return values
+}
+
+// Values returns the values of the checked items of the model that are of type T, e.g. the labels for T string if
+// the items have no values.
+func Values[T any](m *Model) []T {
+	var values []T
+	for _, v := range m.Values() {
+		if t, ok := v.(T); ok {
+			values = append(values, t)
+		}
+	}
+	return values
+}
+
+// CheckAll checks all enabled items, up to the maximum number of checked items.
+func (m *Model) CheckAll() {
+	n := len(m.Checked())
+	for _, item := range m.items {
+		if !item.Disabled && !item.Checked && (m.max <= 0 || n < m.max) {
+			item.Checked = true
+			n++
+		}
+	}
+}
+
+// CheckNone unchecks all enabled items.
+func (m *Model) CheckNone() {
+	for _, item := range m.items {
+		if !item.Disabled {
+			item.Checked = false
+		}
+	}
+}
+
+// toggle switches the state of the item at index i, unless it is disabled or checking it would exceed the maximum.
+func (m *Model) toggle(i int) error {
+	if i < 0 || i >= len(m.items) || m.items[i].Disabled {
+		return nil
+	}
+	item := m.items[i]
+	if !item.Checked && m.max > 0 && len(m.Checked()) >= m.max {
+		return fmt.Errorf("select at most %d", m.max)
+	}
+	item.Checked = !item.Checked
+	return nil
+}
+
+// validate returns an error if the number of checked items violates the constraints.
+func (m *Model) validate() error {
+	if n := len(m.Checked()); n < m.min || (m.max > 0 && n > m.max) {
+		return m.rangeError()
+	}
+	return nil
+}
+
+// nextEnabled returns the index of the next enabled item from i in the given direction, or i if there is none.
+func (m *Model) nextEnabled(i, dir int) int {
+	for j := i + dir; j >= 0 && j < len(m.items); j += dir {
+		if !m.items[j].Disabled {
+			return j
+		}
+	}
+	return max(i, 0)
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is a list of labels as []string, []any or a comma-separated string. The
+// state of disabled items cannot be changed. It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	var labels []string
+	switch v := v.(type) {
+	case []string:
+		labels = v
+	case []any:
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return fmt.Errorf("invalid answer: %v", v)
+			}
+			labels = append(labels, s)
+		}
+	case string:
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				labels = append(labels, s)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+
+	checked := make([]bool, len(m.items))
+	for _, label := range labels {
+		i := m.index(label)
+		if i < 0 {
+			return fmt.Errorf("invalid answer: %s", label)
+		}
+		checked[i] = true
+	}
+	for i, item := range m.items {
+		if item.Disabled && item.Checked != checked[i] {
+			return fmt.Errorf("%s cannot be changed", item.Label)
+		}
+	}
+	n := 0
+	for _, c := range checked {
+		if c {
+			n++
+		}
+	}
+	if n < m.min || (m.max > 0 && n > m.max) {
+		return m.rangeError()
+	}
+
+	for i, item := range m.items {
+		item.Checked = checked[i]
+	}
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// index returns the index of the item with the given label, ignoring case, or -1 if there is none.
+func (m *Model) index(label string) int {
+	for i, item := range m.items {
+		if strings.EqualFold(item.Label, strings.TrimSpace(label)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// rangeError returns an error describing the number of items to check.
+func (m *Model) rangeError() error {
+	switch {
+	case m.max > 0 && m.min == m.max:
+		return fmt.Errorf("select exactly %d", m.min)
+	case m.max > 0:
+		return fmt.Errorf("select between %d and %d", m.min, m.max)
+	}
+	return fmt.Errorf("select at least %d", m.min)
+}
+
+// Answer returns the labels of the checked items as []string. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	labels := []string{}
+	for _, item := range m.Checked() {
+		labels = append(labels, item.Label)
+	}
+	return labels
+}
+
+// Choices returns the labels of all items. It implements ui.ChoiceModel.
+func (m *Model) Choices() []string {
+	labels := make([]string, len(m.items))
+	for i, item := range m.items {
+		labels[i] = item.Label
+	}
+	return labels
+}
+
+// Focus focuses the model, so that it handles key messages. It returns no command and exists for compatibility with
+// other focusable models.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns a nil command.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// Update handles key messages, moving the cursor, checking items and confirming the selection. With mouse support,
+// clicking an item checks or unchecks it, and the wheel moves the cursor. Window size messages determine the width the
+// label is wrapped at.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.WindowSizeMsg); ok {
+		m.width = msg.Width
+		return m, nil
+	}
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	if msg, ok := msg.(ui.MouseMsg); ok && m.focused {
+		return m, m.mouse(msg)
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !m.focused {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, checkKey):
+		m.err = m.toggle(m.cursor)
+	case key.Matches(keyMsg, allKey):
+		m.CheckAll()
+		m.err = nil
+	case key.Matches(keyMsg, noneKey):
+		m.CheckNone()
+		m.err = nil
+	case key.Matches(keyMsg, homeKey):
+		m.cursor = m.nextEnabled(-1, 1)
+	case key.Matches(keyMsg, endKey):
+		m.cursor = m.nextEnabled(len(m.items), -1)
+	case key.Matches(keyMsg, m.keymap.Prev):
+		m.cursor = m.nextEnabled(m.cursor, -1)
+	case key.Matches(keyMsg, m.keymap.Next):
+		m.cursor = m.nextEnabled(m.cursor, 1)
+	case key.Matches(keyMsg, m.keymap.Confirm):
+		if err := m.validate(); err != nil {
+			ui.RecordValidationFailure(m.Key(), err)
+			m.err = err
+			return m, nil
+		}
+		m.err = nil
+		m.canceled, m.quit = false, false
+		return m, m.done()
+	case key.Matches(keyMsg, m.keymap.Cancel):
+		if m.cancelable {
+			m.canceled, m.quit = true, false
+			return m, m.done()
+		}
+	case key.Matches(keyMsg, m.keymap.Quit):
+		if m.quitable {
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m, m.done()
+		}
+	}
+	return m, nil
+}
+
+// mouse handles a mouse event.
+func (m *Model) mouse(msg ui.MouseMsg) tea.Cmd {
+	if d := msg.Wheel(); d != 0 {
+		m.cursor = m.nextEnabled(m.cursor, d)
+		return nil
+	}
+	if i, ok := m.zones.Item(msg); ok && msg.Clicked() && !m.items[i].Disabled {
+		m.cursor = i
+		m.err = m.toggle(i)
+	}
+	return nil
+}
+
+// View renders the label and the items with their checkboxes, and the number of checked items if constrained.
+func (m *Model) View() string {
+	var b strings.Builder
+	g := ui.Glyphs()
+	if m.label != "" {
+		fmt.Fprintf(&b, "%s\n", m.styles.Label.Render(styles.Wrap(m.label, styles.InnerWidth(m.styles.Label, m.width))))
+	}
+
+	version := render.Fingerprint(m.styles.Cursor, m.styles.Checkbox, m.styles.Checked, m.styles.Disabled,
+		m.styles.SelectedItem, m.styles.Item) + g.SelectedLeft + "\x00" + g.Checked + "\x00" + g.Unchecked
+	for i, item := range m.items {
+		key := itemKey{label: item.Label, cursor: i == m.cursor && m.focused, checked: item.Checked,
+			disabled: item.Disabled}
+		line := m.cache.Get(version, key, func() string {
+			return m.renderItem(key, g)
+		})
+		b.WriteString(m.zones.MarkItem(i, line))
+		b.WriteByte('\n')
+	}
+
+	if m.min > 0 || m.max > 0 {
+		status := fmt.Sprintf("%d selected", len(m.Checked()))
+		switch {
+		case m.max > 0 && m.min == m.max:
+			status += fmt.Sprintf(" (select %d)", m.max)
+		case m.max > 0:
+			status += fmt.Sprintf(" (select %d-%d)", m.min, m.max)
+		default:
+			status += fmt.Sprintf(" (select at least %d)", m.min)
+		}
+		fmt.Fprintf(&b, "  %s\n", m.styles.Status.Render(status))
+	}
+	if m.err != nil {
+		fmt.Fprintf(&b, "%s\n", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// itemKey identifies a rendered item in the cache by its label and state.
+type itemKey struct {
+	label    string // label is the label of the item.
+	cursor   bool   // cursor determines if the cursor is on the item.
+	checked  bool   // checked determines if the item is checked.
+	disabled bool   // disabled determines if the item is disabled.
+}
+
+// renderItem renders an item with its cursor and checkbox.
+func (m *Model) renderItem(key itemKey, g ui.GlyphSet) string {
+	cursor := "  "
+	if key.cursor {
+		cursor = m.styles.Cursor.Render(g.SelectedLeft) + " "
+	}
+
+	box, style := g.Unchecked, m.styles.Item
+	if key.checked {
+		box = g.Checked
+	}
+	switch {
+	case key.disabled:
+		style = m.styles.Disabled
+	case key.cursor:
+		style = m.styles.SelectedItem
+	}
+	boxStyle := m.styles.Checkbox
+	switch {
+	case key.disabled:
+		boxStyle = m.styles.Disabled
+	case key.checked:
+		boxStyle = m.styles.Checked
+	}
+	return cursor + boxStyle.Render(box) + " " + style.Render(key.label)
+}
+
+// Run runs the model and returns the checked items. It implements ui.Prompt[[]*Item].
+func (m *Model) Run(ctx context.Context) ([]*Item, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return nil, err
+	}
+	return m.Checked(), nil
+}
+
+// RunAccessible lists the items and asks for the numbers of the items to check instead of using the terminal UI. It
+// implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	if m.label != "" {
+		p.Println(m.label)
+	}
+	var def []string
+	for i, item := range m.items {
+		suffix := ""
+		if item.Disabled {
+			suffix = " (fixed)"
+		}
+		p.Println(fmt.Sprintf("%3d) %s%s", i+1, item.Label, suffix))
+		if item.Checked {
+			def = append(def, strconv.Itoa(i+1))
+		}
+	}
+
+	for {
+		s, err := p.Line("Check (numbers or names, separated by commas, - for none): ", strings.Join(def, ","))
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+
+		var labels []string
+		if strings.TrimSpace(s) != "-" {
+			for _, e := range strings.Split(s, ",") {
+				e = strings.TrimSpace(e)
+				if n, err := strconv.Atoi(e); err == nil && n >= 1 && n <= len(m.items) {
+					e = m.items[n-1].Label
+				}
+				if e != "" {
+					labels = append(labels, e)
+				}
+			}
+		}
+		if err := m.SetAnswer(labels); err != nil {
+			ui.RecordValidationFailure(m.Key(), err)
+			p.Println(fmt.Sprintf("Error: %v", err))
+			continue
+		}
+		return nil
+	}
+}
+
+// Showcase demonstrates the Model component with constraints, a disabled item and typed values.
+func Showcase() {
+	fmt.Println("=== Checkbox Group Showcase ===")
+
+	fmt.Println("\nChoose 1-2 regions (space checks, a/n check all/none):")
+	items := []*Item{
+		{Label: "eu-central-1", Value: 1, Checked: true},
+		{Label: "us-east-1", Value: 2},
+		{Label: "us-west-2", Value: 3},
+		{Label: "ap-south-1 (unavailable)", Value: 4, Disabled: true},
+	}
+	m := New("Regions:", items).WithMin(1).WithMax(2)
+	if _, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Selected IDs: %v\n", Values[int](m))
+	}
+
+	fmt.Println("\nUnconstrained group:")
+	m = New("Features:", Strings("Logging", "Metrics", "Tracing"))
+	if _, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Selected: %v\n", Values[string](m))
+	}
+}
@@ -0,0 +1,115 @@
+package checkboxgroup
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Label sets the label shown above the checkboxes.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Min sets the minimum number of checked items required to confirm the selection.
+func Min(n int) Option {
+	return func(m *Model) {
+		m.min = max(n, 0)
+	}
+}
+
+// Max sets the maximum number of checked items. Further items cannot be checked once it is reached. 0 means
+// unlimited.
+func Max(n int) Option {
+	return func(m *Model) {
+		m.max = max(n, 0)
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Checked checks the items with the given indices, replacing the current state. The items are copied, so that the
+// state of the items of the model it was applied to, or that were passed to New, is left unchanged. Invalid indices
+// and those exceeding the maximum number of checked items are ignored.
+func Checked(indices ...int) Option {
+	return func(m *Model) {
+		items := make([]*Item, len(m.items))
+		for i, item := range m.items {
+			c := *item
+			c.Checked = false
+			items[i] = &c
+		}
+		n := 0
+		for _, i := range indices {
+			if i < 0 || i >= len(items) || items[i].Checked || (m.max > 0 && n >= m.max) {
+				continue
+			}
+			items[i].Checked = true
+			n++
+		}
+		m.items = items
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
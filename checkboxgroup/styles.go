@@ -0,0 +1,46 @@
+package checkboxgroup
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Label        lipgloss.Style // Label is the style of the label above the checkboxes.
+	Checkbox     lipgloss.Style // Checkbox is the style of the checkboxes of unchecked enabled items.
+	Checked      lipgloss.Style // Checked is the style of the checkboxes of checked enabled items.
+	Item         lipgloss.Style // Item is the style of the labels of enabled items.
+	SelectedItem lipgloss.Style // SelectedItem is the style of the label of the item under the cursor.
+	Disabled     lipgloss.Style // Disabled is the style of the checkboxes and labels of disabled items.
+	Cursor       lipgloss.Style // Cursor is the style of the cursor left of the item.
+	Status       lipgloss.Style // Status is the style of the number of checked items.
+	Error        lipgloss.Style // Error is the style of the error shown below the checkboxes.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:        lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Checkbox:     lipgloss.NewStyle().Foreground(t.Accent),
+		Checked:      lipgloss.NewStyle().Foreground(t.Accent),
+		Item:         lipgloss.NewStyle().Foreground(t.Text),
+		SelectedItem: lipgloss.NewStyle().Foreground(t.Success),
+		Disabled:     lipgloss.NewStyle().Faint(true),
+		Cursor:       lipgloss.NewStyle().Foreground(t.Accent),
+		Status:       lipgloss.NewStyle().Faint(true),
+		Error:        t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
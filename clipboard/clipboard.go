@@ -0,0 +1,128 @@
+// Package clipboard copies text to and pastes text from the clipboard, so all components behave the same. Text is
+// copied with an OSC 52 escape sequence, which the terminal applies to the clipboard of the user's machine even over
+// SSH, and, when running locally, to the system clipboard using the native mechanism of the OS: pbcopy on macOS, the
+// clipboard API on Windows, and wl-copy, xclip or xsel elsewhere. Pasting uses the native mechanism only, as most
+// terminals do not allow reading the clipboard via OSC 52.
+//
+// Components handle the Copy and Paste bindings of ui.KeyMap using the commands returned by Copy and Paste.
+package clipboard
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/atotto/clipboard"            // Native clipboard access per OS
+	"github.com/aymanbagabas/go-osc52/v2"    // OSC 52 escape sequences
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/x/term"        // Terminal detection
+)
+
+// ErrUnavailable is returned if no clipboard is available, e.g. when pasting over SSH.
+var ErrUnavailable = errors.New("clipboard unavailable")
+
+var output = struct {
+	sync.Mutex
+	w io.Writer
+}{w: os.Stdout}
+
+// SetOutput sets the terminal the OSC 52 sequence is written to, os.Stdout by default, e.g. an SSH session. Passing
+// nil disables OSC 52.
+func SetOutput(w io.Writer) {
+	output.Lock()
+	defer output.Unlock()
+	output.w = w
+}
+
+// Write copies the text to the clipboard. It succeeds if either the OSC 52 sequence was written to a terminal or the
+// system clipboard was set.
+func Write(text string) error {
+	var errs []error
+	written := false
+	if err := writeOSC52(text); err == nil {
+		written = true
+	} else if !errors.Is(err, ErrUnavailable) {
+		errs = append(errs, err)
+	}
+	if native() {
+		if err := clipboard.WriteAll(text); err == nil {
+			written = true
+		} else {
+			errs = append(errs, err)
+		}
+	}
+	if written {
+		return nil
+	}
+	return errors.Join(append([]error{ErrUnavailable}, errs...)...)
+}
+
+// Read returns the text in the system clipboard.
+func Read() (string, error) {
+	if !native() {
+		return "", ErrUnavailable
+	}
+	text, err := clipboard.ReadAll()
+	if err != nil {
+		return "", errors.Join(ErrUnavailable, err)
+	}
+	return text, nil
+}
+
+// writeOSC52 writes the OSC 52 sequence copying the text to the output, if it is a terminal. Files that are not
+// terminals, like redirected output, are skipped.
+func writeOSC52(text string) error {
+	output.Lock()
+	defer output.Unlock()
+	w := output.w
+	if w == nil {
+		return ErrUnavailable
+	}
+	if f, ok := w.(*os.File); ok && !term.IsTerminal(f.Fd()) {
+		return ErrUnavailable
+	}
+	seq := osc52.New(text)
+	switch {
+	case os.Getenv("TMUX") != "":
+		seq = seq.Tmux()
+	case strings.HasPrefix(os.Getenv("TERM"), "screen"):
+		seq = seq.Screen()
+	}
+	_, err := seq.WriteTo(w)
+	return err
+}
+
+// native reports whether the system clipboard is available. It is not used over SSH, where it would be the
+// clipboard of the remote machine.
+func native() bool {
+	return !clipboard.Unsupported && os.Getenv("SSH_TTY") == "" && os.Getenv("SSH_CONNECTION") == ""
+}
+
+// CopiedMsg reports the result of Copy.
+type CopiedMsg struct {
+	Text string // Text is the copied text.
+	Err  error  // Err is the error copying the text, if any.
+}
+
+// PastedMsg reports the result of Paste. Components insert the text if they have the focus.
+type PastedMsg struct {
+	Text string // Text is the text in the clipboard.
+	Err  error  // Err is the error reading the clipboard, if any.
+}
+
+// Copy returns a command copying the text to the clipboard, which results in a CopiedMsg.
+func Copy(text string) tea.Cmd {
+	return func() tea.Msg {
+		return CopiedMsg{Text: text, Err: Write(text)}
+	}
+}
+
+// Paste returns a command reading the clipboard, which results in a PastedMsg.
+func Paste() tea.Cmd {
+	return func() tea.Msg {
+		text, err := Read()
+		return PastedMsg{Text: text, Err: err}
+	}
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nmeilick/go-ui/checkboxgroup"
+	"github.com/nmeilick/go-ui/confirm"
+	"github.com/nmeilick/go-ui/input"
+	"github.com/nmeilick/go-ui/list"
+	"github.com/nmeilick/go-ui/password"
+	"github.com/nmeilick/go-ui/pick"
+	"github.com/nmeilick/go-ui/textarea"
+	"github.com/spf13/cobra" // Command-line framework
+)
+
+// fruits are the items shown if none are given.
+var fruits = []demoItem{
+	{Title: "Apple", Description: "A sweet red fruit"},
+	{Title: "Banana", Description: "A long yellow fruit"},
+	{Title: "Cherry", Description: "A small red fruit"},
+	{Title: "Date", Description: "A sweet brown fruit"},
+	{Title: "Elderberry", Description: "A small dark purple fruit"},
+}
+
+// demoItem is an item read with --items.
+type demoItem struct {
+	Title       string `json:"title"`       // Title is the text of the item.
+	Description string `json:"description"` // Description is shown below the title by the list.
+}
+
+// UnmarshalJSON accepts an item given as a plain string as well.
+func (i *demoItem) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &i.Title); err == nil {
+		return nil
+	}
+	type item demoItem
+	return json.Unmarshal(data, (*item)(i))
+}
+
+// loadItems returns the items given as arguments, read from the file given with --items, or the default items. The
+// file holds a JSON array of strings or of objects with a title and a description, or one item per line otherwise.
+func loadItems(path string, args []string) ([]demoItem, error) {
+	var items []demoItem
+	switch {
+	case len(args) > 0:
+		for _, a := range args {
+			items = append(items, demoItem{Title: a})
+		}
+	case path != "":
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(strings.TrimSpace(string(data)), "[") {
+			if err := json.Unmarshal(data, &items); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			break
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				items = append(items, demoItem{Title: line})
+			}
+		}
+	default:
+		return fruits, nil
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no items")
+	}
+	return items, nil
+}
+
+// titles returns the titles of the items.
+func titles(items []demoItem) []string {
+	s := make([]string, len(items))
+	for i, item := range items {
+		s[i] = item.Title
+	}
+	return s
+}
+
+// pickCommand returns the demo command of the pick component.
+func pickCommand(f *demoFlags) *cobra.Command {
+	var (
+		label, items           string
+		horizontal             bool
+		selected               int
+		selectedFmt, normalFmt string
+	)
+	cmd := &cobra.Command{
+		Use:   "pick [item...]",
+		Short: "Picks one of a few items",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			loaded, err := loadItems(items, args)
+			if err != nil {
+				return err
+			}
+			opts := []pick.Option{
+				pick.Label(label),
+				pick.SelectedIndex(selected),
+				pick.ProgramOptions(f.programOptions()...),
+			}
+			if horizontal {
+				opts = append(opts, pick.Horizontal())
+			}
+			if selectedFmt != "" {
+				opts = append(opts, pick.SelectedFormat(selectedFmt))
+			}
+			if normalFmt != "" {
+				opts = append(opts, pick.NormalFormat(normalFmt))
+			}
+			i, err := pick.New(titles(loaded), opts...).Run(context.Background())
+			if err != nil {
+				return result(err)
+			}
+			fmt.Printf("Picked item: %s (Index: %d)\n", loaded[i].Title, i)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&label, "label", "Pick a fruit", "label shown above the items")
+	cmd.Flags().StringVar(&items, "items", "", "file with the items, a JSON array or one item per line")
+	cmd.Flags().BoolVar(&horizontal, "horizontal", false, "show the items in a single line")
+	cmd.Flags().IntVar(&selected, "selected", 0, "index of the initially selected item")
+	cmd.Flags().StringVar(&selectedFmt, "selected-format", "", `format of the selected item, e.g. "► %s ◄"`)
+	cmd.Flags().StringVar(&normalFmt, "normal-format", "", `format of the other items, e.g. "  %s  "`)
+	return cmd
+}
+
+// listCommand returns the demo command of the list component.
+func listCommand(f *demoFlags) *cobra.Command {
+	var (
+		title, items string
+		selected     int
+	)
+	cmd := &cobra.Command{
+		Use:   "list [item...]",
+		Short: "Selects an item from a filterable list",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			loaded, err := loadItems(items, args)
+			if err != nil {
+				return err
+			}
+			var listItems []*list.Item
+			for _, item := range loaded {
+				listItems = append(listItems, list.NewItem(item.Title, item.Description))
+			}
+			m := list.New(listItems...).With(list.Title(title), list.SelectedIndex(selected),
+				list.ProgramOptions(f.programOptions()...))
+			item, err := m.Run(context.Background())
+			if err != nil {
+				return result(err)
+			}
+			fmt.Printf("Selected item: %s\n", item.Title())
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&title, "title", "Fruits", "title of the list")
+	cmd.Flags().StringVar(&items, "items", "", "file with the items, a JSON array or one item per line")
+	cmd.Flags().IntVar(&selected, "selected", 0, "index of the initially selected item")
+	return cmd
+}
+
+// inputCommand returns the demo command of the input component.
+func inputCommand(f *demoFlags) *cobra.Command {
+	var (
+		prompt, value, placeholder string
+		suggestions                []string
+		limit                      int
+	)
+	cmd := &cobra.Command{
+		Use:   "input",
+		Short: "Enters text with suggestions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m := input.New(prompt, value, suggestions...).With(input.Placeholder(placeholder), input.CharLimit(limit),
+				input.ProgramOptions(f.programOptions()...))
+			s, err := m.Run(context.Background())
+			if err != nil {
+				return result(err)
+			}
+			fmt.Printf("Entered: %s\n", s)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&prompt, "prompt", "Enter a fruit: ", "prompt shown before the input")
+	cmd.Flags().StringVar(&value, "value", "", "initial value")
+	cmd.Flags().StringVar(&placeholder, "placeholder", "", "text shown while the input is empty")
+	cmd.Flags().StringSliceVar(&suggestions, "suggest", titles(fruits), "suggestions completed with tab")
+	cmd.Flags().IntVar(&limit, "limit", 0, "maximum number of characters, 0 for no limit")
+	return cmd
+}
+
+// confirmCommand returns the demo command of the confirm component.
+func confirmCommand(f *demoFlags) *cobra.Command {
+	var (
+		label, phrase string
+		value         bool
+	)
+	cmd := &cobra.Command{
+		Use:   "confirm",
+		Short: "Asks a yes/no question",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m := confirm.New(label, confirm.Default(value), confirm.Phrase(phrase),
+				confirm.ProgramOptions(f.programOptions()...))
+			ok, err := m.Run(context.Background())
+			if err != nil {
+				return result(err)
+			}
+			fmt.Printf("Confirmed: %t\n", ok)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&label, "label", "Continue?", "question to ask")
+	cmd.Flags().StringVar(&phrase, "phrase", "", "phrase that must be typed to confirm")
+	cmd.Flags().BoolVar(&value, "default", false, "answer selected initially")
+	return cmd
+}
+
+// passwordCommand returns the demo command of the password component.
+func passwordCommand(f *demoFlags) *cobra.Command {
+	var (
+		label        string
+		again, rate  bool
+		minLength    int
+		showPassword bool
+	)
+	cmd := &cobra.Command{
+		Use:   "password",
+		Short: "Enters a password",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m := password.New(label, password.Confirm(again), password.ShowStrength(rate), password.MinLength(minLength),
+				password.ProgramOptions(f.programOptions()...))
+			pw, err := m.Run(context.Background())
+			if err != nil {
+				return result(err)
+			}
+			if showPassword {
+				fmt.Printf("Entered: %s\n", pw)
+			} else {
+				fmt.Printf("Entered %d characters\n", len([]rune(string(pw))))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&label, "label", "Password", "label of the prompt")
+	cmd.Flags().BoolVar(&again, "confirm", false, "ask for the password twice")
+	cmd.Flags().BoolVar(&rate, "strength", false, "show the strength of the password")
+	cmd.Flags().IntVar(&minLength, "min-length", 0, "minimum length of the password")
+	cmd.Flags().BoolVar(&showPassword, "show", false, "print the password entered")
+	return cmd
+}
+
+// textareaCommand returns the demo command of the textarea component.
+func textareaCommand(f *demoFlags) *cobra.Command {
+	var (
+		prompt, value, placeholder string
+		width, height              int
+	)
+	cmd := &cobra.Command{
+		Use:   "textarea",
+		Short: "Edits multi-line text",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m := textarea.New(prompt, value, textarea.Placeholder(placeholder), textarea.MaxWidth(width),
+				textarea.MaxHeight(height), textarea.ProgramOptions(f.programOptions()...))
+			s, err := m.Run(context.Background())
+			if err != nil {
+				return result(err)
+			}
+			fmt.Printf("Entered:\n%s\n", s)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&prompt, "prompt", "Notes", "prompt shown above the text")
+	cmd.Flags().StringVar(&value, "value", "", "initial text")
+	cmd.Flags().StringVar(&placeholder, "placeholder", "", "text shown while the text is empty")
+	cmd.Flags().IntVar(&width, "width", 0, "maximum width, 0 for the width of the terminal")
+	cmd.Flags().IntVar(&height, "height", 0, "maximum height, 0 for the default")
+	return cmd
+}
+
+// checkboxgroupCommand returns the demo command of the checkboxgroup component.
+func checkboxgroupCommand(f *demoFlags) *cobra.Command {
+	var (
+		label, items       string
+		minCount, maxCount int
+	)
+	cmd := &cobra.Command{
+		Use:   "checkboxgroup [item...]",
+		Short: "Checks any number of items",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			loaded, err := loadItems(items, args)
+			if err != nil {
+				return err
+			}
+			m := checkboxgroup.New(label, checkboxgroup.Strings(titles(loaded)...), checkboxgroup.Min(minCount),
+				checkboxgroup.Max(maxCount), checkboxgroup.ProgramOptions(f.programOptions()...))
+			checked, err := m.Run(context.Background())
+			if err != nil {
+				return result(err)
+			}
+			var labels []string
+			for _, item := range checked {
+				labels = append(labels, item.Label)
+			}
+			fmt.Printf("Checked: %s\n", strings.Join(labels, ", "))
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&label, "label", "Fruits", "label shown above the items")
+	cmd.Flags().StringVar(&items, "items", "", "file with the items, a JSON array or one item per line")
+	cmd.Flags().IntVar(&minCount, "min", 0, "minimum number of checked items")
+	cmd.Flags().IntVar(&maxCount, "max", 0, "maximum number of checked items, 0 for no limit")
+	return cmd
+}
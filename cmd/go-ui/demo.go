@@ -0,0 +1,190 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/spf13/cobra" // Command-line framework
+)
+
+// demoFlags holds the flags shared by all demo commands.
+type demoFlags struct {
+	theme      string // theme is the name of a built-in theme or the path of a theme file.
+	accessible bool   // accessible enables the line-based prompts of the accessible mode.
+	ascii      bool   // ascii forces the ASCII glyphs.
+	debug      bool   // debug shows the debug overlay.
+	mouse      bool   // mouse enables mouse support.
+	altScreen  bool   // altScreen runs the components in the alternate screen.
+	keys       string // keys are typed instead of reading the terminal.
+}
+
+// keyNames maps the names accepted by --keys to the input the terminal sends for them.
+var keyNames = map[string]string{
+	"up":        "\x1b[A",
+	"down":      "\x1b[B",
+	"right":     "\x1b[C",
+	"left":      "\x1b[D",
+	"home":      "\x1b[H",
+	"end":       "\x1b[F",
+	"pgup":      "\x1b[5~",
+	"pgdown":    "\x1b[6~",
+	"tab":       "\t",
+	"space":     " ",
+	"backspace": "\x7f",
+	"esc":       "\x1b",
+	"ctrl+c":    "\x03",
+}
+
+// programOptions returns the options passed to the programs run by the demo commands.
+func (f *demoFlags) programOptions() []tea.ProgramOption {
+	opts := []tea.ProgramOption{ui.WithAccessible(f.accessible), ui.WithDebug(f.debug), ui.WithMouse(f.mouse)}
+	if f.altScreen {
+		opts = append(opts, tea.WithAltScreen())
+	}
+	switch {
+	case f.keys != "":
+		opts = append(opts, ui.WithInput(strings.NewReader(f.input())))
+	case f.accessible:
+		// Read piped answers instead of the controlling terminal.
+		opts = append(opts, ui.WithInput(os.Stdin))
+	}
+	return opts
+}
+
+// input returns the terminal input for the keys given with --keys: a space-separated list of key names like "down"
+// or "enter", and text typed as is. In accessible mode, enter ends a line.
+func (f *demoFlags) input() string {
+	var b strings.Builder
+	for _, k := range strings.Fields(f.keys) {
+		switch s, ok := keyNames[k]; {
+		case k == "enter" && f.accessible:
+			b.WriteByte('\n')
+		case k == "enter":
+			b.WriteByte('\r')
+		case ok:
+			b.WriteString(s)
+		default:
+			b.WriteString(k)
+		}
+	}
+	return b.String()
+}
+
+// apply sets up the package-wide settings for the flags. The showcases do not take program options, so the
+// accessible mode and the debug overlay are enabled using their environment variables for them.
+func (f *demoFlags) apply() error {
+	if f.theme != "" {
+		t, err := loadTheme(f.theme)
+		if err != nil {
+			return err
+		}
+		ui.SetTheme(t)
+	}
+	if f.ascii {
+		ui.ForceASCII()
+	}
+	if f.accessible {
+		os.Setenv("UI_ACCESSIBLE", "1")
+	}
+	if f.debug {
+		os.Setenv("UI_DEBUG", "1")
+	}
+	return nil
+}
+
+// loadTheme returns the built-in theme with the given name, or the theme read from the file with the given path.
+func loadTheme(name string) (ui.Theme, error) {
+	if t, ok := ui.ThemeByName(name); ok {
+		return t, nil
+	}
+	if _, err := os.Stat(name); err != nil {
+		return ui.Theme{}, fmt.Errorf("unknown theme: %s", name)
+	}
+	return ui.LoadTheme(name)
+}
+
+// result handles the error of a demo run like an application would. Canceling is not an error of the demo.
+func result(err error) error {
+	if err = ui.Handle(err, ui.HandleOptions{}); errors.Is(err, ui.CanceledError) {
+		return nil
+	}
+	return err
+}
+
+// demoCommand returns the demo command with a sub-command for each component.
+func demoCommand() *cobra.Command {
+	f := new(demoFlags)
+	cmd := &cobra.Command{
+		Use:   "demo",
+		Short: "Runs the demo of a component",
+		Long: `Runs the demo of a component with the options given on the command line.
+
+--theme, --ascii, --debug and --accessible apply to all demos, the other flags to the demos with options of their
+own. --keys runs a demo without reading the terminal, e.g. to reproduce a problem:
+
+  go-ui demo pick --keys "down down enter"
+
+--accessible replaces the terminal UI with line-based prompts, which also work with piped input:
+
+  echo 2 | go-ui demo pick --accessible`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return f.apply()
+		},
+	}
+	pf := cmd.PersistentFlags()
+	pf.StringVar(&f.theme, "theme", "", "built-in theme or theme file to use")
+	pf.BoolVar(&f.accessible, "accessible", false, "use line-based prompts instead of the terminal UI")
+	pf.BoolVar(&f.ascii, "ascii", false, "use ASCII glyphs only")
+	pf.BoolVar(&f.debug, "debug", false, "show the debug overlay")
+	pf.BoolVar(&f.mouse, "mouse", false, "enable mouse support")
+	pf.BoolVar(&f.altScreen, "alt-screen", false, "run in the alternate screen")
+	pf.StringVar(&f.keys, "keys", "", `keys to type instead of reading the terminal, e.g. "down enter"`)
+
+	cmd.AddCommand(
+		pickCommand(f),
+		listCommand(f),
+		inputCommand(f),
+		confirmCommand(f),
+		passwordCommand(f),
+		textareaCommand(f),
+		checkboxgroupCommand(f),
+		themeCommand(),
+		showcaseCommand(),
+	)
+	dedicated := make(map[string]bool)
+	for _, c := range cmd.Commands() {
+		dedicated[c.Name()] = true
+	}
+	for _, s := range showcases {
+		if dedicated[s.name] {
+			continue
+		}
+		cmd.AddCommand(&cobra.Command{
+			Use:   s.name,
+			Short: s.short,
+			Args:  cobra.NoArgs,
+			Run: func(cmd *cobra.Command, args []string) {
+				s.run()
+			},
+		})
+	}
+	return cmd
+}
+
+// showcaseCommand returns the command running the showcases of all components.
+func showcaseCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "showcase",
+		Short: "Runs the showcases of all components in turn",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			for _, s := range showcases {
+				s.run()
+			}
+		},
+	}
+}
@@ -0,0 +1,31 @@
+// Command go-ui demonstrates the go-ui components. Each component has a demo command, so that it can be exercised and
+// debugged on its own with the options given on the command line:
+//
+//	go-ui demo pick --horizontal Apple Banana Cherry
+//	go-ui demo list --items fruits.json
+//	go-ui demo theme dracula
+//	go-ui demo confirm --accessible
+//
+// "go-ui demo showcase" runs the showcases of all components in turn.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra" // Command-line framework
+)
+
+func main() {
+	root := &cobra.Command{
+		Use:           "go-ui",
+		Short:         "Demonstrates the go-ui terminal UI components",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	root.AddCommand(demoCommand())
+	if err := root.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"github.com/nmeilick/go-ui/banner"
+	"github.com/nmeilick/go-ui/breadcrumb"
+	"github.com/nmeilick/go-ui/chart"
+	"github.com/nmeilick/go-ui/checkboxgroup"
+	"github.com/nmeilick/go-ui/combobox"
+	"github.com/nmeilick/go-ui/confirm"
+	"github.com/nmeilick/go-ui/countdown"
+	"github.com/nmeilick/go-ui/dashboard"
+	"github.com/nmeilick/go-ui/dirpicker"
+	"github.com/nmeilick/go-ui/docedit"
+	"github.com/nmeilick/go-ui/durationpicker"
+	"github.com/nmeilick/go-ui/finder"
+	"github.com/nmeilick/go-ui/form"
+	"github.com/nmeilick/go-ui/gauge"
+	"github.com/nmeilick/go-ui/helpscreen"
+	"github.com/nmeilick/go-ui/input"
+	"github.com/nmeilick/go-ui/layout"
+	"github.com/nmeilick/go-ui/list"
+	"github.com/nmeilick/go-ui/logview"
+	"github.com/nmeilick/go-ui/menu"
+	"github.com/nmeilick/go-ui/modal"
+	"github.com/nmeilick/go-ui/multiselect"
+	"github.com/nmeilick/go-ui/pager"
+	"github.com/nmeilick/go-ui/password"
+	"github.com/nmeilick/go-ui/pick"
+	"github.com/nmeilick/go-ui/progress"
+	"github.com/nmeilick/go-ui/slider"
+	"github.com/nmeilick/go-ui/spinner"
+	"github.com/nmeilick/go-ui/splitpane"
+	"github.com/nmeilick/go-ui/statusbar"
+	"github.com/nmeilick/go-ui/stopwatch"
+	"github.com/nmeilick/go-ui/table"
+	"github.com/nmeilick/go-ui/tagselect"
+	"github.com/nmeilick/go-ui/tasklist"
+	"github.com/nmeilick/go-ui/textarea"
+	"github.com/nmeilick/go-ui/timepicker"
+	"github.com/nmeilick/go-ui/toggle"
+	"github.com/nmeilick/go-ui/transferlist"
+	"github.com/nmeilick/go-ui/tree"
+	"github.com/nmeilick/go-ui/wizard"
+)
+
+// showcase is the interactive example of a component.
+type showcase struct {
+	name  string // name is the name of the demo command.
+	short string // short describes the component.
+	run   func() // run runs the example.
+}
+
+// showcases lists the examples of all components. Components with a dedicated demo command run their showcase as
+// part of "go-ui demo showcase" only.
+var showcases = []showcase{
+	{"list", "Selects an item from a filterable list", list.Showcase},
+	{"textarea", "Edits multi-line text", textarea.Showcase},
+	{"input", "Enters text with suggestions", input.Showcase},
+	{"pick", "Picks one of a few items", pick.Showcase},
+	{"confirm", "Asks a yes/no question", confirm.Showcase},
+	{"spinner", "Shows a spinner while tasks run", spinner.Showcase},
+	{"progress", "Shows the progress of an operation", progress.Showcase},
+	{"dashboard", "Shows the progress of parallel operations", dashboard.Showcase},
+	{"table", "Selects a row of a sortable table", table.Showcase},
+	{"tree", "Selects a node of a tree", tree.Showcase},
+	{"dirpicker", "Chooses a directory", dirpicker.Showcase},
+	{"timepicker", "Picks a time of day", timepicker.Showcase},
+	{"durationpicker", "Picks a duration", durationpicker.Showcase},
+	{"slider", "Picks a number from a range", slider.Showcase},
+	{"toggle", "Switches a setting on or off", toggle.Showcase},
+	{"checkboxgroup", "Checks any number of items", checkboxgroup.Showcase},
+	{"multiselect", "Selects any number of items", multiselect.Showcase},
+	{"form", "Fills in a form of fields", form.Showcase},
+	{"wizard", "Runs a multi-step wizard", wizard.Showcase},
+	{"menu", "Navigates a menu with submenus", menu.Showcase},
+	{"pager", "Pages through a long text", pager.Showcase},
+	{"logview", "Follows log output", logview.Showcase},
+	{"docedit", "Edits a structured document", docedit.Showcase},
+	{"finder", "Fuzzy-finds an item", finder.Showcase},
+	{"combobox", "Enters text or picks a suggestion", combobox.Showcase},
+	{"password", "Enters a password", password.Showcase},
+	{"modal", "Shows a dialog over a view", modal.Showcase},
+	{"statusbar", "Shows a status bar below a component", statusbar.Showcase},
+	{"breadcrumb", "Shows a navigation path", breadcrumb.Showcase},
+	{"helpscreen", "Shows a full-screen key help", helpscreen.Showcase},
+	{"banner", "Renders a title banner", banner.Showcase},
+	{"countdown", "Counts down a duration", countdown.Showcase},
+	{"stopwatch", "Measures elapsed time with laps", stopwatch.Showcase},
+	{"chart", "Renders sparklines, column and bar charts", chart.Showcase},
+	{"gauge", "Shows a value against thresholds", gauge.Showcase},
+	{"tasklist", "Runs a list of tasks", tasklist.Showcase},
+	{"tagselect", "Enters a set of tags", tagselect.Showcase},
+	{"transferlist", "Moves items between two lists", transferlist.Showcase},
+	{"layout", "Arranges components in rows and columns", layout.Showcase},
+	{"splitpane", "Shows two components side by side", splitpane.Showcase},
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/checkboxgroup"
+	"github.com/nmeilick/go-ui/confirm"
+	"github.com/nmeilick/go-ui/gauge"
+	"github.com/nmeilick/go-ui/pick"
+	"github.com/nmeilick/go-ui/progress"
+	"github.com/spf13/cobra" // Command-line framework
+)
+
+// themeCommand returns the command previewing a theme.
+func themeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "theme [name|file]",
+		Short: "Previews a built-in theme or a theme file",
+		Long: `Previews a built-in theme or a theme file by printing its colors and a few components styled with it.
+Without an argument, the built-in themes are listed.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				for _, t := range ui.Themes() {
+					fmt.Printf("%-16s %s\n", t.Name, swatches(t))
+				}
+				return nil
+			}
+			t, err := loadTheme(args[0])
+			if err != nil {
+				return err
+			}
+			ui.SetTheme(t)
+			fmt.Println(previewTheme(t))
+			return nil
+		},
+	}
+}
+
+// swatches returns the colors of the theme as colored blocks.
+func swatches(t ui.Theme) string {
+	var b strings.Builder
+	for _, c := range []lipgloss.AdaptiveColor{t.Accent, t.Label, t.Text, t.Success, t.Failure} {
+		b.WriteString(lipgloss.NewStyle().Foreground(c).Render("██ "))
+	}
+	return b.String()
+}
+
+// previewTheme renders the colors of the theme, which must be the current one, and components in typical states.
+func previewTheme(t ui.Theme) string {
+	colors := []struct {
+		name  string
+		color lipgloss.AdaptiveColor
+	}{
+		{"Accent", t.Accent},
+		{"Label", t.Label},
+		{"Text", t.Text},
+		{"Success", t.Success},
+		{"Failure", t.Failure},
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Theme %s\n\n", t.Name)
+	for _, c := range colors {
+		fmt.Fprintf(&b, "%s %-8s light %-8s dark %s\n", lipgloss.NewStyle().Foreground(c.color).Render("██"), c.name,
+			c.color.Light, c.color.Dark)
+	}
+
+	items := checkboxgroup.Strings("Apple", "Banana", "Cherry")
+	items[1].Checked = true
+	bar := progress.New("Downloading", 100)
+	bar.Set(60)
+	views := []string{
+		pick.New([]string{"Apple", "Banana", "Cherry"}, pick.Label("Pick a fruit")).View(),
+		checkboxgroup.New("Fruits", items).View(),
+		confirm.New("Continue?").View(),
+		bar.View(),
+		gauge.New("Disk usage", gauge.Value(85)).View(),
+		ui.RenderError(errors.New("something went wrong")),
+	}
+	for _, v := range views {
+		b.WriteString("\n" + strings.TrimRight(v, "\n") + "\n")
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
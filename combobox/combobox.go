@@ -0,0 +1,507 @@
+package combobox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"       // Manages key bindings
+	"github.com/charmbracelet/bubbles/textinput" // Provides text input model
+	tea "github.com/charmbracelet/bubbletea"     // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/answer"
+	"github.com/nmeilick/go-ui/internal/plain"
+)
+
+var _ ui.Prompt[string] = (*Model)(nil)
+
+var (
+	upKey       = key.NewBinding(key.WithKeys("up", "ctrl+p"), key.WithHelp("↑", "previous option"))
+	downKey     = key.NewBinding(key.WithKeys("down", "ctrl+n"), key.WithHelp("↓", "next option"))
+	completeKey = key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "complete"))
+)
+
+// Model represents a combobox: a text field with a dropdown listing the options that contain the entered text. The
+// user picks an option from the dropdown or types a new value, unless the value is restricted to the options.
+type Model struct {
+	label          string               // label is shown in front of the text field.
+	items          []string             // items holds the options.
+	filtered       []int                // filtered holds the indexes of the options containing the entered text.
+	open           bool                 // open determines if the dropdown is shown.
+	cursor         int                  // cursor is the position of the highlighted option in filtered, -1 if none.
+	offset         int                  // offset is the position of the first visible option in filtered.
+	height         int                  // height is the number of visible options.
+	restrict       bool                 // restrict determines if only the options are accepted.
+	valueInput     textinput.Model      // valueInput reads the value.
+	validator      ui.Validator[string] // validator validates the value before it is accepted
+	cancelable     bool                 // cancelable determines if selection can be canceled with escape key
+	quitable       bool                 // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption  // programOptions are passed to the program running the model
+	id             string               // id identifies the prompt, e.g. for preset answers
+	embedded       bool                 // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                 // focused determines if the model handles key messages
+	keymap         ui.KeyMap            // keymap holds the key bindings of the model.
+	styles         Styles               // styles holds the styles of the model.
+	customStyles   bool                 // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error                // err is shown below the model, e.g. why the previous answer was rejected
+
+	canceled bool // canceled indicates whether the selection was canceled
+	quit     bool // quit indicates whether the selection was quit
+}
+
+// New creates and returns a new Model with the given label and options, configured by the given options.
+func New(label string, items []string, opts ...Option) *Model {
+	ti := textinput.New()
+	ti.KeyMap = ui.DefaultTextKeyMap()
+	ti.Prompt = ""
+	ti.Width = 40
+	ti.Focus()
+
+	m := &Model{
+		label:      label,
+		items:      items,
+		cursor:     -1,
+		height:     6,
+		valueInput: ti,
+		cancelable: true,
+		quitable:   true,
+		focused:    true,
+		keymap:     ui.DefaultKeyMap(),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.filter()
+	return m
+}
+
+// filter collects the options containing the entered text, ignoring case. In restricted mode, the first option is
+// highlighted, otherwise none, so that confirming accepts the entered text.
+func (m *Model) filter() {
+	text := strings.ToLower(m.valueInput.Value())
+	m.filtered = nil
+	for i, item := range m.items {
+		if strings.Contains(strings.ToLower(item), text) {
+			m.filtered = append(m.filtered, i)
+		}
+	}
+	m.cursor, m.offset = -1, 0
+	if m.restrict && len(m.filtered) > 0 {
+		m.cursor = 0
+	}
+}
+
+// scroll adjusts the offset so that the highlighted option is visible.
+func (m *Model) scroll() {
+	if m.cursor < 0 {
+		m.offset = 0
+		return
+	}
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	} else if m.cursor >= m.offset+m.height {
+		m.offset = m.cursor - m.height + 1
+	}
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithItems sets the options and returns a new Model with the updated options.
+func (m *Model) WithItems(items []string) *Model {
+	return m.With(Items(items))
+}
+
+// WithValue sets the initial value and returns a new Model with the updated value.
+func (m *Model) WithValue(value string) *Model {
+	return m.With(Value(value))
+}
+
+// WithRestrict sets whether only the options are accepted and returns a new Model with the updated flag.
+func (m *Model) WithRestrict(restrict bool) *Model {
+	return m.With(Restrict(restrict))
+}
+
+// WithPlaceholder sets the placeholder shown while the text field is empty and returns a new Model with the updated
+// placeholder.
+func (m *Model) WithPlaceholder(s string) *Model {
+	return m.With(Placeholder(s))
+}
+
+// WithHeight sets the number of visible options and returns a new Model with the updated height.
+func (m *Model) WithHeight(height int) *Model {
+	return m.With(Height(height))
+}
+
+// WithValidator sets the validator the value must pass before it is accepted and returns a new Model with the updated
+// validator.
+func (m *Model) WithValidator(v ui.Validator[string]) *Model {
+	return m.With(Validator(v))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Value returns the entered value.
+func (m *Model) Value() string {
+	return m.valueInput.Value()
+}
+
+// resolve returns the value to accept for the given text: in restricted mode, the option equal to the text ignoring
+// case, which fails if there is none. The value must pass the validator.
+func (m *Model) resolve(s string) (string, error) {
+	if m.restrict {
+		found := false
+		for _, item := range m.items {
+			if strings.EqualFold(item, strings.TrimSpace(s)) {
+				s, found = item, true
+				break
+			}
+		}
+		if !found {
+			return "", fmt.Errorf("not one of the options: %s", s)
+		}
+	}
+	if m.validator != nil {
+		if err := m.validator.Validate(s); err != nil {
+			return "", err
+		}
+	}
+	return s, nil
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is the value; in restricted mode, it must be one of the options. It
+// implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	s, err := m.resolve(answer.String(v))
+	if err != nil {
+		return err
+	}
+	m.valueInput.SetValue(s)
+	m.valueInput.CursorEnd()
+	m.filter()
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the entered value. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	return m.Value()
+}
+
+// Choices returns the options. It implements ui.ChoiceModel.
+func (m *Model) Choices() []string {
+	return append([]string(nil), m.items...)
+}
+
+// Focus focuses the model, so that it handles key messages, and returns the command starting the cursor blink.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return m.valueInput.Focus()
+}
+
+// Blur removes the focus from the model, so that it ignores key messages. The dropdown is closed.
+func (m *Model) Blur() {
+	m.focused, m.open = false, false
+	m.valueInput.Blur()
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns the command starting the cursor blink.
+func (m *Model) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// pick puts the highlighted option into the text field and closes the dropdown. It returns false if no option is
+// highlighted.
+func (m *Model) pick() bool {
+	if !m.open || m.cursor < 0 || m.cursor >= len(m.filtered) {
+		return false
+	}
+	m.valueInput.SetValue(m.items[m.filtered[m.cursor]])
+	m.valueInput.CursorEnd()
+	m.filter()
+	m.open = false
+	return true
+}
+
+// Update handles key messages, moving through the dropdown, picking options and editing the value.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if !m.focused {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, downKey):
+			if !m.open {
+				m.open = true
+			} else if m.cursor < len(m.filtered)-1 {
+				m.cursor++
+			}
+			m.scroll()
+			return m, nil
+		case key.Matches(msg, upKey):
+			if m.open {
+				m.cursor = max(-1, m.cursor-1)
+			}
+			m.scroll()
+			return m, nil
+		case key.Matches(msg, completeKey):
+			if m.open && m.cursor < 0 && len(m.filtered) > 0 {
+				m.cursor = 0
+			}
+			m.pick()
+			return m, nil
+		case key.Matches(msg, m.keymap.Confirm):
+			m.pick()
+			s, err := m.resolve(m.Value())
+			if m.err = err; err != nil {
+				ui.RecordValidationFailure(m.Key(), err)
+				return m, nil
+			}
+			m.valueInput.SetValue(s)
+			m.canceled, m.quit = false, false
+			return m, m.done()
+		case key.Matches(msg, m.keymap.Cancel):
+			if m.open {
+				m.open = false
+				return m, nil
+			}
+			if m.cancelable {
+				m.canceled, m.quit = true, false
+				return m, m.done()
+			}
+			return m, nil
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, m.done()
+			}
+			return m, nil
+		}
+
+		value := m.Value()
+		var cmd tea.Cmd
+		m.valueInput, cmd = m.valueInput.Update(msg)
+		if m.Value() != value {
+			m.open, m.err = true, nil
+			m.filter()
+		}
+		return m, cmd
+	}
+
+	var cmd tea.Cmd
+	m.valueInput, cmd = m.valueInput.Update(msg)
+	return m, cmd
+}
+
+// highlight renders the option with the first occurrence of the entered text highlighted.
+func (m *Model) highlight(item string, selected bool) string {
+	style := m.styles.Item
+	if selected {
+		style = m.styles.SelectedItem
+	}
+	text := m.Value()
+	i := strings.Index(strings.ToLower(item), strings.ToLower(text))
+	if text == "" || i < 0 || len(strings.ToLower(item)) != len(item) {
+		return style.Render(item)
+	}
+	end := i + len(text)
+	return style.Render(item[:i]) + m.styles.Match.Render(item[i:end]) + style.Render(item[end:])
+}
+
+// View renders the label, the text field and, while it is open, the dropdown.
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.label != "" {
+		fmt.Fprintf(&b, "%s ", m.styles.Label.Render(m.label))
+	}
+	b.WriteString(m.valueInput.View())
+
+	if m.open {
+		g := ui.Glyphs()
+		end := min(len(m.filtered), m.offset+m.height)
+		for pos := m.offset; pos < end; pos++ {
+			cursor := "  "
+			if pos == m.cursor {
+				cursor = m.styles.Cursor.Render(g.SelectedLeft) + " "
+			}
+			fmt.Fprintf(&b, "\n%s%s", cursor, m.highlight(m.items[m.filtered[pos]], pos == m.cursor))
+		}
+		switch {
+		case len(m.filtered) == 0 && m.restrict:
+			fmt.Fprintf(&b, "\n  %s", m.styles.Status.Render("no matching options"))
+		case len(m.filtered) == 0:
+			fmt.Fprintf(&b, "\n  %s", m.styles.Status.Render("new value"))
+		case len(m.filtered) > m.height:
+			fmt.Fprintf(&b, "\n  %s", m.styles.Status.Render(fmt.Sprintf("%d-%d of %d", m.offset+1, end,
+				len(m.filtered))))
+		}
+	}
+	if m.err != nil {
+		fmt.Fprintf(&b, "\n%s", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return b.String()
+}
+
+// Run runs the model and returns the entered value. It implements ui.Prompt[string].
+func (m *Model) Run(ctx context.Context) (string, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return "", err
+	}
+	return m.Value(), nil
+}
+
+// RunAccessible lists the options as numbered lines and asks for a number or a value instead of using the terminal
+// UI. An empty answer keeps the current value. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	if m.label != "" {
+		p.Println(m.label)
+	}
+	for i, item := range m.items {
+		p.Println(fmt.Sprintf("%3d) %s", i+1, item))
+	}
+
+	prompt := "Choose a number or enter a value: "
+	if m.restrict {
+		prompt = "Choose a number or name: "
+	}
+	for {
+		s, err := p.Line(prompt, m.Value())
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+		if n, err := strconv.Atoi(strings.TrimSpace(s)); err == nil && n >= 1 && n <= len(m.items) {
+			s = m.items[n-1]
+		}
+		if err := m.SetAnswer(s); err != nil {
+			p.Println(fmt.Sprintf("Error: %v", err))
+			continue
+		}
+		return nil
+	}
+}
+
+// Showcase demonstrates the Model component with a free and a restricted combobox.
+func Showcase() {
+	fmt.Println("=== Combobox Showcase ===")
+
+	languages := []string{"Go", "Rust", "Python", "TypeScript", "JavaScript", "Java", "Kotlin", "C", "C++", "Zig"}
+	lang, err := New("Language (↓ opens the options, or type a new one):", languages).Run(context.Background())
+	if ui.Handle(err, ui.HandleOptions{}) != nil {
+		return
+	}
+	fmt.Printf("Language: %s\n", lang)
+
+	zones := []string{"Europe/Berlin", "Europe/London", "America/New_York", "America/Los_Angeles", "Asia/Tokyo",
+		"Asia/Singapore", "Australia/Sydney", "UTC"}
+	zone, err := New("Time zone:", zones).WithRestrict(true).WithPlaceholder("type to filter").
+		Run(context.Background())
+	if ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Time zone: %s\n", zone)
+	}
+}
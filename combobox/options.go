@@ -0,0 +1,122 @@
+package combobox
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	newModel.filter()
+	return &newModel
+}
+
+// Label sets the label shown in front of the text field.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Items sets the options listed in the dropdown.
+func Items(items []string) Option {
+	return func(m *Model) {
+		m.items = items
+	}
+}
+
+// Value sets the initial value.
+func Value(value string) Option {
+	return func(m *Model) {
+		m.valueInput.SetValue(value)
+		m.valueInput.CursorEnd()
+	}
+}
+
+// Restrict sets whether only the options are accepted. Typed values are then matched against the options ignoring
+// case, and the first matching option is highlighted while typing.
+func Restrict(restrict bool) Option {
+	return func(m *Model) {
+		m.restrict = restrict
+	}
+}
+
+// Placeholder sets the placeholder shown while the text field is empty.
+func Placeholder(s string) Option {
+	return func(m *Model) {
+		m.valueInput.Placeholder = s
+	}
+}
+
+// Height sets the number of visible options in the dropdown.
+func Height(height int) Option {
+	return func(m *Model) {
+		m.height = max(1, height)
+	}
+}
+
+// Validator sets the validator the value must pass before it is accepted.
+func Validator(v ui.Validator[string]) Option {
+	return func(m *Model) {
+		m.validator = v
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
@@ -0,0 +1,42 @@
+package combobox
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Label        lipgloss.Style // Label is the style of the label.
+	Item         lipgloss.Style // Item is the style of the options in the dropdown.
+	SelectedItem lipgloss.Style // SelectedItem is the style of the highlighted option.
+	Match        lipgloss.Style // Match is the style of the entered text within the options.
+	Cursor       lipgloss.Style // Cursor is the style of the glyph marking the highlighted option.
+	Status       lipgloss.Style // Status is the style of the scroll position and the hint on missing options.
+	Error        lipgloss.Style // Error is the style of the error shown below the model.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:        lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Item:         lipgloss.NewStyle().Foreground(t.Text),
+		SelectedItem: lipgloss.NewStyle().Foreground(t.Success),
+		Match:        lipgloss.NewStyle().Foreground(t.Accent).Bold(true),
+		Cursor:       lipgloss.NewStyle().Foreground(t.Accent),
+		Status:       lipgloss.NewStyle().Faint(true),
+		Error:        t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
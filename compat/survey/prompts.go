@@ -0,0 +1,199 @@
+package survey
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/checkboxgroup"
+	"github.com/nmeilick/go-ui/confirm"
+	"github.com/nmeilick/go-ui/input"
+	"github.com/nmeilick/go-ui/password"
+	"github.com/nmeilick/go-ui/pick"
+)
+
+// Prompt is a question that can be asked with Ask or AskOne. It is implemented by Input, Password, Confirm, Select
+// and MultiSelect.
+type Prompt interface {
+	// ask runs the prompt until its answer passes validation and returns it.
+	ask(ctx context.Context, o *askOptions) (any, error)
+}
+
+// OptionAnswer is the answer to a Select, which validators and transformers receive. MultiSelect answers are passed
+// as a slice of them.
+type OptionAnswer struct {
+	Value string // Value is the selected option.
+	Index int    // Index is the index of the selected option.
+}
+
+// Input asks for a line of text.
+type Input struct {
+	Message string                           // Message is shown in front of the input.
+	Default string                           // Default is the initial value.
+	Help    string                           // Help is accepted for compatibility; the key bindings are shown instead.
+	Suggest func(toComplete string) []string // Suggest returns the completions, it is called once with "".
+}
+
+// ask implements Prompt.
+func (p *Input) ask(ctx context.Context, o *askOptions) (any, error) {
+	var suggestions []string
+	if p.Suggest != nil {
+		suggestions = p.Suggest("")
+	}
+	m := input.New(p.Message+" ", p.Default, suggestions...).
+		WithProgramOptions(o.programOptions...)
+	if v := o.validator(); v != nil {
+		m = m.WithValidator(ui.ValidatorFunc[string](func(s string) error {
+			return v(s)
+		}))
+	}
+	return m.Run(ctx)
+}
+
+// Password asks for a secret, which is masked while typing.
+type Password struct {
+	Message string // Message is shown above the input.
+	Help    string // Help is accepted for compatibility; the key bindings are shown instead.
+}
+
+// ask implements Prompt.
+func (p *Password) ask(ctx context.Context, o *askOptions) (any, error) {
+	m := password.New(p.Message, password.ShowStrength(false), password.ProgramOptions(o.programOptions...))
+	if v := o.validator(); v != nil {
+		m = m.With(password.Validator(ui.ValidatorFunc[[]byte](func(pw []byte) error {
+			return v(string(pw))
+		})))
+	}
+	pw, err := m.Run(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return string(pw), nil
+}
+
+// Confirm asks a yes/no question.
+type Confirm struct {
+	Message string // Message is the question.
+	Default bool   // Default is the initially selected answer.
+	Help    string // Help is accepted for compatibility; the key bindings are shown instead.
+}
+
+// ask implements Prompt.
+func (p *Confirm) ask(ctx context.Context, o *askOptions) (any, error) {
+	m := confirm.New(p.Message, confirm.Default(p.Default), confirm.ProgramOptions(o.programOptions...))
+	return retry[bool](ctx, m, o)
+}
+
+// Select asks to pick one of the options.
+type Select struct {
+	Message  string   // Message is shown above the options.
+	Options  []string // Options are the choices.
+	Default  any      // Default is the initially selected option, either as a string or as an index.
+	Help     string   // Help is accepted for compatibility; the key bindings are shown instead.
+	PageSize int      // PageSize is accepted for compatibility; all options are shown.
+}
+
+// ask implements Prompt.
+func (p *Select) ask(ctx context.Context, o *askOptions) (any, error) {
+	if len(p.Options) == 0 {
+		return nil, fmt.Errorf("%s: no options to select from", p.Message)
+	}
+	idx := 0
+	if p.Default != nil {
+		i, err := optionIndex(p.Default, p.Options)
+		if err != nil {
+			return nil, fmt.Errorf("%s: default: %w", p.Message, err)
+		}
+		idx = i
+	}
+	m := pick.New(p.Options, pick.Label(p.Message), pick.SelectedIndex(idx), pick.ProgramOptions(o.programOptions...))
+	prompt := errorPrompt[OptionAnswer]{m, func(ctx context.Context) (OptionAnswer, error) {
+		i, err := m.Run(ctx)
+		if err != nil {
+			return OptionAnswer{}, err
+		}
+		return OptionAnswer{Value: p.Options[i], Index: i}, nil
+	}}
+	return retry[OptionAnswer](ctx, prompt, o)
+}
+
+// MultiSelect asks to check any number of the options.
+type MultiSelect struct {
+	Message  string   // Message is shown above the options.
+	Options  []string // Options are the choices.
+	Default  any      // Default holds the initially checked options, as a []string, a []int or a single string.
+	Help     string   // Help is accepted for compatibility; the key bindings are shown instead.
+	PageSize int      // PageSize is accepted for compatibility; all options are shown.
+}
+
+// ask implements Prompt.
+func (p *MultiSelect) ask(ctx context.Context, o *askOptions) (any, error) {
+	items := checkboxgroup.Strings(p.Options...)
+	var defaults []any
+	switch d := p.Default.(type) {
+	case nil:
+	case []string:
+		for _, v := range d {
+			defaults = append(defaults, v)
+		}
+	case []int:
+		for _, v := range d {
+			defaults = append(defaults, v)
+		}
+	default:
+		defaults = []any{d}
+	}
+	for _, d := range defaults {
+		i, err := optionIndex(d, p.Options)
+		if err != nil {
+			return nil, fmt.Errorf("%s: default: %w", p.Message, err)
+		}
+		items[i].Checked = true
+	}
+	m := checkboxgroup.New(p.Message, items, checkboxgroup.ProgramOptions(o.programOptions...))
+	prompt := errorPrompt[[]OptionAnswer]{m, func(ctx context.Context) ([]OptionAnswer, error) {
+		if _, err := m.Run(ctx); err != nil {
+			return nil, err
+		}
+		answers := []OptionAnswer{}
+		for i, item := range items {
+			if item.Checked {
+				answers = append(answers, OptionAnswer{Value: p.Options[i], Index: i})
+			}
+		}
+		return answers, nil
+	}}
+	return retry[[]OptionAnswer](ctx, prompt, o)
+}
+
+// optionIndex returns the index of the option given as a string or an index.
+func optionIndex(v any, options []string) (int, error) {
+	switch v := v.(type) {
+	case string:
+		if i := slices.Index(options, v); i >= 0 {
+			return i, nil
+		}
+		return 0, fmt.Errorf("unknown option %q", v)
+	case int:
+		if v >= 0 && v < len(options) {
+			return v, nil
+		}
+		return 0, fmt.Errorf("option index %d out of range", v)
+	}
+	return 0, fmt.Errorf("expected an option or an index, got %T", v)
+}
+
+// errorPrompt is a prompt converting the answer of a model that displays validation errors for ui.Retry.
+type errorPrompt[T any] struct {
+	ui.ErrorSetter
+	ui.PromptFunc[T]
+}
+
+// retry runs the prompt until its answer passes the validators of the options.
+func retry[T any](ctx context.Context, prompt ui.Prompt[T], o *askOptions) (any, error) {
+	if v := o.validator(); v != nil {
+		prompt = ui.Retry(prompt, func(ans T) error { return v(ans) }, 0)
+	}
+	return prompt.Run(ctx)
+}
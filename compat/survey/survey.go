@@ -0,0 +1,243 @@
+// Package survey provides the API of github.com/AlecAivazis/survey/v2 backed by go-ui components, so that projects
+// can migrate by replacing the import path:
+//
+//	name := ""
+//	err := survey.AskOne(&survey.Input{Message: "Name:"}, &name, survey.WithValidator(survey.Required))
+//
+// Input, Password, Confirm, Select and MultiSelect are supported, as well as validators, transformers and Ask with
+// struct or map responses. Canceling a prompt with esc or ctrl+c returns ErrInterrupt, like terminal.InterruptErr.
+package survey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+)
+
+// ErrInterrupt is returned if the user cancels or quits a prompt.
+var ErrInterrupt = errors.New("interrupt")
+
+// Question is a prompt with the name of the field or map key its answer is stored in by Ask.
+type Question struct {
+	Name      string      // Name matches the field by its survey tag or, ignoring case, by its name.
+	Prompt    Prompt      // Prompt asks the question.
+	Validate  Validator   // Validate validates the answer, the prompt is repeated until it passes.
+	Transform Transformer // Transform converts the answer before it is stored.
+}
+
+// AskOpt configures Ask and AskOne.
+type AskOpt func(*askOptions)
+
+// askOptions holds the settings of a call of Ask or AskOne.
+type askOptions struct {
+	validators     []Validator         // validators validate every answer.
+	programOptions []tea.ProgramOption // programOptions are passed to the programs running the prompts.
+	ctx            context.Context     // ctx is passed to the prompts.
+}
+
+// validator returns the combined validators of the options, or nil if there are none.
+func (o *askOptions) validator() Validator {
+	if len(o.validators) == 0 {
+		return nil
+	}
+	return ComposeValidators(o.validators...)
+}
+
+// WithValidator adds a validator applied to the answers.
+func WithValidator(v Validator) AskOpt {
+	return func(o *askOptions) {
+		o.validators = append(o.validators, v)
+	}
+}
+
+// WithStdio sets the streams the prompts read from and write to. Errors are written to out, so errOut is only
+// accepted for compatibility.
+func WithStdio(in io.Reader, out io.Writer, errOut io.Writer) AskOpt {
+	return func(o *askOptions) {
+		o.programOptions = append(o.programOptions, ui.WithInput(in), ui.WithOutput(out))
+	}
+}
+
+// WithProgramOptions passes the given options, e.g. ui.WithAccessible, to the programs running the prompts.
+func WithProgramOptions(opts ...tea.ProgramOption) AskOpt {
+	return func(o *askOptions) {
+		o.programOptions = append(o.programOptions, opts...)
+	}
+}
+
+// WithContext sets the context the prompts are run with. Prompts are canceled once it is done.
+func WithContext(ctx context.Context) AskOpt {
+	return func(o *askOptions) {
+		o.ctx = ctx
+	}
+}
+
+// newAskOptions returns the settings configured by the given options.
+func newAskOptions(opts []AskOpt) *askOptions {
+	o := &askOptions{ctx: context.Background()}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// AskOne asks a single question and stores the answer in response, which must be a pointer, e.g. a *string for an
+// Input or a Select, an *int for the index of a Select or a *[]string for a MultiSelect.
+func AskOne(p Prompt, response any, opts ...AskOpt) error {
+	return Ask([]*Question{{Prompt: p}}, response, opts...)
+}
+
+// Ask asks the questions in order and stores the answers in response, which must be a pointer to a struct or a map
+// with string keys. If there is only a single question without a name, response can also point to the answer
+// directly, like with AskOne.
+func Ask(qs []*Question, response any, opts ...AskOpt) error {
+	rv := reflect.ValueOf(response)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("response must be a non-nil pointer, got %T", response)
+	}
+	o := newAskOptions(opts)
+	for _, q := range qs {
+		qo := *o
+		if q.Validate != nil {
+			qo.validators = append(append([]Validator(nil), o.validators...), q.Validate)
+		}
+		ans, err := q.Prompt.ask(o.ctx, &qo)
+		if err != nil {
+			if errors.Is(err, ui.CanceledError) || errors.Is(err, ui.QuitError) {
+				return ErrInterrupt
+			}
+			return err
+		}
+		if q.Transform != nil {
+			ans = q.Transform(ans)
+		}
+		if err := store(rv.Elem(), q.Name, ans); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// store stores the answer in the field or map key of dst with the given name, or in dst itself if name is empty.
+func store(dst reflect.Value, name string, ans any) error {
+	if name == "" {
+		return assign(dst, ans)
+	}
+	switch dst.Kind() {
+	case reflect.Map:
+		if dst.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("%s: response map must have string keys", name)
+		}
+		if dst.IsNil() {
+			dst.Set(reflect.MakeMap(dst.Type()))
+		}
+		v := reflect.New(dst.Type().Elem()).Elem()
+		if err := assign(v, ans); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		dst.SetMapIndex(reflect.ValueOf(name).Convert(dst.Type().Key()), v)
+		return nil
+	case reflect.Struct:
+		t := dst.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if tag, ok := f.Tag.Lookup("survey"); (ok && tag == name) || (!ok && strings.EqualFold(f.Name, name)) {
+				if err := assign(dst.Field(i), ans); err != nil {
+					return fmt.Errorf("%s: %w", name, err)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: no matching field in %s", name, t)
+	}
+	return fmt.Errorf("%s: response must point to a struct or a map, got %s", name, dst.Type())
+}
+
+// assign stores the answer in dst, converting it to the type of dst.
+func assign(dst reflect.Value, ans any) error {
+	if !dst.CanSet() {
+		return fmt.Errorf("cannot set unexported field")
+	}
+	switch a := ans.(type) {
+	case OptionAnswer:
+		if isInt(dst.Kind()) {
+			dst.SetInt(int64(a.Index))
+			return nil
+		}
+		return assign(dst, a.Value)
+	case []OptionAnswer:
+		if dst.Kind() == reflect.Slice && isInt(dst.Type().Elem().Kind()) {
+			s := reflect.MakeSlice(dst.Type(), len(a), len(a))
+			for i, o := range a {
+				s.Index(i).SetInt(int64(o.Index))
+			}
+			dst.Set(s)
+			return nil
+		}
+		values := make([]string, len(a))
+		for i, o := range a {
+			values[i] = o.Value
+		}
+		return assign(dst, values)
+	}
+
+	v := reflect.ValueOf(ans)
+	if v.Type().AssignableTo(dst.Type()) {
+		dst.Set(v)
+		return nil
+	}
+	if s, ok := ans.(string); ok {
+		switch k := dst.Kind(); {
+		case k == reflect.String:
+			dst.SetString(s)
+			return nil
+		case k == reflect.Bool:
+			b, err := strconv.ParseBool(s)
+			if err == nil {
+				dst.SetBool(b)
+			}
+			return err
+		case isInt(k):
+			n, err := strconv.ParseInt(s, 10, dst.Type().Bits())
+			if err == nil {
+				dst.SetInt(n)
+			}
+			return err
+		case k == reflect.Float32 || k == reflect.Float64:
+			f, err := strconv.ParseFloat(s, dst.Type().Bits())
+			if err == nil {
+				dst.SetFloat(f)
+			}
+			return err
+		}
+	}
+	if v.Type().ConvertibleTo(dst.Type()) && v.Kind() == dst.Kind() {
+		dst.Set(v.Convert(dst.Type()))
+		return nil
+	}
+	if dst.Kind() == reflect.Slice && v.Kind() == reflect.Slice && dst.Type().Elem().Kind() == reflect.String {
+		s := reflect.MakeSlice(dst.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			s.Index(i).SetString(fmt.Sprint(v.Index(i).Interface()))
+		}
+		dst.Set(s)
+		return nil
+	}
+	return fmt.Errorf("cannot store %T in %s", ans, dst.Type())
+}
+
+// isInt reports whether k is a signed integer kind.
+func isInt(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
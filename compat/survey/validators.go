@@ -0,0 +1,101 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// Validator validates an answer: a string for Input and Password, a bool for Confirm, an OptionAnswer for Select and
+// a []OptionAnswer for MultiSelect.
+type Validator func(ans any) error
+
+// Transformer converts an answer before it is stored.
+type Transformer func(ans any) any
+
+// Required rejects empty answers, i.e. empty strings and selections without any checked option.
+func Required(ans any) error {
+	v := reflect.ValueOf(ans)
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+		if v.Len() == 0 {
+			return errors.New("value is required")
+		}
+	case reflect.Invalid:
+		return errors.New("value is required")
+	}
+	return nil
+}
+
+// MinLength returns a validator rejecting strings with less than length characters.
+func MinLength(length int) Validator {
+	return func(ans any) error {
+		if s, ok := ans.(string); ok && utf8.RuneCountInString(s) < length {
+			return fmt.Errorf("value is too short, min length is %d", length)
+		}
+		return nil
+	}
+}
+
+// MaxLength returns a validator rejecting strings with more than length characters.
+func MaxLength(length int) Validator {
+	return func(ans any) error {
+		if s, ok := ans.(string); ok && utf8.RuneCountInString(s) > length {
+			return fmt.Errorf("value is too long, max length is %d", length)
+		}
+		return nil
+	}
+}
+
+// MinItems returns a validator rejecting MultiSelect answers with less than n checked options.
+func MinItems(n int) Validator {
+	return func(ans any) error {
+		if a, ok := ans.([]OptionAnswer); ok && len(a) < n {
+			return fmt.Errorf("at least %d options must be selected", n)
+		}
+		return nil
+	}
+}
+
+// MaxItems returns a validator rejecting MultiSelect answers with more than n checked options.
+func MaxItems(n int) Validator {
+	return func(ans any) error {
+		if a, ok := ans.([]OptionAnswer); ok && len(a) > n {
+			return fmt.Errorf("at most %d options can be selected", n)
+		}
+		return nil
+	}
+}
+
+// ComposeValidators returns a validator that passes if all given validators pass. It returns the first error
+// encountered.
+func ComposeValidators(validators ...Validator) Validator {
+	return func(ans any) error {
+		for _, v := range validators {
+			if err := v(ans); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// ToLower is a transformer converting string answers to lower case.
+func ToLower(ans any) any {
+	if s, ok := ans.(string); ok {
+		return strings.ToLower(s)
+	}
+	return ans
+}
+
+// ComposeTransformers returns a transformer applying the given transformers in order.
+func ComposeTransformers(transformers ...Transformer) Transformer {
+	return func(ans any) any {
+		for _, t := range transformers {
+			ans = t(ans)
+		}
+		return ans
+	}
+}
@@ -0,0 +1,519 @@
+package confirm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"       // Manages key bindings
+	"github.com/charmbracelet/bubbles/textinput" // Provides text input model
+	tea "github.com/charmbracelet/bubbletea"     // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/pick"
+)
+
+var _ ui.Prompt[bool] = (*Model)(nil)
+
+// Model represents a yes/no confirmation, showing the choices with a horizontal pick model. In dangerous mode, the affirmative is only accepted after typing a phrase,
+// e.g. the name of the resource to delete.
+type Model struct {
+	label          string              // label is the question to confirm.
+	affirmative    string              // affirmative is the text of the affirmative choice.
+	negative       string              // negative is the text of the negative choice.
+	yesKey         string              // yesKey is the shortcut choosing the affirmative.
+	noKey          string              // noKey is the shortcut choosing the negative.
+	value          bool                // value is the currently selected choice.
+	choices        *pick.Model         // choices shows the affirmative and negative choice and moves the selection.
+	phrase         string              // phrase must be typed to accept the affirmative; empty if not in dangerous mode
+	phraseInput    textinput.Model     // phraseInput reads the phrase in dangerous mode.
+	typing         bool                // typing indicates whether the phrase is being typed.
+	cancelable     bool                // cancelable determines if the confirmation can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	id             string              // id identifies the prompt, e.g. for preset answers
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                // focused determines if the model handles key messages
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error               // err is shown below the model, e.g. why the previous answer was rejected
+
+	canceled bool // canceled indicates whether the confirmation was canceled
+	quit     bool // quit indicates whether the confirmation was quit
+}
+
+// New creates and returns a new Model asking the given question, configured by the given options. The negative
+// choice is selected by default.
+func New(label string, opts ...Option) *Model {
+	ti := textinput.New()
+	ti.KeyMap = ui.DefaultTextKeyMap()
+	ti.Prompt = ""
+	ti.CharLimit = 256
+	ti.Width = 40
+
+	m := &Model{
+		label:       label,
+		affirmative: "Yes",
+		negative:    "No",
+		yesKey:      "y",
+		noKey:       "n",
+		choices:     pick.New(nil, pick.Horizontal(), pick.Embedded()),
+		phraseInput: ti,
+		cancelable:  true,
+		quitable:    true,
+		focused:     true,
+		keymap:      ui.DefaultKeyMap(),
+		styles:      DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithLabel sets the question to confirm and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithAffirmative sets the text of the affirmative choice and returns a new Model with the updated text.
+func (m *Model) WithAffirmative(s string) *Model {
+	return m.With(Affirmative(s))
+}
+
+// WithNegative sets the text of the negative choice and returns a new Model with the updated text.
+func (m *Model) WithNegative(s string) *Model {
+	return m.With(Negative(s))
+}
+
+// WithShortcuts sets the keys choosing the affirmative and the negative instead of y and n, e.g. d and k for "Delete"
+// and "Keep", and returns a new Model with the updated shortcuts.
+func (m *Model) WithShortcuts(yes, no string) *Model {
+	return m.With(Shortcuts(yes, no))
+}
+
+// WithDefault sets the initially selected choice and returns a new Model with the updated choice.
+func (m *Model) WithDefault(value bool) *Model {
+	return m.With(Default(value))
+}
+
+// WithPhrase enables the dangerous mode, in which the affirmative is only accepted after typing the given phrase, and
+// returns a new Model with the updated phrase. An empty phrase disables the dangerous mode.
+func (m *Model) WithPhrase(phrase string) *Model {
+	return m.With(Phrase(phrase))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Value returns whether the affirmative is selected.
+func (m *Model) Value() bool {
+	return m.value
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is a bool or a string like "yes" or "n". In dangerous mode, the
+// affirmative must be given as the phrase. It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	var value bool
+	switch v := v.(type) {
+	case bool:
+		value = v
+	case string:
+		switch {
+		case m.phrase != "" && v == m.phrase:
+			value = true
+		case isYes(v, m.affirmative):
+			value = true
+		case isNo(v, m.negative):
+			value = false
+		default:
+			return fmt.Errorf("invalid answer: %v", v)
+		}
+	default:
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+	if value && m.phrase != "" && v != m.phrase {
+		return fmt.Errorf("answer with %q to confirm", m.phrase)
+	}
+	m.value = value
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the selected choice, or the phrase if the affirmative is selected in dangerous mode. It implements
+// ui.AnswerableModel.
+func (m *Model) Answer() any {
+	if m.value && m.phrase != "" {
+		return m.phrase
+	}
+	return m.value
+}
+
+// Choices returns the texts of the affirmative and negative choice. It implements ui.ChoiceModel.
+func (m *Model) Choices() []string {
+	return []string{m.affirmative, m.negative}
+}
+
+// Focus focuses the model, so that it handles key messages. It returns the command starting the cursor blink while
+// the phrase is typed.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	if m.typing {
+		return m.phraseInput.Focus()
+	}
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+	m.phraseInput.Blur()
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns a nil command.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// choose finishes the model with the given choice. In dangerous mode, choosing the affirmative starts typing the
+// phrase instead.
+func (m *Model) choose(value bool) tea.Cmd {
+	m.value = value
+	if value && m.phrase != "" {
+		m.typing = true
+		m.phraseInput.SetValue("")
+		return m.phraseInput.Focus()
+	}
+	m.canceled, m.quit = false, false
+	return m.done()
+}
+
+// picker returns the pick model showing the choices, updated with the label, the selected choice, the key bindings
+// and the styles of the model. The pick model is copied, so that copies of the model do not share it.
+func (m *Model) picker() *pick.Model {
+	idx := 1
+	if m.value {
+		idx = 0
+	}
+	m.choices = m.choices.With(
+		pick.Items(m.affirmative, m.negative),
+		pick.SelectedIndex(idx),
+		pick.Label(m.label),
+		pick.KeyMap(m.keymap),
+		pick.Styled(pick.Styles{
+			Label:        m.styles.Label,
+			SelectedItem: m.styles.SelectedChoice,
+			NormalItem:   m.styles.NormalChoice,
+			Error:        m.styles.Error,
+		}),
+	)
+	return m.choices
+}
+
+// updateChoices passes a message moving the selection to the pick model and finishes the model if the pick model
+// picked the selected choice, e.g. when it was clicked.
+func (m *Model) updateChoices(msg tea.Msg) tea.Cmd {
+	p := m.picker()
+	_, cmd := p.Update(msg)
+	m.value = p.SelectedIdx() == 0
+	if cmd != nil {
+		// The pick model only returns a command, emitting its DoneMsg, when a choice was picked.
+		return m.choose(m.value)
+	}
+	return nil
+}
+
+// Update handles key messages, selecting and confirming a choice or typing the phrase in dangerous mode. The pick
+// model showing the choices moves the selection, also with the mouse. Window size messages determine the width the
+// label is wrapped at.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.picker().Update(msg)
+	case ui.ThemeMsg:
+		m.restyle(msg.Theme)
+		return m, nil
+	case ui.MouseMsg:
+		if m.focused && !m.typing {
+			return m, m.updateChoices(msg)
+		}
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !m.focused {
+		if m.typing {
+			var cmd tea.Cmd
+			m.phraseInput, cmd = m.phraseInput.Update(msg)
+			return m, cmd
+		}
+		return m, nil
+	}
+
+	if key.Matches(keyMsg, m.keymap.Quit) {
+		if m.quitable {
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m, m.done()
+		}
+		return m, nil
+	}
+
+	if m.typing {
+		switch {
+		case key.Matches(keyMsg, m.keymap.Confirm):
+			if m.phraseInput.Value() != m.phrase {
+				m.err = fmt.Errorf("type %q to confirm", m.phrase)
+				return m, nil
+			}
+			m.err = nil
+			m.canceled, m.quit = false, false
+			return m, m.done()
+		case key.Matches(keyMsg, m.keymap.Cancel):
+			// Leaving the phrase returns to the choice instead of canceling the prompt.
+			m.typing, m.value, m.err = false, false, nil
+			m.phraseInput.Blur()
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.phraseInput, cmd = m.phraseInput.Update(msg)
+		return m, cmd
+	}
+
+	switch {
+	case shortcut(keyMsg.String(), m.yesKey):
+		return m, m.choose(true)
+	case shortcut(keyMsg.String(), m.noKey):
+		return m, m.choose(false)
+	case key.Matches(keyMsg, m.keymap.Prev), key.Matches(keyMsg, m.keymap.Next):
+		return m, m.updateChoices(msg)
+	case key.Matches(keyMsg, m.keymap.Confirm):
+		return m, m.choose(m.value)
+	case key.Matches(keyMsg, m.keymap.Cancel):
+		if m.cancelable {
+			m.canceled, m.quit = true, false
+			return m, m.done()
+		}
+	}
+	return m, nil
+}
+
+// shortcut returns whether s is the given shortcut, ignoring case. An empty shortcut matches nothing.
+func shortcut(s, key string) bool {
+	return key != "" && strings.EqualFold(strings.TrimSpace(s), key)
+}
+
+// View renders the question and the choices with the pick model, and the phrase input while typing it.
+func (m *Model) View() string {
+	var b strings.Builder
+	b.WriteString(m.picker().View())
+
+	if m.typing {
+		fmt.Fprintf(&b, "\n%s %s", m.styles.Phrase.Render(fmt.Sprintf("Type %q to confirm:", m.phrase)),
+			m.phraseInput.View())
+	}
+	if m.err != nil {
+		fmt.Fprintf(&b, "\n%s", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return b.String()
+}
+
+// Run runs the model and returns whether the affirmative was confirmed. It implements ui.Prompt[bool].
+func (m *Model) Run(ctx context.Context) (bool, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return false, err
+	}
+	return m.value, nil
+}
+
+// RunAccessible asks for the shortcuts, y or n by default, or the text of the choices if the shortcuts are disabled,
+// instead of using the terminal UI, and for the phrase in dangerous mode. An empty answer keeps the current choice. It
+// implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	// Without shortcuts, the choices are answered by their text, and no hint is shown.
+	yes, no, prompt := m.affirmative, m.negative, m.label+" "
+	if m.yesKey != "" && m.noKey != "" {
+		yes, no, prompt = m.yesKey, m.noKey, fmt.Sprintf("%s (%s/%s) ", m.label, m.yesKey, m.noKey)
+	}
+	def := no
+	if m.value {
+		def = yes
+	}
+	for {
+		s, err := p.Line(prompt, def)
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+
+		switch {
+		case isYes(s, m.affirmative) || shortcut(s, m.yesKey):
+			if m.phrase != "" {
+				s, err := p.Line(fmt.Sprintf("Type %q to confirm: ", m.phrase), "")
+				switch {
+				case errors.Is(err, io.EOF):
+					m.canceled, m.quit = true, false
+					return nil
+				case err != nil:
+					return err
+				case s != m.phrase:
+					p.Println(fmt.Sprintf("Error: type %q to confirm", m.phrase))
+					continue
+				}
+			}
+			m.value = true
+		case isNo(s, m.negative) || shortcut(s, m.noKey):
+			m.value = false
+		default:
+			p.Println(fmt.Sprintf("Error: answer %s or %s", yes, no))
+			continue
+		}
+		m.canceled, m.quit = false, false
+		return nil
+	}
+}
+
+// isYes returns whether s is an affirmative answer.
+func isYes(s, affirmative string) bool {
+	s = strings.TrimSpace(s)
+	return strings.EqualFold(s, "y") || strings.EqualFold(s, "yes") || strings.EqualFold(s, "true") ||
+		strings.EqualFold(s, affirmative)
+}
+
+// isNo returns whether s is a negative answer.
+func isNo(s, negative string) bool {
+	s = strings.TrimSpace(s)
+	return strings.EqualFold(s, "n") || strings.EqualFold(s, "no") || strings.EqualFold(s, "false") ||
+		strings.EqualFold(s, negative)
+}
+
+// Confirm asks the yes/no question and returns whether the affirmative was chosen, or an error. defaultYes selects the
+// affirmative initially.
+// Use errors.Is(ui.CanceledError) or errors.Is(ui.QuitError) to determine if the confirmation was canceled or aborting
+// of the program was requested.
+func Confirm(label string, defaultYes bool) (bool, error) {
+	return ConfirmWithOptions(nil, label, defaultYes)
+}
+
+// ConfirmWithOptions is like Confirm, but passes the given options to the program, e.g. to use the alternate screen or
+// custom input and output.
+func ConfirmWithOptions(opts []tea.ProgramOption, label string, defaultYes bool) (bool, error) {
+	return ConfirmContext(context.Background(), label, defaultYes, opts...)
+}
+
+// ConfirmContext is like ConfirmWithOptions, but aborts the confirmation when the context is done, see ui.RunContext.
+// ui.TimeoutError is returned if its deadline expired.
+func ConfirmContext(ctx context.Context, label string, defaultYes bool, opts ...tea.ProgramOption) (bool, error) {
+	return New(label).WithDefault(defaultYes).WithProgramOptions(opts...).Run(ctx)
+}
+
+// Showcase demonstrates the Model component by asking a regular and a dangerous confirmation.
+func Showcase() {
+	fmt.Println("=== Confirm Showcase ===")
+
+	fmt.Println("\nRegular confirmation (y/n, arrow keys and Enter):")
+	m := New("Continue?").WithDefault(true)
+	if err := ui.Handle(ui.Run(m), ui.HandleOptions{}); err == nil {
+		fmt.Printf("Confirmed: %t\n", m.Value())
+	}
+
+	fmt.Println("\nDangerous confirmation (type the phrase after choosing Yes):")
+	m = New("Delete database production?").WithPhrase("production")
+	if err := ui.Handle(ui.Run(m), ui.HandleOptions{}); err == nil {
+		fmt.Printf("Confirmed: %t\n", m.Value())
+	}
+}
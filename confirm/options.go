@@ -0,0 +1,114 @@
+package confirm
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Label sets the question to confirm.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Affirmative sets the text of the affirmative choice.
+func Affirmative(s string) Option {
+	return func(m *Model) {
+		m.affirmative = s
+	}
+}
+
+// Negative sets the text of the negative choice.
+func Negative(s string) Option {
+	return func(m *Model) {
+		m.negative = s
+	}
+}
+
+// Shortcuts sets the keys choosing the affirmative and the negative instead of y and n, e.g. d and k for "Delete" and
+// "Keep". Letters match regardless of case; an empty string disables the shortcut.
+func Shortcuts(yes, no string) Option {
+	return func(m *Model) {
+		m.yesKey, m.noKey = yes, no
+	}
+}
+
+// Default sets the initially selected choice.
+func Default(value bool) Option {
+	return func(m *Model) {
+		m.value = value
+	}
+}
+
+// Phrase enables the dangerous mode, in which the affirmative is only accepted after typing the given phrase, e.g.
+// the name of the resource to delete. An empty phrase disables the dangerous mode.
+func Phrase(phrase string) Option {
+	return func(m *Model) {
+		m.phrase = phrase
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
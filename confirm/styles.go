@@ -0,0 +1,38 @@
+package confirm
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Label          lipgloss.Style // Label is the style of the question.
+	SelectedChoice lipgloss.Style // SelectedChoice is the style of the selected choice.
+	NormalChoice   lipgloss.Style // NormalChoice is the style of the unselected choice.
+	Phrase         lipgloss.Style // Phrase is the style of the request to type the phrase in dangerous mode.
+	Error          lipgloss.Style // Error is the style of the error shown below the choices.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:          lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		SelectedChoice: lipgloss.NewStyle().Foreground(t.Success),
+		NormalChoice:   lipgloss.NewStyle().Foreground(t.Text),
+		Phrase:         lipgloss.NewStyle().Foreground(t.Failure),
+		Error:          t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
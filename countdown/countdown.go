@@ -0,0 +1,416 @@
+package countdown
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"      // Manages key bindings
+	"github.com/charmbracelet/bubbles/progress" // Provides progress bar model
+	tea "github.com/charmbracelet/bubbletea"    // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+)
+
+var _ ui.Prompt[bool] = (*Model)(nil)
+
+var (
+	pauseKey  = key.NewBinding(key.WithKeys(" ", "p"), key.WithHelp("space", "pause"))
+	extendKey = key.NewBinding(key.WithKeys("+", "="), key.WithHelp("+", "extend"))
+)
+
+// tickInterval is the interval in which the remaining time is redrawn.
+const tickInterval = 100 * time.Millisecond
+
+// Model represents a countdown timer with a label and a shrinking bar. When the time is up, the model finishes and
+// the callback set with OnExpire is called. The user can pause the countdown, extend it, or skip the rest of it with
+// the confirm key, e.g. to proceed before an automatic confirmation.
+type Model struct {
+	bar            progress.Model      // bar renders the remaining time.
+	label          string              // label is shown before the bar.
+	total          time.Duration       // total is the duration of the countdown, including extensions.
+	deadline       time.Time           // deadline is the time the countdown expires while it is running.
+	left           time.Duration       // left is the remaining time while the countdown is paused or not started.
+	paused         bool                // paused indicates whether the countdown is paused.
+	pausable       bool                // pausable determines if the countdown can be paused by the user.
+	extendBy       time.Duration       // extendBy is added by the extend key, 0 to disable the key.
+	showBar        bool                // showBar determines if the bar is shown.
+	onExpire       func()              // onExpire is called when the time is up.
+	expired        bool                // expired indicates whether the time is up.
+	finished       bool                // finished indicates whether the countdown expired or was skipped.
+	cancelable     bool                // cancelable determines if the countdown can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+
+	canceled bool // canceled indicates whether the countdown was canceled
+	quit     bool // quit indicates whether the countdown was quit
+}
+
+// New creates and returns a new Model counting down the given duration, configured by the given options. The
+// countdown starts when the model is initialized.
+func New(label string, d time.Duration, opts ...Option) *Model {
+	bar := progress.New(progress.WithDefaultGradient(), progress.WithoutPercentage(), progress.WithWidth(30))
+	if ui.LegacyConsole() {
+		bar.Full, bar.Empty = '#', '-'
+	}
+
+	m := &Model{
+		bar:        bar,
+		label:      label,
+		total:      max(0, d),
+		left:       max(0, d),
+		pausable:   true,
+		extendBy:   30 * time.Second,
+		showBar:    true,
+		cancelable: true,
+		quitable:   true,
+		keymap:     ui.DefaultKeyMap(),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithPausable sets whether the user can pause the countdown and returns a new Model with the updated flag.
+func (m *Model) WithPausable(pausable bool) *Model {
+	return m.With(Pausable(pausable))
+}
+
+// WithExtendBy sets the duration added by the extend key and returns a new Model with the updated duration.
+func (m *Model) WithExtendBy(d time.Duration) *Model {
+	return m.With(ExtendBy(d))
+}
+
+// WithBar sets whether the bar is shown and returns a new Model with the updated flag.
+func (m *Model) WithBar(show bool) *Model {
+	return m.With(Bar(show))
+}
+
+// WithWidth sets the width of the bar and returns a new Model with the updated width.
+func (m *Model) WithWidth(width int) *Model {
+	return m.With(Width(width))
+}
+
+// WithGradient fills the bar with a gradient between the given colors and returns a new Model with the updated
+// colors.
+func (m *Model) WithGradient(colorA, colorB string) *Model {
+	return m.With(Gradient(colorA, colorB))
+}
+
+// WithOnExpire sets the function called when the time is up and returns a new Model with the updated function.
+func (m *Model) WithOnExpire(fn func()) *Model {
+	return m.With(OnExpire(fn))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the countdown finished.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Remaining returns the remaining time.
+func (m *Model) Remaining() time.Duration {
+	if m.paused || m.deadline.IsZero() || m.finished {
+		return m.left
+	}
+	return max(0, time.Until(m.deadline))
+}
+
+// Total returns the duration of the countdown, including extensions.
+func (m *Model) Total() time.Duration {
+	return m.total
+}
+
+// Pause pauses the countdown.
+func (m *Model) Pause() {
+	if m.paused || m.finished {
+		return
+	}
+	m.left, m.paused = m.Remaining(), true
+}
+
+// Resume resumes the paused countdown.
+func (m *Model) Resume() {
+	if !m.paused {
+		return
+	}
+	m.paused = false
+	if !m.deadline.IsZero() {
+		m.deadline = time.Now().Add(m.left)
+	}
+}
+
+// Paused returns whether the countdown is paused.
+func (m *Model) Paused() bool {
+	return m.paused
+}
+
+// Extend adds the given duration to the countdown.
+func (m *Model) Extend(d time.Duration) {
+	if m.finished || d <= 0 {
+		return
+	}
+	m.total += d
+	m.left += d
+	if !m.deadline.IsZero() {
+		m.deadline = m.deadline.Add(d)
+	}
+}
+
+// Expired returns whether the time is up. It is false if the countdown was skipped or canceled.
+func (m *Model) Expired() bool {
+	return m.expired
+}
+
+// Finished returns whether the countdown expired or was skipped.
+func (m *Model) Finished() bool {
+	return m.finished
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the countdown finished. Use the model's
+// Expired, Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// tickMsg triggers redrawing a Model and checking whether its time is up.
+type tickMsg struct {
+	model *Model
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// tick returns the command scheduling the next redraw.
+func (m *Model) tick() tea.Cmd {
+	return tea.Tick(tickInterval, func(time.Time) tea.Msg {
+		return tickMsg{model: m}
+	})
+}
+
+// Init starts the countdown.
+func (m *Model) Init() tea.Cmd {
+	m.deadline = time.Now().Add(m.left)
+	return m.tick()
+}
+
+// finish stops the countdown, calling the expiry callback if the time is up, and returns the command finishing the
+// model.
+func (m *Model) finish(expired bool) tea.Cmd {
+	m.left = m.Remaining()
+	m.finished, m.expired, m.paused = true, expired, false
+	if expired && m.onExpire != nil {
+		m.onExpire()
+	}
+	return m.done()
+}
+
+// Update handles the ticks and the keys pausing, extending, skipping and canceling the countdown.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.finished {
+			return m, nil
+		}
+		switch {
+		case m.pausable && key.Matches(msg, pauseKey):
+			if m.paused {
+				m.Resume()
+			} else {
+				m.Pause()
+			}
+		case m.extendBy > 0 && key.Matches(msg, extendKey):
+			m.Extend(m.extendBy)
+		case key.Matches(msg, m.keymap.Confirm):
+			m.canceled, m.quit = false, false
+			return m, m.finish(false)
+		case key.Matches(msg, m.keymap.Cancel):
+			if m.cancelable {
+				m.canceled, m.quit = true, false
+				m.left, m.finished = m.Remaining(), true
+				return m, m.done()
+			}
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				m.left, m.finished = m.Remaining(), true
+				return m, m.done()
+			}
+		}
+	case tickMsg:
+		if msg.model != m || m.finished {
+			return m, nil
+		}
+		if !m.paused && m.Remaining() <= 0 {
+			return m, m.finish(true)
+		}
+		return m, m.tick()
+	}
+	return m, nil
+}
+
+// formatRemaining formats the remaining time as minutes and seconds, or hours, minutes and seconds. Seconds are
+// rounded up, so that zero is only shown when the time is up.
+func formatRemaining(d time.Duration) string {
+	s := int64((d + time.Second - 1) / time.Second)
+	if s >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", s/3600, s/60%60, s%60)
+	}
+	return fmt.Sprintf("%d:%02d", s/60, s%60)
+}
+
+// View renders the label, the bar, the remaining time and the available keys.
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.label != "" {
+		fmt.Fprintf(&b, "%s ", m.styles.Label.Render(m.label))
+	}
+	remaining := m.Remaining()
+	if m.showBar {
+		fraction := 0.0
+		if m.total > 0 {
+			fraction = float64(remaining) / float64(m.total)
+		}
+		b.WriteString(m.bar.ViewAs(fraction) + " ")
+	}
+	b.WriteString(m.styles.Time.Render(formatRemaining(remaining)))
+
+	switch {
+	case m.canceled || m.quit:
+		b.WriteString(" (canceled)")
+	case m.expired:
+		b.WriteString(" " + m.styles.Done.Render(ui.Glyphs().Success))
+	case m.finished:
+		b.WriteString(" (skipped)")
+	case m.paused:
+		b.WriteString(" " + m.styles.Paused.Render("(paused)"))
+	}
+
+	if !m.finished {
+		var hints []string
+		if m.pausable {
+			if m.paused {
+				hints = append(hints, "space resume")
+			} else {
+				hints = append(hints, "space pause")
+			}
+		}
+		if m.extendBy > 0 {
+			hints = append(hints, "+ add "+m.extendBy.String())
+		}
+		hints = append(hints, "enter skip")
+		b.WriteString("\n" + m.styles.Hint.Render(strings.Join(hints, " · ")))
+	}
+	return b.String() + "\n"
+}
+
+// Run runs the countdown and returns whether the time is up; it returns false if the user skipped the rest of the
+// countdown. It implements ui.Prompt[bool].
+func (m *Model) Run(ctx context.Context) (bool, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return false, err
+	}
+	return m.expired, nil
+}
+
+// RunAccessible writes the label and the duration and waits until the time is up instead of using the terminal UI.
+// No input is read, so the countdown can neither be paused nor skipped. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(_ io.Reader, out io.Writer) error {
+	label := m.label
+	if label == "" {
+		label = "Waiting"
+	}
+	fmt.Fprintf(out, "%s %s\n", label, formatRemaining(m.left))
+	time.Sleep(m.left)
+	_ = m.finish(true)
+	return nil
+}
+
+// Showcase demonstrates the Model component as a standalone timer and as the delay of an automatic confirmation.
+func Showcase() {
+	fmt.Println("=== Countdown Showcase ===")
+
+	m := New("Break ends in", 20*time.Second).WithExtendBy(10 * time.Second)
+	if expired, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		if expired {
+			fmt.Println("Time is up!")
+		} else {
+			fmt.Printf("Skipped with %s left\n", formatRemaining(m.Remaining()))
+		}
+	}
+
+	automatic := false
+	deploy := New("Deploying to production in", 10*time.Second).WithBar(false).
+		WithOnExpire(func() { automatic = true })
+	if _, err := deploy.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Deploying (automatic: %t)\n", automatic)
+	}
+}
@@ -0,0 +1,116 @@
+package countdown
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbles/progress" // Provides progress bar model
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Label sets the label shown before the bar.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Pausable sets whether the user can pause and resume the countdown with space or p. It is enabled by default.
+func Pausable(pausable bool) Option {
+	return func(m *Model) {
+		m.pausable = pausable
+	}
+}
+
+// ExtendBy sets the duration added to the countdown by the + key. The default is 30 seconds; 0 disables the key.
+func ExtendBy(d time.Duration) Option {
+	return func(m *Model) {
+		m.extendBy = max(0, d)
+	}
+}
+
+// Bar sets whether the bar is shown. It is enabled by default.
+func Bar(show bool) Option {
+	return func(m *Model) {
+		m.showBar = show
+	}
+}
+
+// Width sets the width of the bar.
+func Width(width int) Option {
+	return func(m *Model) {
+		m.bar.Width = width
+	}
+}
+
+// Gradient fills the bar with a gradient between the given colors, e.g. "#5A56E0" and "#EE6FF8".
+func Gradient(colorA, colorB string) Option {
+	return func(m *Model) {
+		progress.WithGradient(colorA, colorB)(&m.bar)
+	}
+}
+
+// OnExpire sets the function called when the time is up. It is called from the update function of the program and
+// must not block; it is not called if the countdown is skipped or canceled.
+func OnExpire(fn func()) Option {
+	return func(m *Model) {
+		m.onExpire = fn
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the countdown finished.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
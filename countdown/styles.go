@@ -0,0 +1,38 @@
+package countdown
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model. The colors of the bar are configured with the Gradient option.
+type Styles struct {
+	Label  lipgloss.Style // Label is the style of the label.
+	Time   lipgloss.Style // Time is the style of the remaining time.
+	Paused lipgloss.Style // Paused is the style of the note shown while the countdown is paused.
+	Done   lipgloss.Style // Done is the style of the glyph shown when the time is up.
+	Hint   lipgloss.Style // Hint is the style of the hint on the available keys.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:  lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Time:   lipgloss.NewStyle().Foreground(t.Text).Bold(true),
+		Paused: lipgloss.NewStyle().Foreground(t.Label),
+		Done:   lipgloss.NewStyle().Foreground(t.Success),
+		Hint:   lipgloss.NewStyle().Faint(true),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
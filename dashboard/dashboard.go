@@ -0,0 +1,514 @@
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"              // Manages key bindings
+	"github.com/charmbracelet/bubbles/progress"         // Provides progress bar model
+	bspinner "github.com/charmbracelet/bubbles/spinner" // Provides spinner animations
+	tea "github.com/charmbracelet/bubbletea"            // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"                 // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/spinner"
+)
+
+// board holds the tasks shared by a Model and its task handles.
+type board struct {
+	mu     sync.Mutex
+	tasks  []*Task
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// Task is a handle to a task shown on the dashboard. Its methods are safe for concurrent use, so a task can report
+// from its own goroutine.
+type Task struct {
+	b       *board
+	label   string        // label identifies the task.
+	status  string        // status is a short description of what the task is doing.
+	log     string        // log is the last log line of the task.
+	current int64         // current is the amount of work done.
+	total   int64         // total is the total amount of work; zero or less if the task shows no bar
+	state   spinner.State // state is the state of the task.
+	err     error         // err is the error the task finished with.
+	started time.Time     // started is the time the task was added.
+	elapsed time.Duration // elapsed is the duration of the finished task.
+}
+
+// SetLabel changes the label of the task.
+func (t *Task) SetLabel(label string) {
+	t.b.mu.Lock()
+	defer t.b.mu.Unlock()
+	t.label = label
+}
+
+// Label returns the label of the task.
+func (t *Task) Label() string {
+	t.b.mu.Lock()
+	defer t.b.mu.Unlock()
+	return t.label
+}
+
+// SetStatus sets a short description of what the task is doing, shown next to its label.
+func (t *Task) SetStatus(status string) {
+	t.b.mu.Lock()
+	defer t.b.mu.Unlock()
+	t.status = status
+}
+
+// Log sets the log line shown below the task. Only the last line of s is kept.
+func (t *Task) Log(s string) {
+	s = strings.TrimRight(s, "\r\n")
+	if i := strings.LastIndexAny(s, "\r\n"); i >= 0 {
+		s = s[i+1:]
+	}
+	t.b.mu.Lock()
+	defer t.b.mu.Unlock()
+	t.log = s
+}
+
+// Logf formats its arguments like fmt.Sprintf and sets the result as log line.
+func (t *Task) Logf(format string, args ...any) {
+	t.Log(fmt.Sprintf(format, args...))
+}
+
+// Write sets the last complete line of p as log line, so that the output of a command can be tailed. It implements
+// io.Writer.
+func (t *Task) Write(p []byte) (int, error) {
+	s := strings.TrimRight(string(p), "\r\n")
+	if s != "" {
+		t.Log(s)
+	}
+	return len(p), nil
+}
+
+// SetProgress sets the amount of work done and the total amount of work, showing a progress bar for the task. A total
+// of zero or less hides the bar.
+func (t *Task) SetProgress(current, total int64) {
+	t.b.mu.Lock()
+	defer t.b.mu.Unlock()
+	t.current, t.total = current, total
+}
+
+// Done finishes the task with the given error: nil marks it as succeeded, spinner.ErrSkip (possibly wrapped) as
+// skipped and any other error as failed. Calling Done again has no effect.
+func (t *Task) Done(err error) {
+	t.b.mu.Lock()
+	defer t.b.mu.Unlock()
+	if t.state != spinner.Running {
+		return
+	}
+	t.err, t.elapsed = err, time.Since(t.started)
+	switch {
+	case err == nil:
+		t.state = spinner.Succeeded
+	case errors.Is(err, spinner.ErrSkip):
+		t.state = spinner.Skipped
+	default:
+		t.state = spinner.Failed
+	}
+}
+
+// State returns the state of the task.
+func (t *Task) State() spinner.State {
+	t.b.mu.Lock()
+	defer t.b.mu.Unlock()
+	return t.state
+}
+
+// Err returns the error the task finished with.
+func (t *Task) Err() error {
+	t.b.mu.Lock()
+	defer t.b.mu.Unlock()
+	return t.err
+}
+
+// Context returns the context of the dashboard, which is canceled when the user cancels the dashboard. Tasks should
+// stop and call Done once it is canceled.
+func (t *Task) Context() context.Context {
+	return t.b.ctx
+}
+
+// Model represents a dashboard tracking many concurrent tasks, each shown with a spinner or progress bar, a status
+// and the last log line.
+type Model struct {
+	board          *board              // board holds the tasks; shared by copies of the model
+	frames         bspinner.Spinner    // frames is the animation of the spinners.
+	frame          int                 // frame is the current animation frame.
+	bar            progress.Model      // bar renders the progress bars of the tasks.
+	showLog        bool                // showLog determines if the last log line of each task is shown.
+	showSummary    bool                // showSummary determines if the summary line is shown.
+	cancelable     bool                // cancelable determines if the tasks can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	finished       bool                // finished indicates whether all tasks finished.
+
+	canceled bool // canceled indicates whether the tasks were canceled
+	quit     bool // quit indicates whether the tasks were quit
+}
+
+// New creates and returns a new Model without tasks, configured by the given options.
+func New(opts ...Option) *Model {
+	frames := bspinner.Dot
+	bar := progress.New(progress.WithDefaultGradient(), progress.WithoutPercentage(), progress.WithWidth(20))
+	if ui.LegacyConsole() {
+		frames = bspinner.Line
+		bar.Full, bar.Empty = '#', '-'
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Model{
+		board:       &board{ctx: ctx, cancel: cancel},
+		frames:      frames,
+		bar:         bar,
+		showLog:     true,
+		showSummary: true,
+		cancelable:  true,
+		quitable:    true,
+		keymap:      ui.DefaultKeyMap(),
+		styles:      DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithLog sets whether the last log line of each task is shown and returns a new Model with the updated flag.
+func (m *Model) WithLog(show bool) *Model {
+	return m.With(ShowLog(show))
+}
+
+// WithSummary sets whether the summary line is shown and returns a new Model with the updated flag.
+func (m *Model) WithSummary(show bool) *Model {
+	return m.With(Summary(show))
+}
+
+// WithBarWidth sets the width of the progress bars and returns a new Model with the updated width.
+func (m *Model) WithBarWidth(width int) *Model {
+	return m.With(BarWidth(width))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when all tasks finished.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Add adds a running task with the given label and returns its handle. It is safe for concurrent use.
+func (m *Model) Add(label string) *Task {
+	t := &Task{b: m.board, label: label, started: time.Now()}
+	m.board.mu.Lock()
+	defer m.board.mu.Unlock()
+	m.board.tasks = append(m.board.tasks, t)
+	return t
+}
+
+// Go adds a task with the given label and runs fn in a new goroutine, finishing the task with the error returned by
+// fn. The context passed to fn is canceled when the user cancels the dashboard.
+func (m *Model) Go(label string, fn func(ctx context.Context, t *Task) error) *Task {
+	t := m.Add(label)
+	go func() {
+		t.Done(fn(m.board.ctx, t))
+	}()
+	return t
+}
+
+// Tasks returns the handles of all tasks in the order they were added.
+func (m *Model) Tasks() []*Task {
+	m.board.mu.Lock()
+	defer m.board.mu.Unlock()
+	return append([]*Task(nil), m.board.tasks...)
+}
+
+// Err returns the errors of all failed tasks joined, each prefixed with the label of the task, or nil if no task
+// failed.
+func (m *Model) Err() error {
+	m.board.mu.Lock()
+	defer m.board.mu.Unlock()
+	var errs []error
+	for _, t := range m.board.tasks {
+		if t.state == spinner.Failed {
+			errs = append(errs, fmt.Errorf("%s: %w", t.label, t.err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// running returns the number of tasks that have not finished yet.
+func (m *Model) running() int {
+	m.board.mu.Lock()
+	defer m.board.mu.Unlock()
+	n := 0
+	for _, t := range m.board.tasks {
+		if t.state == spinner.Running {
+			n++
+		}
+	}
+	return n
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when all tasks finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// tickMsg triggers redrawing a Model.
+type tickMsg struct {
+	model *Model
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// tick returns the command scheduling the next redraw.
+func (m *Model) tick() tea.Cmd {
+	return tea.Tick(m.frames.FPS, func(time.Time) tea.Msg {
+		return tickMsg{model: m}
+	})
+}
+
+// Init starts redrawing the dashboard.
+func (m *Model) Init() tea.Cmd {
+	return m.tick()
+}
+
+// Update redraws the dashboard, finishing it once all tasks finished, and handles cancellation.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.finished {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, m.keymap.Cancel):
+			if m.cancelable {
+				m.canceled, m.quit = true, false
+				m.board.cancel()
+			}
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				m.board.cancel()
+			}
+		}
+	case tickMsg:
+		if msg.model != m || m.finished {
+			return m, nil
+		}
+		m.frame++
+		if m.running() == 0 {
+			m.finished = true
+			return m, m.done()
+		}
+		return m, m.tick()
+	}
+	return m, nil
+}
+
+// View renders a line per task, followed by its last log line, and a summary line.
+func (m *Model) View() string {
+	m.board.mu.Lock()
+	defer m.board.mu.Unlock()
+
+	width := 0
+	for _, t := range m.board.tasks {
+		width = max(width, lipgloss.Width(t.label))
+	}
+
+	var b strings.Builder
+	g := ui.Glyphs()
+	counts := map[spinner.State]int{}
+	for _, t := range m.board.tasks {
+		counts[t.state]++
+		switch t.state {
+		case spinner.Running:
+			frame := strings.TrimSpace(m.frames.Frames[m.frame%len(m.frames.Frames)])
+			b.WriteString(m.styles.Spinner.Render(frame))
+		case spinner.Succeeded:
+			b.WriteString(m.styles.Success.Render(g.Success))
+		case spinner.Failed:
+			b.WriteString(m.styles.Failure.Render(g.Failure))
+		case spinner.Skipped:
+			b.WriteString(m.styles.Skipped.Render(g.Skipped))
+		}
+		label := t.label + strings.Repeat(" ", width-lipgloss.Width(t.label))
+		fmt.Fprintf(&b, " %s", m.styles.Label.Render(label))
+
+		if t.total > 0 && t.state == spinner.Running {
+			percent := min(1, max(0, float64(t.current)/float64(t.total)))
+			fmt.Fprintf(&b, " %s %3.0f%%", m.bar.ViewAs(percent), percent*100)
+		}
+		switch {
+		case t.state == spinner.Failed:
+			fmt.Fprintf(&b, " %s", m.styles.Failure.Render(t.err.Error()))
+		case t.state == spinner.Skipped && t.err != spinner.ErrSkip:
+			fmt.Fprintf(&b, " %s", m.styles.Status.Render(t.err.Error()))
+		case t.status != "":
+			fmt.Fprintf(&b, " %s", m.styles.Status.Render(t.status))
+		}
+		if t.state != spinner.Running {
+			fmt.Fprintf(&b, " %s", m.styles.Elapsed.Render(fmt.Sprintf("(%s)", t.elapsed.Round(100*time.Millisecond))))
+		}
+		b.WriteString("\n")
+
+		if m.showLog && t.log != "" && t.state == spinner.Running {
+			fmt.Fprintf(&b, "  %s\n", m.styles.Log.Render(t.log))
+		}
+	}
+
+	if m.showSummary && len(m.board.tasks) > 0 {
+		summary := fmt.Sprintf("%d/%d done", len(m.board.tasks)-counts[spinner.Running], len(m.board.tasks))
+		if n := counts[spinner.Failed]; n > 0 {
+			summary += fmt.Sprintf(" · %d failed", n)
+		}
+		if n := counts[spinner.Skipped]; n > 0 {
+			summary += fmt.Sprintf(" · %d skipped", n)
+		}
+		if counts[spinner.Running] > 0 && (m.canceled || m.quit) {
+			summary += " · canceling..."
+		}
+		fmt.Fprintf(&b, "%s\n", m.styles.Summary.Render(summary))
+	}
+	return b.String()
+}
+
+// Run shows the dashboard until all tasks finished. It returns ui.CanceledError or ui.QuitError if the user canceled
+// the tasks, once they finished, and otherwise the joined errors of all failed tasks. Canceling ctx cancels the
+// tasks started with Go.
+func (m *Model) Run(ctx context.Context) error {
+	stop := context.AfterFunc(ctx, m.board.cancel)
+	defer stop()
+
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return err
+	}
+	return m.Err()
+}
+
+// RunAccessible waits for all tasks to finish, reporting each finished task as a plain line. It implements
+// ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	reported := map[*Task]bool{}
+	for {
+		for _, t := range m.Tasks() {
+			if reported[t] {
+				continue
+			}
+			switch label, state, err := t.Label(), t.State(), t.Err(); state {
+			case spinner.Succeeded:
+				fmt.Fprintf(out, "%s: done\n", label)
+			case spinner.Failed:
+				fmt.Fprintf(out, "%s: failed: %v\n", label, err)
+			case spinner.Skipped:
+				fmt.Fprintf(out, "%s: %v\n", label, err)
+			default:
+				continue
+			}
+			reported[t] = true
+		}
+		if m.running() == 0 {
+			m.finished = true
+			return nil
+		}
+		time.Sleep(m.frames.FPS)
+	}
+}
+
+// Showcase demonstrates the Model component with simulated parallel downloads and builds.
+func Showcase() {
+	fmt.Println("=== Dashboard Showcase ===")
+
+	m := New()
+	for i, name := range []string{"api", "web", "worker", "docs"} {
+		name, delay := name, time.Duration(i+1)*30*time.Millisecond
+		m.Go("build "+name, func(ctx context.Context, t *Task) error {
+			for step := int64(1); step <= 50; step++ {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(delay):
+				}
+				t.SetProgress(step, 50)
+				t.SetStatus(fmt.Sprintf("step %d/50", step))
+				t.Logf("compiling %s/module%02d.go", name, step)
+			}
+			switch name {
+			case "docs":
+				return fmt.Errorf("%w: no changes", spinner.ErrSkip)
+			case "worker":
+				return errors.New("test failed")
+			}
+			return nil
+		})
+	}
+	if err := m.Run(context.Background()); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+}
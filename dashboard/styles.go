@@ -0,0 +1,46 @@
+package dashboard
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model. The colors of the progress bars are configured with the Gradient option.
+type Styles struct {
+	Spinner lipgloss.Style // Spinner is the style of the spinners of running tasks.
+	Label   lipgloss.Style // Label is the style of the task labels.
+	Status  lipgloss.Style // Status is the style of the task statuses.
+	Log     lipgloss.Style // Log is the style of the last log line of each task.
+	Elapsed lipgloss.Style // Elapsed is the style of the duration of finished tasks.
+	Success lipgloss.Style // Success is the style of the glyph of succeeded tasks.
+	Failure lipgloss.Style // Failure is the style of the glyph and error of failed tasks.
+	Skipped lipgloss.Style // Skipped is the style of the glyph of skipped tasks.
+	Summary lipgloss.Style // Summary is the style of the summary line.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Spinner: lipgloss.NewStyle().Foreground(t.Accent),
+		Label:   lipgloss.NewStyle().Foreground(t.Label),
+		Status:  lipgloss.NewStyle().Foreground(t.Text),
+		Log:     lipgloss.NewStyle().Faint(true),
+		Elapsed: lipgloss.NewStyle().Faint(true),
+		Success: lipgloss.NewStyle().Foreground(t.Success),
+		Failure: lipgloss.NewStyle().Foreground(t.Failure),
+		Skipped: lipgloss.NewStyle().Foreground(t.Text),
+		Summary: lipgloss.NewStyle().Bold(true),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
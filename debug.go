@@ -0,0 +1,157 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"
+)
+
+// debugKeys is the number of key messages shown in the debug overlay.
+const debugKeys = 8
+
+var debugStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+// WithDebug enables or disables the debug overlay, which is shown below the model and lists the last key messages,
+// the state of the model and the message returned by the last command. The overlay is toggled with f12. The mode is
+// enabled by default if the environment variable UI_DEBUG is set to a true value. If UI_DEBUG_FILE names a file, the
+// same information is appended to it as well.
+func WithDebug(debug bool) tea.ProgramOption {
+	return option(func(s *settings) {
+		s.debug = debug
+	})
+}
+
+// hostModel is implemented by models running other models, so that the debug overlay can describe the hosted model.
+type hostModel interface {
+	hosted() tea.Model
+}
+
+// debugger collects the information shown in the debug overlay.
+type debugger struct {
+	mu        sync.Mutex
+	collapsed bool      // collapsed determines if the overlay is reduced to a single line.
+	keys      []string  // keys are the last key messages.
+	lastMsg   string    // lastMsg describes the message returned by the last command.
+	log       io.Writer // log receives the debug information, if UI_DEBUG_FILE is set.
+	file      *os.File  // file is the file opened for log.
+}
+
+// newDebugger returns a debugger, opening the log file named by UI_DEBUG_FILE if set.
+func newDebugger() (*debugger, error) {
+	d := &debugger{}
+	if path := os.Getenv("UI_DEBUG_FILE"); path != "" {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		d.file, d.log = f, f
+	}
+	return d, nil
+}
+
+// logf appends a line to the log file, if any. The caller must hold the lock.
+func (d *debugger) logf(format string, args ...any) {
+	if d.log != nil {
+		fmt.Fprintf(d.log, "%s "+format+"\n", append([]any{time.Now().Format("15:04:05.000")}, args...)...)
+	}
+}
+
+// key records the key message and returns whether it toggled the overlay, in which case it must not be passed on.
+func (d *debugger) key(msg tea.KeyMsg) bool {
+	if d == nil {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if msg.Type == tea.KeyF12 {
+		d.collapsed = !d.collapsed
+		return true
+	}
+	d.keys = append(d.keys, msg.String())
+	if len(d.keys) > debugKeys {
+		d.keys = d.keys[len(d.keys)-debugKeys:]
+	}
+	return false
+}
+
+// handled logs the key message along with the state of the model after handling it.
+func (d *debugger) handled(msg tea.KeyMsg, m tea.Model) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.logf("key %q -> %s", msg.String(), describeModel(m))
+}
+
+// cmd wraps the command, so that the message it returns is recorded.
+func (d *debugger) cmd(cmd tea.Cmd) tea.Cmd {
+	if d == nil || cmd == nil {
+		return cmd
+	}
+	return func() tea.Msg {
+		msg := cmd()
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		d.lastMsg = fmt.Sprintf("%T", msg)
+		d.logf("cmd -> %s", d.lastMsg)
+		return msg
+	}
+}
+
+// view returns the view with the overlay appended.
+func (d *debugger) view(view string, m tea.Model) string {
+	if d == nil {
+		return view
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.collapsed {
+		return view + "\n" + debugStyle.Render("[debug: f12 to expand]")
+	}
+	lastMsg := d.lastMsg
+	if lastMsg == "" {
+		lastMsg = "-"
+	}
+	lines := []string{
+		"[debug: f12 to collapse]",
+		"model: " + describeModel(m),
+		"keys:  " + strings.Join(d.keys, " "),
+		"cmd:   " + lastMsg,
+	}
+	return view + "\n" + debugStyle.Render(strings.Join(lines, "\n"))
+}
+
+// close closes the log file, if any.
+func (d *debugger) close() error {
+	if d == nil || d.file == nil {
+		return nil
+	}
+	return d.file.Close()
+}
+
+// describeModel returns the type and state of the model, or of the model it hosts.
+func describeModel(m tea.Model) string {
+	if h, ok := m.(hostModel); ok {
+		if hm := h.hosted(); hm != nil {
+			m = hm
+		}
+	}
+	desc := fmt.Sprintf("%T", m)
+	if am, ok := m.(AnswerableModel); ok {
+		desc += fmt.Sprintf(" key=%q answer=%v", am.Key(), am.Answer())
+	}
+	if sm, ok := m.(StandardModel); ok {
+		desc += fmt.Sprintf(" canceled=%t quit=%t", sm.Canceled(), sm.Quit())
+	}
+	if fm, ok := m.(interface{ Focused() bool }); ok {
+		desc += fmt.Sprintf(" focused=%t", fm.Focused())
+	}
+	return desc
+}
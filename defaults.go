@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// WithDefaults sets default answers keyed by prompt ID or label. In contrast to preset answers, prompts with a default
+// answer are still shown, but prefilled with the default, which the user can accept or change. Defaults that are not
+// accepted by a prompt, e.g. because the item no longer exists, are ignored.
+func WithDefaults(defaults map[string]any) tea.ProgramOption {
+	return option(func(s *settings) {
+		if s.defaults == nil {
+			s.defaults = make(map[string]any)
+		}
+		for k, v := range defaults {
+			s.defaults[k] = v
+		}
+	})
+}
+
+// WithDefaultsFile sets default answers read from the given TOML or JSON file, depending on its extension, like
+// WithDefaults. A missing file is not an error, so that the file can be optional.
+func WithDefaultsFile(path string) tea.ProgramOption {
+	return option(func(s *settings) {
+		defaults, err := LoadDefaults(path)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+		case err != nil:
+			s.err = err
+		default:
+			if s.defaults == nil {
+				s.defaults = make(map[string]any)
+			}
+			for k, v := range defaults {
+				s.defaults[k] = v
+			}
+		}
+	})
+}
+
+// LoadDefaults reads default answers from the given TOML or JSON file, depending on its extension. Files with other
+// extensions are read as TOML.
+func LoadDefaults(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defaults := make(map[string]any)
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, &defaults)
+	} else {
+		err = toml.Unmarshal(data, &defaults)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return defaults, nil
+}
+
+// UserDefaultsFile returns the path of the default answers file of the given application in the user's config
+// directory, e.g. ~/.config/<app>/answers.toml on Linux.
+func UserDefaultsFile(app string) (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, app, "answers.toml"), nil
+}
+
+// applyDefault prefills the model with its default answer, if any.
+func applyDefault(m tea.Model, defaults map[string]any) {
+	if am, ok := m.(AnswerableModel); ok {
+		if v, ok := lookupAnswer(defaults, am.Key()); ok {
+			// Stale defaults are ignored, the user can still answer interactively.
+			_ = am.SetAnswer(v)
+		}
+	}
+}
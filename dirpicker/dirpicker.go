@@ -0,0 +1,510 @@
+package dirpicker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"       // Manages key bindings
+	"github.com/charmbracelet/bubbles/textinput" // Provides text input model
+	tea "github.com/charmbracelet/bubbletea"     // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/tree"
+)
+
+var _ ui.Prompt[string] = (*Model)(nil)
+
+var newDirKey = key.NewBinding(key.WithKeys("n", "+"), key.WithHelp("n", "new directory"))
+
+// Model represents a directory chooser. It only lists directories below a root directory, lets the user create a new
+// directory inline, and returns the absolute path of the chosen directory.
+type Model struct {
+	label          string              // label is the label for the chooser.
+	root           string              // root is the absolute path of the top directory.
+	tree           *tree.Model         // tree shows the directories.
+	showHidden     bool                // showHidden determines if hidden directories are listed.
+	allowCreate    bool                // allowCreate determines if new directories can be created.
+	creating       bool                // creating indicates whether the name of a new directory is being typed.
+	nameInput      textinput.Model     // nameInput reads the name of a new directory.
+	path           string              // path is the absolute path of the chosen directory.
+	cancelable     bool                // cancelable determines if selection can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	id             string              // id identifies the prompt, e.g. for preset answers
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                // focused determines if the model handles key messages
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error               // err is shown below the model, e.g. why creating a directory failed
+
+	canceled bool // canceled indicates whether the selection was canceled
+	quit     bool // quit indicates whether the selection was quit
+}
+
+// New creates and returns a new Model listing the directories below the given root directory, configured by the given
+// options. An empty root lists the current working directory.
+func New(label, root string, opts ...Option) *Model {
+	ti := textinput.New()
+	ti.KeyMap = ui.DefaultTextKeyMap()
+	ti.CharLimit = 255
+	ti.Width = 40
+
+	m := &Model{
+		label:       label,
+		root:        root,
+		allowCreate: true,
+		nameInput:   ti,
+		cancelable:  true,
+		quitable:    true,
+		focused:     true,
+		keymap:      ui.DefaultKeyMap(),
+		styles:      DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.build()
+	return m
+}
+
+// build creates the tree of directories below the root directory.
+func (m *Model) build() {
+	root := m.root
+	if root == "" {
+		root = "."
+	}
+	if abs, err := filepath.Abs(root); err == nil {
+		root = abs
+	}
+	m.root = root
+
+	node := m.node(root)
+	node.Label, node.Expanded = root, true
+	if err := m.loadInto(node); err != nil {
+		m.err = err
+	}
+	m.tree = tree.New(m.label, []*tree.Node{node}, tree.Embedded(), tree.KeyMap(m.keymap),
+		tree.Styled(m.styles.Tree), tree.Cancel(m.cancelable), tree.Quit(m.quitable))
+}
+
+// node returns a node for the directory with the given path, loading its subdirectories lazily.
+func (m *Model) node(path string) *tree.Node {
+	n := &tree.Node{Label: filepath.Base(path), Value: path}
+	n.Load = func() ([]*tree.Node, error) {
+		return m.children(path)
+	}
+	return n
+}
+
+// loadInto loads the subdirectories of the node synchronously.
+func (m *Model) loadInto(n *tree.Node) error {
+	children, err := m.children(n.Value.(string))
+	n.Children, n.Load = children, nil
+	return err
+}
+
+// children returns nodes for the subdirectories of the given directory, sorted by name.
+func (m *Model) children(dir string) ([]*tree.Node, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*tree.Node
+	for _, e := range entries {
+		if !m.isDir(dir, e) || (!m.showHidden && strings.HasPrefix(e.Name(), ".")) {
+			continue
+		}
+		nodes = append(nodes, m.node(filepath.Join(dir, e.Name())))
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return strings.ToLower(nodes[i].Label) < strings.ToLower(nodes[j].Label)
+	})
+	return nodes, nil
+}
+
+// isDir returns whether the entry is a directory or a symbolic link to one.
+func (m *Model) isDir(dir string, e os.DirEntry) bool {
+	if e.IsDir() {
+		return true
+	}
+	if e.Type()&os.ModeSymlink == 0 {
+		return false
+	}
+	fi, err := os.Stat(filepath.Join(dir, e.Name()))
+	return err == nil && fi.IsDir()
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithRoot sets the top directory and returns a new Model listing the directories below it.
+func (m *Model) WithRoot(root string) *Model {
+	return m.With(Root(root))
+}
+
+// WithHidden sets whether hidden directories are listed and returns a new Model with the updated flag.
+func (m *Model) WithHidden(show bool) *Model {
+	return m.With(Hidden(show))
+}
+
+// WithCreate sets whether new directories can be created and returns a new Model with the updated flag.
+func (m *Model) WithCreate(allow bool) *Model {
+	return m.With(Create(allow))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Path returns the absolute path of the chosen directory, or of the selected directory while the chooser runs.
+func (m *Model) Path() string {
+	if m.path != "" {
+		return m.path
+	}
+	if n := m.tree.Selected(); n != nil {
+		return n.Value.(string)
+	}
+	return m.root
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is the path of an existing directory, relative to the root directory or
+// absolute. It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+	path, err := m.resolve(s)
+	if err != nil {
+		return err
+	}
+	m.path = path
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// resolve returns the absolute path of the given directory, which is relative to the root directory or absolute, and
+// fails if it is not an existing directory.
+func (m *Model) resolve(s string) (string, error) {
+	path := s
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(m.root, path)
+	}
+	fi, err := os.Stat(path)
+	switch {
+	case err != nil:
+		return "", err
+	case !fi.IsDir():
+		return "", fmt.Errorf("not a directory: %s", s)
+	}
+	return filepath.Clean(path), nil
+}
+
+// Answer returns the absolute path of the chosen directory. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	return m.Path()
+}
+
+// Focus focuses the model, so that it handles key messages. It returns the command starting the cursor blink while
+// the name of a new directory is typed.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	m.tree.Focus()
+	if m.creating {
+		return m.nameInput.Focus()
+	}
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+	m.tree.Blur()
+	m.nameInput.Blur()
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns a nil command.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// Update handles key messages, creating a new directory or passing them to the tree of directories.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+	}
+	switch msg := msg.(type) {
+	case tree.DoneMsg:
+		if msg.Model != m.tree {
+			return m, nil
+		}
+		m.canceled, m.quit = m.tree.Canceled(), m.tree.Quit()
+		if !m.canceled && !m.quit {
+			m.path = m.tree.Selected().Value.(string)
+		}
+		return m, m.done()
+	case tea.KeyMsg:
+		if !m.focused {
+			return m, nil
+		}
+		if m.creating {
+			return m, m.updateCreating(msg)
+		}
+		if m.allowCreate && key.Matches(msg, newDirKey) {
+			m.creating, m.err = true, nil
+			m.nameInput.SetValue("")
+			return m, m.nameInput.Focus()
+		}
+		m.err = nil
+	}
+
+	_, cmd := m.tree.Update(msg)
+	return m, cmd
+}
+
+// updateCreating handles key messages while the name of a new directory is typed.
+func (m *Model) updateCreating(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, m.keymap.Quit):
+		if m.quitable {
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m.done()
+		}
+		return nil
+	case key.Matches(msg, m.keymap.Cancel):
+		m.creating, m.err = false, nil
+		m.nameInput.Blur()
+		return nil
+	case key.Matches(msg, m.keymap.Confirm):
+		if err := m.create(m.nameInput.Value()); err != nil {
+			m.err = err
+			return nil
+		}
+		m.creating, m.err = false, nil
+		m.nameInput.Blur()
+		return nil
+	}
+	var cmd tea.Cmd
+	m.nameInput, cmd = m.nameInput.Update(msg)
+	return cmd
+}
+
+// create creates a directory with the given name in the selected directory and selects it.
+func (m *Model) create(name string) error {
+	name = strings.TrimSpace(name)
+	switch {
+	case name == "":
+		return errors.New("name is empty")
+	case name == "." || name == ".." || strings.ContainsAny(name, `/\`):
+		return fmt.Errorf("invalid name: %s", name)
+	}
+
+	parent := m.tree.Selected()
+	dir := parent.Value.(string)
+	path := filepath.Join(dir, name)
+	if err := os.Mkdir(path, 0o755); err != nil {
+		return err
+	}
+
+	if parent.Load != nil {
+		if err := m.loadInto(parent); err != nil {
+			return err
+		}
+	} else {
+		parent.Children = append(parent.Children, m.node(path))
+		sort.Slice(parent.Children, func(i, j int) bool {
+			return strings.ToLower(parent.Children[i].Label) < strings.ToLower(parent.Children[j].Label)
+		})
+	}
+	parent.Expanded = true
+	m.tree.Refresh()
+	for _, c := range parent.Children {
+		if c.Value == path {
+			m.tree.Select(c)
+		}
+	}
+	return nil
+}
+
+// View renders the tree of directories and, while creating a directory, the input for its name.
+func (m *Model) View() string {
+	var b strings.Builder
+	b.WriteString(m.tree.View())
+	if m.creating {
+		fmt.Fprintf(&b, "\n%s %s", m.styles.Prompt.Render(fmt.Sprintf("New directory in %s:", m.tree.Selected().Value)),
+			m.nameInput.View())
+	} else if m.allowCreate {
+		fmt.Fprintf(&b, "\n%s", m.styles.Hint.Render("n: new directory"))
+	}
+	if m.err != nil {
+		fmt.Fprintf(&b, "\n%s", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return b.String()
+}
+
+// Run runs the model and returns the absolute path of the chosen directory. It implements ui.Prompt[string].
+func (m *Model) Run(ctx context.Context) (string, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return "", err
+	}
+	return m.Path(), nil
+}
+
+// RunAccessible asks for the path of the directory instead of using the terminal UI, offering to create it if it does
+// not exist. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	for {
+		s, err := p.Line(m.label+" ", m.Path())
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+
+		path, err := m.resolve(s)
+		if errors.Is(err, os.ErrNotExist) && m.allowCreate {
+			if !m.confirmCreate(p, s) {
+				continue
+			}
+			if !filepath.IsAbs(s) {
+				s = filepath.Join(m.root, s)
+			}
+			if err = os.MkdirAll(s, 0o755); err == nil {
+				path, err = m.resolve(s)
+			}
+		}
+		if err != nil {
+			p.Println(fmt.Sprintf("Error: %v", err))
+			continue
+		}
+		m.path = path
+		m.canceled, m.quit = false, false
+		return nil
+	}
+}
+
+// confirmCreate asks whether the missing directory should be created.
+func (m *Model) confirmCreate(p *plain.Prompter, dir string) bool {
+	s, err := p.Line(fmt.Sprintf("%s does not exist. Create it? (y/n) ", dir), "n")
+	return err == nil && strings.EqualFold(strings.TrimSpace(s), "y")
+}
+
+// Showcase demonstrates the Model component by choosing a directory below a temporary directory.
+func Showcase() {
+	fmt.Println("=== Directory Chooser Showcase ===")
+
+	root, err := os.MkdirTemp("", "dirpicker")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	defer os.RemoveAll(root)
+	for _, dir := range []string{"opt/app", "opt/tools", "usr/local/bin", "usr/share"} {
+		_ = os.MkdirAll(filepath.Join(root, dir), 0o755)
+	}
+
+	path, err := New("Choose an install location (n creates a directory):", root).Run(context.Background())
+	if ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Chosen: %s\n", path)
+	}
+}
@@ -0,0 +1,882 @@
+package docedit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"       // Manages key bindings
+	"github.com/charmbracelet/bubbles/textinput" // Provides text input model
+	tea "github.com/charmbracelet/bubbletea"     // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/styles"
+	"github.com/nmeilick/go-ui/tree"
+	"gopkg.in/yaml.v3" // Parses and encodes YAML documents
+)
+
+var _ ui.Prompt[[]byte] = (*Model)(nil)
+
+var (
+	editKey   = key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "edit"))
+	toggleKey = key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "toggle"))
+	saveKey   = key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "save"))
+)
+
+// Format is the format of a document.
+type Format int
+
+const (
+	Auto Format = iota // Auto detects the format: documents that are valid JSON are JSON, others YAML.
+	JSON               // JSON is a JSON document.
+	YAML               // YAML is a YAML document.
+)
+
+// String returns the name of the format.
+func (f Format) String() string {
+	switch f {
+	case JSON:
+		return "JSON"
+	case YAML:
+		return "YAML"
+	default:
+		return "auto"
+	}
+}
+
+// maxValueWidth is the width at which values are truncated in the tree.
+const maxValueWidth = 60
+
+// jsonNumber matches numbers in JSON syntax, which are written to JSON documents unchanged.
+var jsonNumber = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][-+]?[0-9]+)?$`)
+
+// entry is a value of the document shown as a node of the tree.
+type entry struct {
+	key  string     // key is the key of the value in its mapping, or its index in brackets in a sequence.
+	path string     // path is the path of the value, e.g. "servers[0].port".
+	node *yaml.Node // node is the value.
+}
+
+// Model represents an editor for a JSON or YAML document. The document is shown as a tree whose leaves can be edited
+// inline; new values are validated against the type of the old value, so that a number stays a number. The modified
+// document is returned in its original format.
+type Model struct {
+	label          string              // label is the label for the editor.
+	format         Format              // format is the format of the document, Auto until the document is parsed.
+	doc            *yaml.Node          // doc is the parsed document.
+	parseErr       error               // parseErr is the error returned when parsing the document.
+	roots          []*tree.Node        // roots holds the nodes of the top-level values.
+	tree           *tree.Model         // tree shows the values of the document.
+	height         int                 // height is the number of visible rows.
+	editing        *tree.Node          // editing is the node whose value is being edited, nil if none.
+	valueInput     textinput.Model     // valueInput reads the new value of the edited node.
+	modified       bool                // modified indicates whether a value was changed.
+	cancelable     bool                // cancelable determines if editing can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	id             string              // id identifies the prompt, e.g. for preset answers
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                // focused determines if the model handles key messages
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error               // err is shown below the model, e.g. why a value was rejected
+
+	canceled bool // canceled indicates whether editing was canceled
+	quit     bool // quit indicates whether editing was quit
+}
+
+// New creates and returns a new Model editing the given JSON or YAML document, configured by the given options. If
+// the document cannot be parsed, the error is shown and returned by Run and Err.
+func New(label string, doc []byte, opts ...Option) *Model {
+	ti := textinput.New()
+	ti.KeyMap = ui.DefaultTextKeyMap()
+	ti.Width = 40
+
+	m := &Model{
+		label:      label,
+		height:     15,
+		valueInput: ti,
+		cancelable: true,
+		quitable:   true,
+		focused:    true,
+		keymap:     ui.DefaultKeyMap(),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.doc, m.format, m.parseErr = parse(doc, m.format)
+	m.build()
+	return m
+}
+
+// parse parses the document in the given format, detecting the format if it is Auto.
+func parse(data []byte, format Format) (*yaml.Node, Format, error) {
+	if format == Auto {
+		format = YAML
+		if json.Valid(data) {
+			format = JSON
+		}
+	}
+	if format == JSON && !json.Valid(data) {
+		var v any
+		err := json.Unmarshal(data, &v)
+		return nil, format, fmt.Errorf("invalid JSON document: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, format, fmt.Errorf("invalid %s document: %w", format, err)
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return nil, format, errors.New("empty document")
+	}
+	if format == JSON {
+		resetStyle(&doc)
+	}
+	return &doc, format, nil
+}
+
+// resetStyle removes the flow and quoting styles from the value and its values, so that a JSON document is written
+// in block style when converted to YAML.
+func resetStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		resetStyle(c)
+	}
+}
+
+// build creates the tree showing the values of the document. The values of the top level are expanded.
+func (m *Model) build() {
+	m.roots = nil
+	if m.doc != nil {
+		root := m.doc.Content[0]
+		switch root.Kind {
+		case yaml.MappingNode, yaml.SequenceNode:
+			m.roots = m.children(root, "")
+			for _, n := range m.roots {
+				n.Expanded = len(n.Children) > 0
+			}
+		default:
+			m.roots = []*tree.Node{m.node(entry{key: "value", node: root})}
+		}
+	}
+	m.tree = tree.New(m.label, m.roots, tree.Embedded(), tree.Height(m.height), tree.KeyMap(m.keymap),
+		tree.Styled(m.styles.Tree), tree.Cancel(m.cancelable), tree.Quit(m.quitable),
+		tree.Selectable(func(*tree.Node) bool { return false }))
+}
+
+// children returns the nodes for the values of the given mapping or sequence, whose path is given.
+func (m *Model) children(n *yaml.Node, path string) []*tree.Node {
+	var nodes []*tree.Node
+	switch n.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k := n.Content[i].Value
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+			nodes = append(nodes, m.node(entry{key: k, path: p, node: n.Content[i+1]}))
+		}
+	case yaml.SequenceNode:
+		for i, c := range n.Content {
+			k := fmt.Sprintf("[%d]", i)
+			nodes = append(nodes, m.node(entry{key: k, path: path + k, node: c}))
+		}
+	}
+	return nodes
+}
+
+// node returns the tree node for the given entry, including the nodes of its values if it is a mapping or sequence.
+func (m *Model) node(e entry) *tree.Node {
+	n := &tree.Node{Label: label(e), Value: e}
+	n.Children = m.children(e.node, e.path)
+	return n
+}
+
+// label returns the label of the tree node for the given entry: the key, followed by the value for scalars and by
+// the number of values for mappings and sequences.
+func label(e entry) string {
+	n := e.node
+	switch n.Kind {
+	case yaml.MappingNode:
+		return fmt.Sprintf("%s {%d}", e.key, len(n.Content)/2)
+	case yaml.SequenceNode:
+		return fmt.Sprintf("%s [%d]", e.key, len(n.Content))
+	case yaml.AliasNode:
+		return fmt.Sprintf("%s: *%s", e.key, n.Value)
+	}
+	v := n.Value
+	if n.ShortTag() == "!!str" {
+		v = strconv.Quote(v)
+	}
+	return fmt.Sprintf("%s: %s", e.key, styles.Truncate(v, maxValueWidth))
+}
+
+// typeName returns the name of the type of the value.
+func typeName(n *yaml.Node) string {
+	switch n.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "array"
+	case yaml.AliasNode:
+		return "alias"
+	}
+	switch tag := n.ShortTag(); tag {
+	case "!!str":
+		return "string"
+	case "!!int", "!!float":
+		return "number"
+	case "!!bool":
+		return "bool"
+	case "!!null":
+		return "null"
+	default:
+		return strings.TrimPrefix(tag, "!!")
+	}
+}
+
+// editable returns an error if the value cannot be edited inline.
+func editable(n *yaml.Node) error {
+	switch {
+	case n.Kind == yaml.AliasNode:
+		return errors.New("aliases cannot be edited")
+	case n.Kind != yaml.ScalarNode:
+		return fmt.Errorf("%ss cannot be edited inline", typeName(n))
+	case strings.Contains(n.Value, "\n"):
+		return errors.New("multi-line strings cannot be edited inline")
+	}
+	return nil
+}
+
+// set validates the text against the type of the value and sets the value. Strings accept any text, numbers and
+// booleans only numbers and booleans. A null value takes the type of the text.
+func set(n *yaml.Node, s string) error {
+	if err := editable(n); err != nil {
+		return err
+	}
+
+	tag := n.ShortTag()
+	if tag == "!!null" {
+		t := strings.TrimSpace(s)
+		switch {
+		case t == "" || t == "null":
+			return nil
+		case t == "true" || t == "false":
+			tag = "!!bool"
+		case isNumber(t):
+			tag = "!!int"
+		default:
+			tag = "!!str"
+		}
+	}
+
+	switch tag {
+	case "!!str":
+		if strings.ContainsAny(s, "\r\n") {
+			return errors.New("strings must not contain line breaks")
+		}
+		n.Tag, n.Value = "!!str", s
+		return nil
+	case "!!int", "!!float":
+		s = strings.TrimSpace(s)
+		if !isNumber(s) {
+			return fmt.Errorf("not a number: %q", s)
+		}
+		if !jsonNumber.MatchString(s) {
+			f, _ := strconv.ParseFloat(s, 64)
+			s = strconv.FormatFloat(f, 'g', -1, 64)
+		}
+		n.Tag = "!!float"
+		if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+			n.Tag = "!!int"
+		}
+		n.Value, n.Style = s, 0
+		return nil
+	case "!!bool":
+		b, err := strconv.ParseBool(strings.TrimSpace(s))
+		if err != nil {
+			return fmt.Errorf("not a boolean: %q (use true or false)", s)
+		}
+		n.Tag, n.Value, n.Style = "!!bool", strconv.FormatBool(b), 0
+		return nil
+	default:
+		return fmt.Errorf("values of type %s cannot be edited", typeName(n))
+	}
+}
+
+// isNumber returns whether the text is a finite number.
+func isNumber(s string) bool {
+	f, err := strconv.ParseFloat(s, 64)
+	return err == nil && !math.IsInf(f, 0) && !math.IsNaN(f)
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithFormat sets the format of the document and returns a new Model with the updated format, e.g. to convert a JSON
+// document to YAML.
+func (m *Model) WithFormat(format Format) *Model {
+	return m.With(Formatted(format))
+}
+
+// WithHeight sets the number of visible rows and returns a new Model with the updated height.
+func (m *Model) WithHeight(height int) *Model {
+	return m.With(Height(height))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Format returns the format of the document.
+func (m *Model) Format() Format {
+	return m.format
+}
+
+// Err returns the error returned when parsing the document, or nil if it was parsed.
+func (m *Model) Err() error {
+	return m.parseErr
+}
+
+// Modified returns whether a value of the document was changed.
+func (m *Model) Modified() bool {
+	return m.modified
+}
+
+// Set validates the text against the type of the value with the given path, e.g. "servers[0].port", and sets the
+// value.
+func (m *Model) Set(path, value string) error {
+	n := m.find(path)
+	if n == nil {
+		return fmt.Errorf("no such value: %s", path)
+	}
+	e := n.Value.(entry)
+	if err := set(e.node, value); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	n.Label = label(e)
+	m.modified = true
+	return nil
+}
+
+// find returns the tree node of the value with the given path, or nil if there is none.
+func (m *Model) find(path string) *tree.Node {
+	if m.doc == nil {
+		return nil
+	}
+	var walk func(nodes []*tree.Node) *tree.Node
+	walk = func(nodes []*tree.Node) *tree.Node {
+		for _, n := range nodes {
+			if n.Value.(entry).path == path {
+				return n
+			}
+			if found := walk(n.Children); found != nil {
+				return found
+			}
+		}
+		return nil
+	}
+	return walk(m.roots)
+}
+
+// Document returns the document in its original format, including the changed values.
+func (m *Model) Document() ([]byte, error) {
+	if m.parseErr != nil {
+		return nil, m.parseErr
+	}
+	if m.format == JSON {
+		var b bytes.Buffer
+		if err := writeJSON(&b, m.doc.Content[0]); err != nil {
+			return nil, err
+		}
+		var out bytes.Buffer
+		if err := json.Indent(&out, b.Bytes(), "", "  "); err != nil {
+			return nil, err
+		}
+		out.WriteString("\n")
+		return out.Bytes(), nil
+	}
+
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(m.doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// writeJSON writes the value as compact JSON, keeping the order of keys.
+func writeJSON(b *bytes.Buffer, n *yaml.Node) error {
+	switch n.Kind {
+	case yaml.DocumentNode:
+		return writeJSON(b, n.Content[0])
+	case yaml.AliasNode:
+		return writeJSON(b, n.Alias)
+	case yaml.MappingNode:
+		b.WriteString("{")
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			k, _ := json.Marshal(n.Content[i].Value)
+			b.Write(k)
+			b.WriteString(":")
+			if err := writeJSON(b, n.Content[i+1]); err != nil {
+				return err
+			}
+		}
+		b.WriteString("}")
+		return nil
+	case yaml.SequenceNode:
+		b.WriteString("[")
+		for i, c := range n.Content {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			if err := writeJSON(b, c); err != nil {
+				return err
+			}
+		}
+		b.WriteString("]")
+		return nil
+	}
+
+	switch n.ShortTag() {
+	case "!!null":
+		b.WriteString("null")
+		return nil
+	case "!!bool", "!!int", "!!float":
+		if n.ShortTag() != "!!bool" && jsonNumber.MatchString(n.Value) {
+			b.WriteString(n.Value)
+			return nil
+		}
+		var v any
+		if err := n.Decode(&v); err != nil {
+			return err
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		b.Write(data)
+		return nil
+	default:
+		data, _ := json.Marshal(n.Value)
+		b.Write(data)
+		return nil
+	}
+}
+
+// Decode decodes the document, including the changed values, into the value pointed to by dst, using encoding/json
+// for JSON documents and yaml.v3 for YAML documents.
+func (m *Model) Decode(dst any) error {
+	data, err := m.Document()
+	if err != nil {
+		return err
+	}
+	if m.format == JSON {
+		return json.Unmarshal(data, dst)
+	}
+	return yaml.Unmarshal(data, dst)
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is either a complete document as a string or byte slice, or a map from
+// paths to new values, e.g. {"servers[0].port": 8080}. It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	switch v := v.(type) {
+	case string:
+		return m.SetAnswer([]byte(v))
+	case []byte:
+		doc, _, err := parse(v, m.format)
+		if err != nil {
+			return err
+		}
+		m.doc, m.parseErr, m.modified = doc, nil, true
+		m.build()
+	case map[string]any:
+		for path, value := range v {
+			s := fmt.Sprint(value)
+			if value == nil {
+				s = "null"
+			}
+			if err := m.Set(path, s); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the document as a string. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	data, err := m.Document()
+	if err != nil {
+		return nil
+	}
+	return string(data)
+}
+
+// Focus focuses the model, so that it handles key messages. It returns the command starting the cursor blink while a
+// value is edited.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	m.tree.Focus()
+	if m.editing != nil {
+		return m.valueInput.Focus()
+	}
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+	m.tree.Blur()
+	m.valueInput.Blur()
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns a nil command.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// Update handles key messages, editing the selected value or passing them to the tree of values.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+	}
+	switch msg := msg.(type) {
+	case tree.DoneMsg:
+		if msg.Model != m.tree {
+			return m, nil
+		}
+		m.canceled, m.quit = m.tree.Canceled(), m.tree.Quit()
+		return m, m.done()
+	case tea.KeyMsg:
+		if !m.focused {
+			return m, nil
+		}
+		if m.editing != nil {
+			return m, m.updateEditing(msg)
+		}
+		m.err = nil
+		if m.parseErr != nil {
+			switch {
+			case key.Matches(msg, m.keymap.Cancel) && m.cancelable:
+				m.canceled, m.quit = true, false
+				return m, m.done()
+			case key.Matches(msg, m.keymap.Quit) && m.quitable:
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, m.done()
+			}
+			return m, nil
+		}
+
+		n := m.tree.Selected()
+		switch {
+		case key.Matches(msg, saveKey):
+			m.canceled, m.quit = false, false
+			return m, m.done()
+		case n == nil || len(n.Children) > 0:
+		case key.Matches(msg, toggleKey) && n.Value.(entry).node.ShortTag() == "!!bool":
+			e := n.Value.(entry)
+			if err := m.Set(e.path, strconv.FormatBool(e.node.Value != "true")); err != nil {
+				m.err = err
+			}
+			return m, nil
+		case key.Matches(msg, editKey, m.keymap.Confirm):
+			return m, m.edit(n)
+		}
+	}
+
+	_, cmd := m.tree.Update(msg)
+	return m, cmd
+}
+
+// edit starts editing the value of the node.
+func (m *Model) edit(n *tree.Node) tea.Cmd {
+	e := n.Value.(entry)
+	if err := editable(e.node); err != nil {
+		m.err = err
+		return nil
+	}
+	m.editing, m.err = n, nil
+	value := e.node.Value
+	if e.node.ShortTag() == "!!null" {
+		value = ""
+	}
+	m.valueInput.SetValue(value)
+	m.valueInput.CursorEnd()
+	return m.valueInput.Focus()
+}
+
+// updateEditing handles key messages while a value is edited.
+func (m *Model) updateEditing(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, m.keymap.Quit):
+		if m.quitable {
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m.done()
+		}
+		return nil
+	case key.Matches(msg, m.keymap.Cancel):
+		m.editing, m.err = nil, nil
+		m.valueInput.Blur()
+		return nil
+	case key.Matches(msg, m.keymap.Confirm):
+		e := m.editing.Value.(entry)
+		if err := set(e.node, m.valueInput.Value()); err != nil {
+			m.err = err
+			return nil
+		}
+		m.editing.Label = label(e)
+		m.editing, m.modified, m.err = nil, true, nil
+		m.valueInput.Blur()
+		return nil
+	}
+	var cmd tea.Cmd
+	m.valueInput, cmd = m.valueInput.Update(msg)
+	return cmd
+}
+
+// View renders the tree of values, the path and type of the selected value and, while editing, the input for the new
+// value.
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.parseErr != nil {
+		if m.label != "" {
+			fmt.Fprintf(&b, "%s\n", m.styles.Tree.Label.Render(m.label))
+		}
+		b.WriteString(ui.RenderErrorWith(m.styles.Error, m.parseErr))
+		return b.String()
+	}
+
+	b.WriteString(m.tree.View())
+	if n := m.tree.Selected(); n != nil {
+		e := n.Value.(entry)
+		path := e.path
+		if path == "" {
+			path = e.key
+		}
+		status := fmt.Sprintf("%s (%s)", path, typeName(e.node))
+		if m.modified {
+			status += " · modified"
+		}
+		fmt.Fprintf(&b, "\n%s", m.styles.Status.Render(status))
+	}
+	if m.editing != nil {
+		e := m.editing.Value.(entry)
+		fmt.Fprintf(&b, "\n%s %s", m.styles.Prompt.Render(fmt.Sprintf("%s (%s):", e.key, typeName(e.node))),
+			m.valueInput.View())
+		fmt.Fprintf(&b, "\n%s", m.styles.Hint.Render("enter apply · esc discard"))
+	} else {
+		fmt.Fprintf(&b, "\n%s", m.styles.Hint.Render("enter edit · space toggle · ctrl+s save · esc cancel"))
+	}
+	if m.err != nil {
+		fmt.Fprintf(&b, "\n%s", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return b.String()
+}
+
+// Run runs the model and returns the modified document in its original format. It implements ui.Prompt[[]byte].
+func (m *Model) Run(ctx context.Context) ([]byte, error) {
+	if m.parseErr != nil {
+		return nil, m.parseErr
+	}
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return nil, err
+	}
+	return m.Document()
+}
+
+// RunAccessible lists the values of the document as numbered lines instead of using the terminal UI. Choosing a value
+// asks for its new value, until the document is saved. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	if m.parseErr != nil {
+		return m.parseErr
+	}
+
+	p := plain.New(in, out)
+	for {
+		items := []string{"(save)"}
+		targets := []*tree.Node{nil}
+		var walk func(nodes []*tree.Node)
+		walk = func(nodes []*tree.Node) {
+			for _, n := range nodes {
+				if len(n.Children) > 0 {
+					walk(n.Children)
+					continue
+				}
+				e := n.Value.(entry)
+				path := e.path
+				if path == "" {
+					path = e.key
+				}
+				items = append(items, fmt.Sprintf("%s = %s", path, strings.TrimPrefix(n.Label, e.key+": ")))
+				targets = append(targets, n)
+			}
+		}
+		walk(m.roots)
+
+		i, err := p.Choice(m.label, items, -1)
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+
+		n := targets[i]
+		if n == nil {
+			m.canceled, m.quit = false, false
+			return nil
+		}
+		e := n.Value.(entry)
+		if err := editable(e.node); err != nil {
+			p.Println(fmt.Sprintf("Error: %v", err))
+			continue
+		}
+		s, err := p.Line(fmt.Sprintf("New value (%s): ", typeName(e.node)), e.node.Value)
+		switch {
+		case errors.Is(err, io.EOF):
+			continue
+		case err != nil:
+			return err
+		}
+		if err := set(e.node, s); err != nil {
+			p.Println(fmt.Sprintf("Error: %v", err))
+			continue
+		}
+		n.Label = label(e)
+		m.modified = true
+	}
+}
+
+// Showcase demonstrates the Model component by editing a JSON configuration.
+func Showcase() {
+	fmt.Println("=== Document Editor Showcase ===")
+
+	doc := []byte(`{
+  "name": "api-gateway",
+  "replicas": 3,
+  "debug": false,
+  "listen": {"host": "0.0.0.0", "port": 8080},
+  "upstreams": [
+    {"name": "users", "url": "http://users:9000", "timeout": 2.5},
+    {"name": "orders", "url": "http://orders:9000", "timeout": null}
+  ]
+}`)
+
+	data, err := New("Edit the configuration (enter edits, ctrl+s saves):", doc).Run(context.Background())
+	if ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Saved:\n%s", data)
+	}
+}
@@ -0,0 +1,41 @@
+package docedit
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/tree"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Tree   tree.Styles    // Tree holds the styles of the tree of values.
+	Status lipgloss.Style // Status is the style of the path and type of the selected value.
+	Prompt lipgloss.Style // Prompt is the style of the prompt for the new value.
+	Hint   lipgloss.Style // Hint is the style of the hint on the available keys.
+	Error  lipgloss.Style // Error is the style of the error shown below the values.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Tree:   tree.ThemedStyles(t),
+		Status: lipgloss.NewStyle().Foreground(t.Accent),
+		Prompt: lipgloss.NewStyle().Foreground(t.Label),
+		Hint:   lipgloss.NewStyle().Faint(true),
+		Error:  t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly, including those of the
+// tree.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+		tree.Styled(m.styles.Tree)(m.tree)
+	}
+}
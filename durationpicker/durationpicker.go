@@ -0,0 +1,586 @@
+package durationpicker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/plain"
+)
+
+var _ ui.Prompt[time.Duration] = (*Model)(nil)
+
+// Day is the duration of a day, which is not defined by the time package.
+const Day = 24 * time.Hour
+
+var (
+	incKey       = key.NewBinding(key.WithKeys("up", "k", "+"), key.WithHelp("↑/k", "increment"))
+	decKey       = key.NewBinding(key.WithKeys("down", "j", "-"), key.WithHelp("↓/j", "decrement"))
+	prevSegKey   = key.NewBinding(key.WithKeys("left", "h", "shift+tab"), key.WithHelp("←/h", "previous unit"))
+	nextSegKey   = key.NewBinding(key.WithKeys("right", "l", "tab"), key.WithHelp("→/l", "next unit"))
+	backspaceKey = key.NewBinding(key.WithKeys("backspace"), key.WithHelp("backspace", "delete character"))
+)
+
+// units maps the unit names accepted by Parse to their durations.
+var units = map[string]time.Duration{
+	"d": Day, "day": Day, "days": Day,
+	"h": time.Hour, "hr": time.Hour, "hrs": time.Hour, "hour": time.Hour, "hours": time.Hour,
+	"m": time.Minute, "min": time.Minute, "mins": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+	"s": time.Second, "sec": time.Second, "secs": time.Second, "second": time.Second, "seconds": time.Second,
+}
+
+// Model represents a duration picker with day, hour, minute and second segments. Besides changing the segments
+// with the arrow keys, a duration like "1h30m" or "2 days" can be typed, which is parsed when confirmed.
+type Model struct {
+	label          string              // label is the label for the picker.
+	value          time.Duration       // value is the selected duration.
+	min            time.Duration       // min is the smallest selectable duration.
+	max            time.Duration       // max is the largest selectable duration, 0 if unlimited.
+	days           bool                // days determines if the day segment is shown.
+	seconds        bool                // seconds determines if the second segment is shown.
+	segment        int                 // segment is the index of the focused segment.
+	text           string              // text holds the duration typed by the user, parsed when confirmed.
+	cancelable     bool                // cancelable determines if selection can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	id             string              // id identifies the prompt, e.g. for preset answers
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                // focused determines if the model handles key messages
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error               // err is shown below the model, e.g. why the previous answer was rejected
+
+	canceled bool // canceled indicates whether the selection was canceled
+	quit     bool // quit indicates whether the selection was quit
+}
+
+// New creates and returns a new Model with the given label, configured by the given options. Initially, a zero
+// duration is selected and the minute segment is focused.
+func New(label string, opts ...Option) *Model {
+	m := &Model{
+		label:      label,
+		days:       true,
+		seconds:    true,
+		segment:    -1,
+		cancelable: true,
+		quitable:   true,
+		focused:    true,
+		keymap:     ui.DefaultKeyMap(),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithDuration sets the initially selected duration and returns a new Model with the updated duration.
+func (m *Model) WithDuration(d time.Duration) *Model {
+	return m.With(Duration(d))
+}
+
+// WithMin sets the smallest selectable duration and returns a new Model with the updated bound.
+func (m *Model) WithMin(d time.Duration) *Model {
+	return m.With(Min(d))
+}
+
+// WithMax sets the largest selectable duration and returns a new Model with the updated bound.
+func (m *Model) WithMax(d time.Duration) *Model {
+	return m.With(Max(d))
+}
+
+// WithDays sets whether the day segment is shown and returns a new Model with the updated flag.
+func (m *Model) WithDays(show bool) *Model {
+	return m.With(Days(show))
+}
+
+// WithSeconds sets whether the second segment is shown and returns a new Model with the updated flag.
+func (m *Model) WithSeconds(show bool) *Model {
+	return m.With(Seconds(show))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Value returns the selected duration.
+func (m *Model) Value() time.Duration {
+	return m.value
+}
+
+// String returns the selected duration in the compact format accepted by Parse, e.g. "1d2h30m".
+func (m *Model) String() string {
+	return Format(m.value)
+}
+
+// Format formats a duration in the compact format accepted by Parse, e.g. "1d2h30m". Zero units are omitted and
+// fractions of a second are truncated.
+func Format(d time.Duration) string {
+	if d < time.Second && d > -time.Second {
+		return "0s"
+	}
+	var b strings.Builder
+	if d < 0 {
+		b.WriteString("-")
+		d = -d
+	}
+	for _, u := range []time.Duration{Day, time.Hour, time.Minute, time.Second} {
+		if n := d / u; n > 0 {
+			fmt.Fprintf(&b, "%d%s", n, unitName(u))
+			d -= n * u
+		}
+	}
+	return b.String()
+}
+
+// Parse parses a duration like "1h30m", "2 days 4 hours", "1.5h" or "90s". Besides the units of time.ParseDuration
+// up to hours, days are supported as "d". A plain number is interpreted as seconds.
+func Parse(s string) (time.Duration, error) {
+	return parse(s, time.Second)
+}
+
+// parse parses a duration like Parse, interpreting a plain number in the given unit.
+func parse(s string, unit time.Duration) (time.Duration, error) {
+	text := strings.ToLower(strings.Join(strings.Fields(s), ""))
+	negative := strings.HasPrefix(text, "-")
+	text = strings.TrimPrefix(text, "-")
+	if text == "" {
+		return 0, fmt.Errorf("invalid duration: %q", s)
+	}
+
+	if n, err := strconv.ParseFloat(text, 64); err == nil {
+		return sign(round(n*float64(unit)), negative), nil
+	}
+	if d, err := time.ParseDuration(text); err == nil {
+		return sign(d, negative), nil
+	}
+
+	var total float64
+	for text != "" {
+		i := strings.IndexFunc(text, func(r rune) bool { return !unicode.IsDigit(r) && r != '.' })
+		if i <= 0 {
+			return 0, fmt.Errorf("invalid duration: %q", s)
+		}
+		n, err := strconv.ParseFloat(text[:i], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration: %q", s)
+		}
+		text = text[i:]
+
+		j := strings.IndexFunc(text, func(r rune) bool { return !unicode.IsLetter(r) })
+		if j < 0 {
+			j = len(text)
+		}
+		u, ok := units[text[:j]]
+		if !ok {
+			return 0, fmt.Errorf("invalid unit %q in duration %q", text[:j], s)
+		}
+		total += n * float64(u)
+		text = text[j:]
+	}
+	if total > math.MaxInt64 {
+		return 0, fmt.Errorf("duration out of range: %q", s)
+	}
+	return sign(round(total), negative), nil
+}
+
+// round rounds a number of nanoseconds to a duration.
+func round(n float64) time.Duration {
+	return time.Duration(math.Round(n))
+}
+
+// sign negates d if negative is set.
+func sign(d time.Duration, negative bool) time.Duration {
+	if negative {
+		return -d
+	}
+	return d
+}
+
+// units returns the units of the visible segments, from the largest to the smallest.
+func (m *Model) units() []time.Duration {
+	var us []time.Duration
+	if m.days {
+		us = append(us, Day)
+	}
+	us = append(us, time.Hour, time.Minute)
+	if m.seconds {
+		us = append(us, time.Second)
+	}
+	return us
+}
+
+// unitName returns the abbreviated name of a segment unit.
+func unitName(u time.Duration) string {
+	switch u {
+	case Day:
+		return "d"
+	case time.Hour:
+		return "h"
+	case time.Minute:
+		return "m"
+	}
+	return "s"
+}
+
+// focusedIndex returns the index of the focused segment, which is the minute segment if none was focused yet.
+func (m *Model) focusedIndex() int {
+	us := m.units()
+	if m.segment >= 0 && m.segment < len(us) {
+		return m.segment
+	}
+	for i, u := range us {
+		if u == time.Minute {
+			return i
+		}
+	}
+	return 0
+}
+
+// focusedUnit returns the unit of the focused segment.
+func (m *Model) focusedUnit() time.Duration {
+	return m.units()[m.focusedIndex()]
+}
+
+// validate returns an error if d is not within the bounds of the model.
+func (m *Model) validate(d time.Duration) error {
+	switch {
+	case d < 0:
+		return errors.New("duration must not be negative")
+	case d < m.min:
+		return fmt.Errorf("duration must be at least %s", Format(m.min))
+	case m.max > 0 && d > m.max:
+		return fmt.Errorf("duration must be at most %s", Format(m.max))
+	}
+	return nil
+}
+
+// set sets the selected duration, truncated to the smallest visible unit and clamped to the bounds.
+func (m *Model) set(d time.Duration) {
+	us := m.units()
+	d = d.Truncate(us[len(us)-1])
+	if m.max > 0 {
+		d = min(d, m.max)
+	}
+	m.value = max(d, m.min, 0)
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is a time.Duration or a string accepted by Parse. It implements
+// ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	var d time.Duration
+	switch v := v.(type) {
+	case time.Duration:
+		d = v
+	case string:
+		var err error
+		if d, err = Parse(v); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+	if err := m.validate(d); err != nil {
+		return err
+	}
+	m.set(d)
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the selected duration as string, e.g. "1h30m". It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	return m.String()
+}
+
+// Focus focuses the model, so that it handles key messages. It returns no command and exists for compatibility with
+// other focusable models.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns a nil command.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// UsesKey reports whether the picker handles the key itself, like shift+tab moving to the previous unit. It implements
+// ui.KeyModel.
+func (m *Model) UsesKey(msg tea.KeyMsg) bool {
+	return key.Matches(msg, nextSegKey, prevSegKey)
+}
+
+// Update handles key messages. Digits start a typed duration, which takes precedence over the segments until it is
+// confirmed or discarded with escape.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !m.focused {
+		return m, nil
+	}
+
+	if m.text != "" {
+		return m.updateText(keyMsg)
+	}
+
+	switch {
+	case keyMsg.Type == tea.KeyRunes && len(keyMsg.Runes) == 1 && (unicode.IsDigit(keyMsg.Runes[0]) || keyMsg.Runes[0] == '.'):
+		m.text, m.err = string(keyMsg.Runes), nil
+	case key.Matches(keyMsg, incKey):
+		m.set(m.value + m.focusedUnit())
+	case key.Matches(keyMsg, decKey):
+		m.set(m.value - m.focusedUnit())
+	case key.Matches(keyMsg, prevSegKey):
+		m.segment = max(m.focusedIndex()-1, 0)
+	case key.Matches(keyMsg, nextSegKey):
+		m.segment = min(m.focusedIndex()+1, len(m.units())-1)
+	case key.Matches(keyMsg, m.keymap.Confirm):
+		if err := m.validate(m.value); err != nil {
+			m.err = err
+			return m, nil
+		}
+		m.canceled, m.quit = false, false
+		return m, m.done()
+	case key.Matches(keyMsg, m.keymap.Cancel):
+		if m.cancelable {
+			m.canceled, m.quit = true, false
+			return m, m.done()
+		}
+	case key.Matches(keyMsg, m.keymap.Quit):
+		if m.quitable {
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m, m.done()
+		}
+	}
+	return m, nil
+}
+
+// updateText handles key messages while a duration is typed.
+func (m *Model) updateText(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace:
+		m.text, m.err = m.text+string(msg.Runes), nil
+	case key.Matches(msg, backspaceKey):
+		r := []rune(m.text)
+		m.text, m.err = string(r[:len(r)-1]), nil
+	case key.Matches(msg, m.keymap.Confirm):
+		d, err := parse(m.text, m.focusedUnit())
+		if err == nil {
+			err = m.validate(d)
+		}
+		if err != nil {
+			m.err = err
+			return m, nil
+		}
+		m.set(d)
+		m.text, m.err = "", nil
+		m.canceled, m.quit = false, false
+		return m, m.done()
+	case key.Matches(msg, m.keymap.Cancel):
+		m.text, m.err = "", nil
+	case key.Matches(msg, m.keymap.Quit):
+		if m.quitable {
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m, m.done()
+		}
+	}
+	return m, nil
+}
+
+// View renders the label and either the segments, highlighting the focused one, or the typed duration.
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.label != "" {
+		fmt.Fprintf(&b, "%s ", m.styles.Label.Render(m.label))
+	}
+
+	if m.text != "" {
+		b.WriteString(m.styles.Text.Render(m.text))
+		if d, err := parse(m.text, m.focusedUnit()); err == nil {
+			fmt.Fprintf(&b, "  %s", m.styles.Hint.Render("= "+Format(d)))
+		}
+	} else {
+		focused := m.focusedUnit()
+		rest := m.value
+		for i, u := range m.units() {
+			n := rest / u
+			rest -= n * u
+
+			if i > 0 {
+				b.WriteString(" ")
+			}
+			text := fmt.Sprintf("%02d", n)
+			if u == Day {
+				text = strconv.FormatInt(int64(n), 10)
+			}
+			if u == focused && m.focused {
+				b.WriteString(m.styles.SelectedSegment.Render(text))
+			} else {
+				b.WriteString(m.styles.Segment.Render(text))
+			}
+			b.WriteString(m.styles.Unit.Render(unitName(u)))
+		}
+	}
+	if m.err != nil {
+		fmt.Fprintf(&b, "\n%s", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return b.String()
+}
+
+// Run runs the model and returns the selected duration. It implements ui.Prompt[time.Duration].
+func (m *Model) Run(ctx context.Context) (time.Duration, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return 0, err
+	}
+	return m.value, nil
+}
+
+// RunAccessible asks for the duration as a line of text instead of using the terminal UI. It implements
+// ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	for {
+		s, err := p.Line(m.label+" ", m.String())
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+		if err := m.SetAnswer(s); err != nil {
+			p.Println(fmt.Sprintf("Error: %v", err))
+			continue
+		}
+		return nil
+	}
+}
+
+// Showcase demonstrates the Model component with segments and typed durations.
+func Showcase() {
+	fmt.Println("=== Duration Picker Showcase ===")
+
+	fmt.Println("\nArrows change and move between units, or type a duration like 1h30m:")
+	m := New("Timeout:").WithDuration(5 * time.Minute).WithMin(time.Second).WithMax(7 * Day)
+	if d, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Selected: %s\n", d)
+	}
+
+	fmt.Println("\nWithout days and seconds:")
+	m = New("Cache TTL:").WithDays(false).WithSeconds(false).WithDuration(90 * time.Minute)
+	if d, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Selected: %s\n", d)
+	}
+}
@@ -0,0 +1,114 @@
+package durationpicker
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Label sets the label of the picker.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Duration sets the initially selected duration.
+func Duration(d time.Duration) Option {
+	return func(m *Model) {
+		m.value = d
+	}
+}
+
+// Min sets the smallest selectable duration.
+func Min(d time.Duration) Option {
+	return func(m *Model) {
+		m.min = d
+	}
+}
+
+// Max sets the largest selectable duration. 0 means unlimited.
+func Max(d time.Duration) Option {
+	return func(m *Model) {
+		m.max = d
+	}
+}
+
+// Days sets whether the day segment is shown. If it is hidden, days are shown as hours.
+func Days(show bool) Option {
+	return func(m *Model) {
+		m.days = show
+	}
+}
+
+// Seconds sets whether the second segment is shown. If it is hidden, the selected duration is truncated to minutes.
+func Seconds(show bool) Option {
+	return func(m *Model) {
+		m.seconds = show
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
@@ -0,0 +1,42 @@
+package durationpicker
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Label           lipgloss.Style // Label is the style of the label.
+	Segment         lipgloss.Style // Segment is the style of the unfocused segments.
+	SelectedSegment lipgloss.Style // SelectedSegment is the style of the focused segment.
+	Unit            lipgloss.Style // Unit is the style of the unit names following the segments.
+	Text            lipgloss.Style // Text is the style of the typed duration.
+	Hint            lipgloss.Style // Hint is the style of the parsed duration shown next to the typed one.
+	Error           lipgloss.Style // Error is the style of the error shown below the picker.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:           lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Segment:         lipgloss.NewStyle().Foreground(t.Text),
+		SelectedSegment: lipgloss.NewStyle().Foreground(t.Accent).Reverse(true),
+		Unit:            lipgloss.NewStyle().Faint(true),
+		Text:            lipgloss.NewStyle().Foreground(t.Text).Underline(true),
+		Hint:            lipgloss.NewStyle().Faint(true),
+		Error:           t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
@@ -0,0 +1,196 @@
+// Package errorview renders errors and warnings the same way in all components: an icon, the styled first line of the
+// message and, on request, the details of the error below it. Components keep the error they show and render it
+// with Render; standalone, a Model shows its own error:
+//
+//	v := errorview.New(err)
+//	fmt.Println(v.View())
+//
+// Warnings are errors wrapped by Warn; they are shown with the warning icon and style instead.
+package errorview
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/styles"
+)
+
+// Detailer is implemented by errors carrying details that are not part of their message, e.g. the output of a failed
+// command or the position of a syntax error.
+type Detailer interface {
+	Details() string
+}
+
+// warning marks an error as a warning, see Warn.
+type warning struct {
+	error
+}
+
+// Unwrap returns the wrapped error.
+func (w warning) Unwrap() error {
+	return w.error
+}
+
+// Warn marks err as a warning, e.g. a validation result that does not block the answer. It returns nil if err is nil.
+func Warn(err error) error {
+	if err == nil {
+		return nil
+	}
+	return warning{err}
+}
+
+// IsWarning reports whether err was marked as a warning by Warn.
+func IsWarning(err error) bool {
+	var w warning
+	return errors.As(err, &w)
+}
+
+// Details returns the details of err: the lines of its message after the first one, e.g. of errors joined by
+// errors.Join, followed by the details of the first error in its chain implementing Detailer.
+func Details(err error) string {
+	if err == nil {
+		return ""
+	}
+	var parts []string
+	if _, rest, ok := strings.Cut(err.Error(), "\n"); ok && strings.TrimSpace(rest) != "" {
+		parts = append(parts, rest)
+	}
+	var d Detailer
+	if errors.As(err, &d) {
+		if s := strings.TrimRight(d.Details(), "\n"); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// Model shows an error or warning. The Details binding of its key map expands and collapses the details of the error,
+// if there are any.
+type Model struct {
+	err      error     // err is the error shown by View.
+	expanded bool      // expanded determines if the details are shown.
+	width    int       // width is the width the message and the details are wrapped at, 0 for no wrapping.
+	keymap   ui.KeyMap // keymap holds the key bindings of the model.
+	styles   Styles    // styles holds the styles of the model.
+
+	customStyles bool // customStyles is set if styles were set explicitly, which a theme switch keeps
+}
+
+// New creates and returns a new Model showing err, configured by the given options.
+func New(err error, opts ...Option) *Model {
+	m := &Model{
+		err:    err,
+		keymap: ui.DefaultKeyMap(),
+		styles: DefaultStyles(),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithExpanded sets whether the details are shown and returns a new Model with the updated flag.
+func (m *Model) WithExpanded(expanded bool) *Model {
+	return m.With(Expanded(expanded))
+}
+
+// WithWidth sets the width the error is wrapped at and returns a new Model with the updated width.
+func (m *Model) WithWidth(width int) *Model {
+	return m.With(Width(width))
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// SetError sets the error shown by View. The details are collapsed.
+func (m *Model) SetError(err error) {
+	m.err, m.expanded = err, false
+}
+
+// Err returns the error shown by View.
+func (m *Model) Err() error {
+	return m.err
+}
+
+// Expanded returns whether the details are shown.
+func (m *Model) Expanded() bool {
+	return m.expanded
+}
+
+// Init initializes the model.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update expands or collapses the details of the error on the Details key, and wraps the error at the window width.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	case ui.ThemeMsg:
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	m.Toggle(msg, m.err)
+	return m, nil
+}
+
+// Toggle expands or collapses the details if msg is the Details key and err has details, and reports whether it did.
+// Components showing err using Render pass their key messages to it first.
+func (m *Model) Toggle(msg tea.Msg, err error) bool {
+	k, ok := msg.(tea.KeyMsg)
+	if !ok || !key.Matches(k, m.keymap.Details) || Details(err) == "" {
+		return false
+	}
+	m.expanded = !m.expanded
+	return true
+}
+
+// View renders the error of the model, or an empty string if there is none.
+func (m *Model) View() string {
+	return m.Render(m.err)
+}
+
+// Render renders err with the state and styles of the model: the icon and the first line of the message, followed
+// by the details if they are expanded, or by a hint how to expand them. It returns an empty string if err is nil.
+func (m *Model) Render(err error) string {
+	return m.RenderWith(m.styles.Error, err)
+}
+
+// RenderWith renders err like Render, using the given style for errors, e.g. the error style of a component.
+func (m *Model) RenderWith(style lipgloss.Style, err error) string {
+	if err == nil {
+		return ""
+	}
+	icon := ui.Glyphs().Failure
+	if IsWarning(err) {
+		style, icon = m.styles.Warning, ui.Glyphs().Warning
+	}
+	msg, _, _ := strings.Cut(err.Error(), "\n")
+	lines := []string{style.Render(styles.Wrap(icon+" "+msg, styles.InnerWidth(style, m.width)))}
+	switch details := Details(err); {
+	case details == "":
+	case m.expanded:
+		lines = append(lines, m.styles.Details.Render(styles.Wrap(details, styles.InnerWidth(m.styles.Details, m.width))))
+	case m.keymap.Details.Enabled():
+		lines = append(lines, m.styles.Hint.Render(m.keymap.Details.Help().Key+" for details"))
+	}
+	return strings.Join(lines, "\n")
+}
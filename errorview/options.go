@@ -0,0 +1,57 @@
+package errorview
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Expanded sets whether the details of the error are shown initially.
+func Expanded(expanded bool) Option {
+	return func(m *Model) {
+		m.expanded = expanded
+	}
+}
+
+// Width sets the width the message and the details are wrapped at. By default, the window width is used if the model
+// is updated with window size messages, and the error is not wrapped otherwise.
+func Width(width int) Option {
+	return func(m *Model) {
+		m.width = width
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map. Only the Details binding is used.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
+
+// ErrorStyle sets the style of errors, e.g. to use the error style of a component.
+func ErrorStyle(style lipgloss.Style) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles.Error = style
+	}
+}
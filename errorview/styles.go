@@ -0,0 +1,39 @@
+package errorview
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// WarningColor is the default color of warnings.
+var WarningColor = lipgloss.AdaptiveColor{Light: "#B58900", Dark: "#E5C07B"}
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Error   lipgloss.Style // Error is the style of the icon and message of errors.
+	Warning lipgloss.Style // Warning is the style of the icon and message of warnings.
+	Details lipgloss.Style // Details is the style of the expanded details.
+	Hint    lipgloss.Style // Hint is the style of the hint how to expand the details.
+}
+
+// DefaultStyles returns the default styles, with errors styled like ui.RenderError.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Error:   t.ErrorStyle(),
+		Warning: lipgloss.NewStyle().Foreground(WarningColor),
+		Details: lipgloss.NewStyle().PaddingLeft(2).Foreground(t.Text),
+		Hint:    lipgloss.NewStyle().PaddingLeft(2).Faint(true),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
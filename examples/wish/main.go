@@ -0,0 +1,58 @@
+// Command wish serves a few go-ui prompts over SSH. Start it and connect with "ssh -p 2222 localhost"; the host key
+// is generated on startup unless -hostkey is given.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/charmbracelet/ssh"           // SSH server used by wish
+	"github.com/muesli/termenv"              // Terminal capability detection
+	"github.com/nmeilick/go-ui/confirm"
+	"github.com/nmeilick/go-ui/input"
+	uiwish "github.com/nmeilick/go-ui/integrations/wish"
+	"github.com/nmeilick/go-ui/pick"
+)
+
+func main() {
+	addr := flag.String("addr", ":2222", "address to listen on")
+	hostKey := flag.String("hostkey", "", "path of the host key")
+	flag.Parse()
+
+	var opts []ssh.Option
+	if *hostKey != "" {
+		opts = append(opts, ssh.HostKeyFile(*hostKey))
+	}
+	// Render all colors; each session reduces them to those its terminal supports.
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	handler := uiwish.Middleware(deploy)(func(s ssh.Session) {})
+	log.Printf("Listening on %s", *addr)
+	log.Fatal(ssh.ListenAndServe(*addr, handler, opts...))
+}
+
+// deploy asks for the details of a deployment and prints a summary to the session.
+func deploy(s ssh.Session, opts ...tea.ProgramOption) error {
+	ctx := s.Context()
+	service, err := input.New("Service: ", s.User()).WithProgramOptions(opts...).Run(ctx)
+	if err != nil {
+		return err
+	}
+	envs := []string{"staging", "production"}
+	i, err := pick.New(envs, pick.Label("Environment"), pick.ProgramOptions(opts...)).Run(ctx)
+	if err != nil {
+		return err
+	}
+	ok, err := confirm.New(fmt.Sprintf("Deploy %s to %s?", service, envs[i]), confirm.ProgramOptions(opts...)).Run(ctx)
+	if err != nil {
+		return err
+	}
+	if ok {
+		fmt.Fprintf(s, "Deploying %s to %s.\n", service, envs[i])
+	} else {
+		fmt.Fprintln(s, "Nothing deployed.")
+	}
+	return nil
+}
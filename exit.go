@@ -0,0 +1,110 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// ExitPolicy determines the exit codes Main uses for the outcome of an interactive flow.
+type ExitPolicy struct {
+	Canceled int  // Canceled is the exit code if a prompt was canceled, see CanceledError.
+	Quit     int  // Quit is the exit code if quitting was requested or the program was terminated, see QuitError.
+	Timeout  int  // Timeout is the exit code if the deadline of the context of a prompt expired.
+	Error    int  // Error is the exit code on all other errors.
+	Quiet    bool // Quiet suppresses the messages printed for canceled, quit and timed out flows; errors are printed.
+}
+
+var exitPolicy = struct {
+	sync.RWMutex
+	p ExitPolicy
+}{p: ExitPolicy{Canceled: 1, Quit: 130, Timeout: 124, Error: 1}}
+
+// DefaultExitPolicy returns the exit policy used by Main. Unless changed by SetDefaultExitPolicy, a canceled prompt
+// exits with code 1, quitting with 130 as for SIGINT, a timeout with 124 like timeout(1) and other errors with 1.
+func DefaultExitPolicy() ExitPolicy {
+	exitPolicy.RLock()
+	defer exitPolicy.RUnlock()
+	return exitPolicy.p
+}
+
+// SetDefaultExitPolicy sets the exit policy used by Main, e.g. to exit with code 0 if the user canceled.
+func SetDefaultExitPolicy(p ExitPolicy) {
+	exitPolicy.Lock()
+	defer exitPolicy.Unlock()
+	exitPolicy.p = p
+}
+
+// Code returns the exit code for the error returned by an interactive flow, 0 if it is nil.
+func (p ExitPolicy) Code(err error) int {
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, QuitError):
+		return p.Quit
+	case errors.Is(err, CanceledError):
+		return p.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return p.Timeout
+	}
+	return p.Error
+}
+
+// message returns the message printed for the error, or an empty string if none is printed.
+func (p ExitPolicy) message(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, QuitError):
+		if !p.Quiet {
+			return "Quit"
+		}
+	case errors.Is(err, CanceledError):
+		if !p.Quiet {
+			return "Canceled"
+		}
+	case errors.Is(err, context.DeadlineExceeded):
+		if !p.Quiet {
+			return "Timed out"
+		}
+	default:
+		return fmt.Sprintf("Error: %v", err)
+	}
+	return ""
+}
+
+// Main runs fn, the interactive flow of a program, and exits according to the default exit policy: if fn returns an
+// error, a message like "Canceled" or the error is printed to stderr and the program exits with the code of the
+// policy; otherwise Main returns. The context passed to fn is canceled when the program receives SIGINT or SIGTERM
+// outside of a prompt, which is handled like TerminatedError if fn returns the error of the context; prompts handle
+// the signals themselves and return TerminatedError.
+//
+//	func main() {
+//		ui.Main(func(ctx context.Context) error {
+//			name, err := input.New("Name: ", "").Run(ctx)
+//			if err != nil {
+//				return err
+//			}
+//			fmt.Println("Hello,", name)
+//			return nil
+//		})
+//	}
+func Main(fn func(ctx context.Context) error) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := fn(ctx)
+	if ctx.Err() != nil && errors.Is(err, context.Canceled) {
+		err = TerminatedError
+	}
+	stop()
+	p := DefaultExitPolicy()
+	if msg := p.message(err); msg != "" {
+		fmt.Fprintln(os.Stderr, msg)
+	}
+	if code := p.Code(err); code != 0 {
+		exit(code)
+	}
+}
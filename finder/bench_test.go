@@ -0,0 +1,12 @@
+package finder
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui/internal/benchview"
+)
+
+func BenchmarkView10000(b *testing.B) {
+	benchview.Run(b, func() tea.Model { return New("Find", Items(benchview.Items(10000))) })
+}
@@ -0,0 +1,977 @@
+package finder
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"       // Manages key bindings
+	"github.com/charmbracelet/bubbles/textinput" // Provides text input model
+	tea "github.com/charmbracelet/bubbletea"     // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"          // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/history"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/styles"
+	"github.com/sahilm/fuzzy" // Ranks candidates by fuzzy matching
+)
+
+var _ ui.Prompt[[]string] = (*Model)(nil)
+
+var (
+	upKey       = key.NewBinding(key.WithKeys("up", "ctrl+p", "ctrl+k"), key.WithHelp("↑/ctrl+p", "up"))
+	downKey     = key.NewBinding(key.WithKeys("down", "ctrl+n", "ctrl+j"), key.WithHelp("↓/ctrl+n", "down"))
+	pageUpKey   = key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "page up"))
+	pageDownKey = key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdown", "page down"))
+	markKey     = key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "mark"))
+	markUpKey   = key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "mark and move up"))
+)
+
+// maxBatch is the maximum number of candidates added at once while reading from the source.
+const maxBatch = 1000
+
+// maxAccessibleMatches is the number of matches listed in accessible mode.
+const maxAccessibleMatches = 20
+
+// candidates is the subset of the candidates with the given indexes, searched by the fuzzy matcher.
+type candidates struct {
+	items   []string
+	indexes []int
+}
+
+// String returns the candidate at position i of the subset. It implements fuzzy.Source.
+func (c candidates) String(i int) string {
+	return c.items[c.indexes[i]]
+}
+
+// Len returns the size of the subset. It implements fuzzy.Source.
+func (c candidates) Len() int {
+	return len(c.indexes)
+}
+
+// itemsMsg delivers candidates from the source.
+type itemsMsg struct {
+	model *Model
+	items []string
+	eof   bool
+}
+
+// Model represents a fuzzy finder, like fzf. The user types a query, the candidates are ranked by how well they match
+// it, and one candidate, or several in multi-select mode, is chosen. Candidates can be given upfront or read from a
+// reader or channel while the finder runs, and a preview of the selected candidate can be shown next to the list.
+type Model struct {
+	label          string                   // label is shown above the query.
+	items          []string                 // items holds the candidates.
+	reader         io.Reader                // reader is the reader the candidates are read from, if set.
+	channel        <-chan string            // channel is the channel the candidates are received from, if set.
+	feed           <-chan string            // feed delivers the candidates while running.
+	readErr        chan error               // readErr receives the error of the reader once the feed is closed.
+	stop           chan struct{}            // stop is closed to stop reading when the model finished.
+	ended          bool                     // ended determines if the source ended.
+	queryInput     textinput.Model          // queryInput reads the query.
+	term           string                   // term is the query the matches were ranked for.
+	matches        fuzzy.Matches            // matches holds the candidates matching the query, best first.
+	cursor         int                      // cursor is the position of the selected match.
+	offset         int                      // offset is the position of the first visible match.
+	height         int                      // height is the number of visible matches.
+	width          int                      // width is the available width, updated from window size messages.
+	multi          bool                     // multi determines if several candidates can be marked.
+	marked         map[int]bool             // marked holds the indexes of the marked candidates.
+	preview        func(item string) string // preview returns the preview of a candidate, nil for no preview.
+	previewIndex   int                      // previewIndex is the index of the candidate of previewText, -1 if none.
+	previewText    string                   // previewText caches the preview of the selected candidate.
+	chosen         []int                    // chosen holds the indexes of the chosen candidates.
+	cancelable     bool                     // cancelable determines if selection can be canceled with escape key
+	quitable       bool                     // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption      // programOptions are passed to the program running the model
+	id             string                   // id identifies the prompt, e.g. for preset answers
+	embedded       bool                     // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                     // focused determines if the model handles key messages
+	keymap         ui.KeyMap                // keymap holds the key bindings of the model.
+	styles         Styles                   // styles holds the styles of the model.
+	customStyles   bool                     // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error                    // err is shown below the model, e.g. why the previous answer was rejected
+	history        *history.Store           // history stores the chosen candidates, which are listed first
+	recent         map[string]int           // recent maps recently chosen candidates to their age, nil if not loaded
+	order          []int                    // order holds the indexes of the candidates listed for an empty query
+	front          int                      // front is the number of recently chosen candidates at the top of order
+
+	canceled bool // canceled indicates whether the selection was canceled
+	quit     bool // quit indicates whether the selection was quit
+}
+
+// New creates and returns a new Model with the given label, configured by the given options. Use the Items, Reader or
+// Channel options to set the candidates.
+func New(label string, opts ...Option) *Model {
+	ti := textinput.New()
+	ti.KeyMap = ui.DefaultTextKeyMap()
+	ti.Prompt = ""
+	ti.Focus()
+
+	m := &Model{
+		label:        label,
+		queryInput:   ti,
+		height:       10,
+		width:        80,
+		marked:       map[int]bool{},
+		previewIndex: -1,
+		cancelable:   true,
+		quitable:     true,
+		focused:      true,
+		keymap:       ui.DefaultKeyMap(),
+		styles:       DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.rank()
+	return m
+}
+
+// rank ranks the candidates for the query. If the query extends the previous query, only the previous matches are
+// searched.
+func (m *Model) rank() {
+	query := m.queryInput.Value()
+	if query == "" {
+		m.term, m.matches = "", nil
+		return
+	}
+
+	var indexes []int
+	if m.term != "" && strings.HasPrefix(query, m.term) {
+		for _, match := range m.matches {
+			indexes = append(indexes, match.Index)
+		}
+		sort.Ints(indexes)
+	} else {
+		indexes = make([]int, len(m.items))
+		for i := range indexes {
+			indexes[i] = i
+		}
+	}
+	m.term, m.matches = query, m.find(query, indexes)
+	sort.Stable(m.matches)
+}
+
+// find returns the unsorted matches of the query among the candidates with the given indexes.
+func (m *Model) find(query string, indexes []int) fuzzy.Matches {
+	matches := fuzzy.FindFromNoSort(query, candidates{items: m.items, indexes: indexes})
+	for i := range matches {
+		matches[i].Index = indexes[matches[i].Index]
+	}
+	return matches
+}
+
+// count returns the number of candidates matching the query.
+func (m *Model) count() int {
+	if m.term == "" {
+		return len(m.items)
+	}
+	return len(m.matches)
+}
+
+// index returns the index of the candidate at the given position of the matches.
+func (m *Model) index(pos int) int {
+	if m.term == "" {
+		if m.order != nil {
+			return m.order[pos]
+		}
+		return pos
+	}
+	return m.matches[pos].Index
+}
+
+// Append adds candidates, ranking them for the current query. The selected match stays selected.
+func (m *Model) Append(items ...string) {
+	start := len(m.items)
+	m.items = append(m.items, items...)
+	if m.recent != nil {
+		m.arrange(start)
+	}
+	if m.term == "" || len(items) == 0 {
+		return
+	}
+
+	selected := -1
+	if m.cursor < len(m.matches) {
+		selected = m.matches[m.cursor].Index
+	}
+	indexes := make([]int, len(items))
+	for i := range indexes {
+		indexes[i] = start + i
+	}
+	m.matches = append(m.matches, m.find(m.term, indexes)...)
+	sort.Stable(m.matches)
+	for i, match := range m.matches {
+		if match.Index == selected {
+			m.cursor = i
+			break
+		}
+	}
+	m.scroll()
+}
+
+// loadHistory loads the recently chosen candidates from the history, if set, so they are listed first for an empty
+// query.
+func (m *Model) loadHistory() {
+	if m.history == nil || m.recent != nil {
+		return
+	}
+	entries, err := m.history.Entries(m.Key())
+	if err != nil {
+		m.err = err
+		return
+	}
+	m.recent = make(map[string]int, len(entries))
+	for age, e := range entries {
+		if _, ok := m.recent[e]; !ok {
+			m.recent[e] = age
+		}
+	}
+	m.order, m.front = make([]int, 0, len(m.items)), 0
+	m.arrange(0)
+}
+
+// arrange adds the candidates starting at the given index to the order listed for an empty query, inserting
+// recently chosen ones among those at the top by age.
+func (m *Model) arrange(start int) {
+	for i := start; i < len(m.items); i++ {
+		age, ok := m.recent[m.items[i]]
+		if !ok {
+			m.order = append(m.order, i)
+			continue
+		}
+		pos := sort.Search(m.front, func(k int) bool { return m.recent[m.items[m.order[k]]] > age })
+		m.order = slices.Insert(m.order, pos, i)
+		m.front++
+	}
+}
+
+// remember adds the chosen candidates to the history, if set. Failing to write the history does not fail the prompt.
+func (m *Model) remember() {
+	if m.history != nil {
+		_ = m.history.Add(m.Key(), m.Selected()...)
+	}
+}
+
+// scroll adjusts the offset so that the cursor is visible.
+func (m *Model) scroll() {
+	m.cursor = max(0, min(m.cursor, m.count()-1))
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	} else if m.cursor >= m.offset+m.height {
+		m.offset = m.cursor - m.height + 1
+	}
+	m.offset = max(0, min(m.offset, m.count()-m.height))
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithItems sets the candidates and returns a new Model with the updated candidates.
+func (m *Model) WithItems(items []string) *Model {
+	return m.With(Items(items))
+}
+
+// WithReader sets the reader the candidates are read from, one per line, and returns a new Model with the updated
+// source.
+func (m *Model) WithReader(r io.Reader) *Model {
+	return m.With(Reader(r))
+}
+
+// WithChannel sets the channel the candidates are received from and returns a new Model with the updated source.
+func (m *Model) WithChannel(ch <-chan string) *Model {
+	return m.With(Channel(ch))
+}
+
+// WithQuery sets the initial query and returns a new Model with the updated query.
+func (m *Model) WithQuery(query string) *Model {
+	return m.With(Query(query))
+}
+
+// WithMulti sets whether several candidates can be marked and returns a new Model with the updated flag.
+func (m *Model) WithMulti(multi bool) *Model {
+	return m.With(Multi(multi))
+}
+
+// WithPreview sets the function returning the preview of a candidate and returns a new Model with the updated
+// function.
+func (m *Model) WithPreview(fn func(item string) string) *Model {
+	return m.With(Preview(fn))
+}
+
+// WithHeight sets the number of visible matches and returns a new Model with the updated height.
+func (m *Model) WithHeight(height int) *Model {
+	return m.With(Height(height))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithHistory sets the history the chosen candidates are added to and returns a new Model with the updated history.
+func (m *Model) WithHistory(h *history.Store) *Model {
+	return m.With(History(h))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Items returns the candidates received so far.
+func (m *Model) Items() []string {
+	return m.items
+}
+
+// Query returns the query.
+func (m *Model) Query() string {
+	return m.queryInput.Value()
+}
+
+// Current returns the candidate at the cursor, or an empty string if no candidate matches the query.
+func (m *Model) Current() string {
+	if m.cursor >= m.count() {
+		return ""
+	}
+	return m.items[m.index(m.cursor)]
+}
+
+// Selected returns the chosen candidates in the order they were received.
+func (m *Model) Selected() []string {
+	selected := make([]string, 0, len(m.chosen))
+	for _, i := range m.chosen {
+		selected = append(selected, m.items[i])
+	}
+	return selected
+}
+
+// choose chooses the marked candidates, or the candidate at the cursor if none is marked. It returns false if there
+// is nothing to choose.
+func (m *Model) choose() bool {
+	m.chosen = nil
+	for i := range m.marked {
+		m.chosen = append(m.chosen, i)
+	}
+	sort.Ints(m.chosen)
+	if len(m.chosen) == 0 && m.cursor < m.count() {
+		m.chosen = []int{m.index(m.cursor)}
+	}
+	return len(m.chosen) > 0
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is a candidate, or a list of candidates in multi-select mode. The
+// candidates must have been received already. It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	var values []string
+	switch v := v.(type) {
+	case string:
+		values = []string{v}
+	case []string:
+		values = v
+	case []any:
+		for _, e := range v {
+			values = append(values, fmt.Sprint(e))
+		}
+	default:
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+	if len(values) == 0 || (!m.multi && len(values) > 1) {
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+
+	var chosen []int
+	for _, value := range values {
+		found := false
+		for i, item := range m.items {
+			if item == value {
+				chosen, found = append(chosen, i), true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("no such item: %s", value)
+		}
+	}
+	sort.Ints(chosen)
+	m.chosen = chosen
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the chosen candidate, or the list of chosen candidates in multi-select mode. It implements
+// ui.AnswerableModel.
+func (m *Model) Answer() any {
+	if m.multi {
+		return m.Selected()
+	}
+	if len(m.chosen) == 0 {
+		return nil
+	}
+	return m.items[m.chosen[0]]
+}
+
+// Choices returns the candidates received so far. It implements ui.ChoiceModel.
+func (m *Model) Choices() []string {
+	return append([]string(nil), m.items...)
+}
+
+// Focus focuses the model, so that it handles key messages. It returns the command starting the cursor blink.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return m.queryInput.Focus()
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+	m.queryInput.Blur()
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// start starts reading candidates from the source, if it was not started yet.
+func (m *Model) start() {
+	if m.feed != nil {
+		return
+	}
+	m.stop = make(chan struct{})
+	switch {
+	case m.reader != nil:
+		feed := make(chan string, maxBatch)
+		m.feed, m.readErr = feed, make(chan error, 1)
+		go func(r io.Reader, stop <-chan struct{}, errc chan<- error) {
+			defer close(feed)
+			sc := bufio.NewScanner(r)
+			sc.Buffer(make([]byte, 64*1024), 1024*1024)
+			for sc.Scan() {
+				select {
+				case feed <- sc.Text():
+				case <-stop:
+					errc <- nil
+					return
+				}
+			}
+			errc <- sc.Err()
+		}(m.reader, m.stop, m.readErr)
+	case m.channel != nil:
+		m.feed = m.channel
+	default:
+		m.ended = true
+	}
+}
+
+// wait returns the command waiting for the next candidates from the source.
+func (m *Model) wait() tea.Cmd {
+	feed := m.feed
+	if feed == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		item, ok := <-feed
+		if !ok {
+			return itemsMsg{model: m, eof: true}
+		}
+		items := []string{item}
+		for len(items) < maxBatch {
+			select {
+			case item, ok := <-feed:
+				if !ok {
+					return itemsMsg{model: m, items: items, eof: true}
+				}
+				items = append(items, item)
+			default:
+				return itemsMsg{model: m, items: items}
+			}
+		}
+		return itemsMsg{model: m, items: items}
+	}
+}
+
+// Init starts reading candidates from the source and returns the commands waiting for them and starting the cursor
+// blink.
+func (m *Model) Init() tea.Cmd {
+	m.loadHistory()
+	m.start()
+	return tea.Batch(m.wait(), textinput.Blink)
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode. Reading
+// from the source is stopped.
+func (m *Model) done() tea.Cmd {
+	if m.stop != nil {
+		close(m.stop)
+		m.stop = nil
+	}
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// UsesKey reports whether the finder handles the key itself, like shift+tab marking a candidate if multiple ones can
+// be selected. It implements ui.KeyModel.
+func (m *Model) UsesKey(msg tea.KeyMsg) bool {
+	return m.multi && key.Matches(msg, markKey, markUpKey)
+}
+
+// Update handles candidates from the source, window size messages and key messages, moving the cursor, marking
+// candidates and editing the query.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case itemsMsg:
+		if msg.model != m {
+			return m, nil
+		}
+		m.Append(msg.items...)
+		if !msg.eof {
+			return m, m.wait()
+		}
+		m.ended = true
+		if m.readErr != nil {
+			if err := <-m.readErr; err != nil {
+				m.err = err
+			}
+		}
+		return m, nil
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		return m, nil
+	case tea.KeyMsg:
+		if !m.focused {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, upKey):
+			m.cursor--
+		case key.Matches(msg, downKey):
+			m.cursor++
+		case key.Matches(msg, pageUpKey):
+			m.cursor -= m.height
+		case key.Matches(msg, pageDownKey):
+			m.cursor += m.height
+		case m.multi && key.Matches(msg, markKey, markUpKey):
+			if m.cursor < m.count() {
+				i := m.index(m.cursor)
+				if m.marked[i] {
+					delete(m.marked, i)
+				} else {
+					m.marked[i] = true
+				}
+			}
+			if key.Matches(msg, markKey) {
+				m.cursor++
+			} else {
+				m.cursor--
+			}
+		case key.Matches(msg, m.keymap.Confirm):
+			if m.choose() {
+				m.canceled, m.quit, m.err = false, false, nil
+				m.remember()
+				return m, m.done()
+			}
+		case key.Matches(msg, m.keymap.Cancel):
+			if m.cancelable {
+				m.canceled, m.quit = true, false
+				return m, m.done()
+			}
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, m.done()
+			}
+		default:
+			var cmd tea.Cmd
+			m.queryInput, cmd = m.queryInput.Update(msg)
+			if m.queryInput.Value() != m.term {
+				m.rank()
+				m.cursor, m.offset = 0, 0
+			}
+			return m, cmd
+		}
+		m.scroll()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.queryInput, cmd = m.queryInput.Update(msg)
+	return m, cmd
+}
+
+// highlight renders the candidate, cut to the given width, with the matched characters at the given byte positions
+// highlighted.
+func (m *Model) highlight(item string, positions []int, width int, style lipgloss.Style) string {
+	matched := make(map[int]bool, len(positions))
+	for _, p := range positions {
+		matched[p] = true
+	}
+
+	ellipsis := ui.Glyphs().Ellipsis
+	truncate := styles.Width(item) > width
+	if truncate {
+		width -= styles.Width(ellipsis)
+	}
+	// Consecutive characters with the same style are rendered together, as styling each one is expensive.
+	var b, run strings.Builder
+	runMatched := false
+	flush := func() {
+		if run.Len() == 0 {
+			return
+		}
+		if runMatched {
+			b.WriteString(m.styles.Match.Render(run.String()))
+		} else {
+			b.WriteString(style.Render(run.String()))
+		}
+		run.Reset()
+	}
+	w := 0
+	styles.Graphemes(item, func(offset int, g string, gw int) bool {
+		if r := g[0]; r == '\t' || r < ' ' {
+			g, gw = " ", 1
+		}
+		if w+gw > width {
+			return false
+		}
+		w += gw
+		hit := false
+		for i := offset; i < offset+len(g) && !hit; i++ {
+			hit = matched[i]
+		}
+		if hit != runMatched {
+			flush()
+			runMatched = hit
+		}
+		run.WriteString(g)
+		return true
+	})
+	flush()
+	if truncate {
+		b.WriteString(style.Render(ellipsis))
+	}
+	return b.String()
+}
+
+// list renders the visible matches, each cut to the given width.
+func (m *Model) list(width int) []string {
+	g := ui.Glyphs()
+	var lines []string
+	end := min(m.count(), m.offset+m.height)
+	for pos := m.offset; pos < end; pos++ {
+		i := m.index(pos)
+		var positions []int
+		if m.term != "" {
+			positions = m.matches[pos].MatchedIndexes
+		}
+
+		prefix := "  "
+		style := m.styles.Item
+		if pos == m.cursor {
+			prefix, style = m.styles.Cursor.Render(g.SelectedLeft)+" ", m.styles.SelectedItem
+		}
+		if m.multi {
+			if m.marked[i] {
+				prefix += m.styles.Marker.Render(g.Checked) + " "
+			} else {
+				prefix += m.styles.Marker.Render(g.Unchecked) + " "
+			}
+		}
+		avail := max(1, width-lipgloss.Width(prefix))
+		lines = append(lines, prefix+m.highlight(m.items[i], positions, avail, style))
+	}
+	for len(lines) < m.height {
+		lines = append(lines, "")
+	}
+	return lines
+}
+
+// previewLines renders the preview of the candidate at the cursor, cut to the given width and the height of the
+// list. The preview is cached until the cursor moves to another candidate.
+func (m *Model) previewLines(width int) []string {
+	text := ""
+	if m.cursor < m.count() {
+		if i := m.index(m.cursor); i != m.previewIndex {
+			m.previewIndex, m.previewText = i, m.preview(m.items[i])
+		}
+		text = m.previewText
+	}
+
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\t", "    "), "\n") {
+		if len(lines) == m.height {
+			break
+		}
+		lines = append(lines, m.styles.Preview.Render(styles.Truncate(line, width)))
+	}
+	return lines
+}
+
+// View renders the label, the query with the number of matches, the visible matches and the preview.
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.label != "" {
+		fmt.Fprintf(&b, "%s\n", m.styles.Label.Render(m.label))
+	}
+
+	status := fmt.Sprintf("%d/%d", m.count(), len(m.items))
+	if m.feed != nil && !m.ended {
+		status += " " + ui.Glyphs().Ellipsis
+	}
+	if len(m.marked) > 0 {
+		status += fmt.Sprintf(" (%d marked)", len(m.marked))
+	}
+	fmt.Fprintf(&b, "%s %s  %s\n", m.styles.Prompt.Render(ui.Glyphs().SelectedLeft), m.queryInput.View(),
+		m.styles.Status.Render(status))
+
+	width := m.width
+	if m.preview == nil {
+		b.WriteString(strings.Join(m.list(width), "\n"))
+	} else {
+		listWidth := max(10, width/2-2)
+		divider := m.styles.Divider.Render(ui.Glyphs().Border.Left)
+		list, preview := m.list(listWidth), m.previewLines(max(10, width-listWidth-3))
+		for i, line := range list {
+			pad := strings.Repeat(" ", max(0, listWidth-lipgloss.Width(line)))
+			b.WriteString(line + pad + " " + divider)
+			if i < len(preview) {
+				b.WriteString(" " + preview[i])
+			}
+			if i < len(list)-1 {
+				b.WriteString("\n")
+			}
+		}
+	}
+
+	hint := "enter select · esc cancel"
+	if m.multi {
+		hint = "tab mark · enter select · esc cancel"
+	}
+	fmt.Fprintf(&b, "\n%s", m.styles.Hint.Render(hint))
+	if m.err != nil {
+		fmt.Fprintf(&b, "\n%s", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return b.String()
+}
+
+// Run runs the model and returns the chosen candidates: a single candidate, or the marked candidates in multi-select
+// mode. It implements ui.Prompt[[]string].
+func (m *Model) Run(ctx context.Context) ([]string, error) {
+	defer func() {
+		if m.stop != nil {
+			close(m.stop)
+			m.stop = nil
+		}
+	}()
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return nil, err
+	}
+	return m.Selected(), nil
+}
+
+// RunAccessible reads all candidates and asks for a query instead of using the terminal UI. The best matches are
+// listed as numbered lines to choose from, or to search again. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	m.loadHistory()
+	m.start()
+	if m.feed != nil {
+		for item := range m.feed {
+			m.items = append(m.items, item)
+		}
+		m.ended = true
+		if m.readErr != nil {
+			if err := <-m.readErr; err != nil {
+				return err
+			}
+		}
+	}
+
+	p := plain.New(in, out)
+	if m.label != "" {
+		p.Println(m.label)
+	}
+	for {
+		query, err := p.Line("Search (empty lists all): ", "")
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+		m.queryInput.SetValue(query)
+		m.rank()
+		n := min(m.count(), maxAccessibleMatches)
+		if n == 0 {
+			p.Println("No matches.")
+			continue
+		}
+
+		items := []string{"(search again)"}
+		for pos := 0; pos < n; pos++ {
+			items = append(items, m.items[m.index(pos)])
+		}
+		if m.count() > n {
+			p.Println(fmt.Sprintf("Showing the best %d of %d matches.", n, m.count()))
+		}
+
+		if !m.multi {
+			i, err := p.Choice("", items, -1)
+			switch {
+			case errors.Is(err, io.EOF):
+				m.canceled, m.quit = true, false
+				return nil
+			case err != nil:
+				return err
+			case i == 0:
+				continue
+			}
+			m.chosen = []int{m.index(i - 1)}
+			m.canceled, m.quit = false, false
+			m.remember()
+			return nil
+		}
+
+		for i, item := range items[1:] {
+			p.Println(fmt.Sprintf("%3d) %s", i+1, item))
+		}
+		s, err := p.Line("Choose (numbers separated by commas, empty to search again): ", "")
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+		var chosen []int
+		for _, e := range strings.Split(s, ",") {
+			if e = strings.TrimSpace(e); e == "" {
+				continue
+			}
+			k, err := strconv.Atoi(e)
+			if err != nil || k < 1 || k > n {
+				p.Println(fmt.Sprintf("Error: invalid choice: %s", e))
+				chosen = nil
+				break
+			}
+			chosen = append(chosen, m.index(k-1))
+		}
+		if len(chosen) == 0 {
+			continue
+		}
+		sort.Ints(chosen)
+		m.chosen = chosen
+		m.canceled, m.quit = false, false
+		m.remember()
+		return nil
+	}
+}
+
+// Showcase demonstrates the Model component with generated candidates and a preview.
+func Showcase() {
+	fmt.Println("=== Finder Showcase ===")
+
+	ch := make(chan string)
+	go func() {
+		defer close(ch)
+		dirs := []string{"cmd", "internal/server", "internal/storage", "pkg/api", "pkg/client", "docs"}
+		names := []string{"main", "handler", "config", "router", "cache", "index", "util", "types"}
+		for i := 0; i < 10000; i++ {
+			ch <- fmt.Sprintf("%s/%s_%d.go", dirs[i%len(dirs)], names[(i/len(dirs))%len(names)], i)
+		}
+	}()
+
+	preview := func(item string) string {
+		return fmt.Sprintf("File: %s\nSize: %d bytes\n\npackage %s", item, len(item)*137, path.Base(path.Dir(item)))
+	}
+	items, err := New("Find files (tab marks several):").WithChannel(ch).WithMulti(true).WithPreview(preview).
+		Run(context.Background())
+	if ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Selected: %s\n", strings.Join(items, ", "))
+	}
+}
@@ -0,0 +1,151 @@
+package finder
+
+import (
+	"io"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/history"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy. The candidates are ranked again.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	newModel.marked = make(map[int]bool, len(m.marked))
+	for i := range m.marked {
+		newModel.marked[i] = true
+	}
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	newModel.term, newModel.matches = "", nil
+	newModel.recent, newModel.order, newModel.front = nil, nil, 0
+	newModel.rank()
+	newModel.scroll()
+	return &newModel
+}
+
+// History sets the history the chosen candidates are added to. Recently chosen candidates are listed first while the
+// query is empty. Entries are namespaced by the key of the prompt, see WithID.
+func History(h *history.Store) Option {
+	return func(m *Model) {
+		m.history = h
+	}
+}
+
+// Label sets the label shown above the query.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Items sets the candidates. Candidates from a reader or channel are added to them.
+func Items(items []string) Option {
+	return func(m *Model) {
+		m.items = items
+		m.marked = map[int]bool{}
+		m.cursor, m.offset, m.previewIndex = 0, 0, -1
+	}
+}
+
+// Reader sets the reader the candidates are read from, one per line, e.g. the output of a command. The finder can be
+// used while the candidates are read.
+func Reader(r io.Reader) Option {
+	return func(m *Model) {
+		m.reader = r
+	}
+}
+
+// Channel sets the channel the candidates are received from. Receiving stops when the channel is closed.
+func Channel(ch <-chan string) Option {
+	return func(m *Model) {
+		m.channel = ch
+	}
+}
+
+// Query sets the initial query.
+func Query(query string) Option {
+	return func(m *Model) {
+		m.queryInput.SetValue(query)
+	}
+}
+
+// Multi sets whether several candidates can be marked with tab. Confirming chooses the marked candidates, or the
+// candidate at the cursor if none is marked.
+func Multi(multi bool) Option {
+	return func(m *Model) {
+		m.multi = multi
+	}
+}
+
+// Preview sets the function returning the preview of a candidate, which is shown next to the candidates. It is called
+// whenever the cursor moves to another candidate and should return quickly.
+func Preview(fn func(item string) string) Option {
+	return func(m *Model) {
+		m.preview = fn
+		m.previewIndex = -1
+	}
+}
+
+// Height sets the number of visible matches.
+func Height(height int) Option {
+	return func(m *Model) {
+		m.height = max(1, height)
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
@@ -0,0 +1,52 @@
+package finder
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Label        lipgloss.Style // Label is the style of the label.
+	Prompt       lipgloss.Style // Prompt is the style of the glyph in front of the query.
+	Status       lipgloss.Style // Status is the style of the number of matches.
+	Item         lipgloss.Style // Item is the style of unselected candidates.
+	SelectedItem lipgloss.Style // SelectedItem is the style of the candidate at the cursor.
+	Match        lipgloss.Style // Match is the style of the characters matching the query.
+	Cursor       lipgloss.Style // Cursor is the style of the glyph marking the candidate at the cursor.
+	Marker       lipgloss.Style // Marker is the style of the checkboxes in multi-select mode.
+	Divider      lipgloss.Style // Divider is the style of the line between the candidates and the preview.
+	Preview      lipgloss.Style // Preview is the style of the preview.
+	Hint         lipgloss.Style // Hint is the style of the hint on the available keys.
+	Error        lipgloss.Style // Error is the style of the error shown below the candidates.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:        lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Prompt:       lipgloss.NewStyle().Foreground(t.Accent),
+		Status:       lipgloss.NewStyle().Faint(true),
+		Item:         lipgloss.NewStyle().Foreground(t.Text),
+		SelectedItem: lipgloss.NewStyle().Foreground(t.Success),
+		Match:        lipgloss.NewStyle().Foreground(t.Accent).Bold(true),
+		Cursor:       lipgloss.NewStyle().Foreground(t.Accent),
+		Marker:       lipgloss.NewStyle().Foreground(t.Accent),
+		Divider:      lipgloss.NewStyle().Faint(true),
+		Preview:      lipgloss.NewStyle().Foreground(t.Text),
+		Hint:         lipgloss.NewStyle().Faint(true),
+		Error:        t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
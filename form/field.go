@@ -0,0 +1,318 @@
+package form
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"       // Manages key bindings
+	"github.com/charmbracelet/bubbles/textinput" // Provides text input model
+	tea "github.com/charmbracelet/bubbletea"     // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/checkboxgroup"
+	"github.com/nmeilick/go-ui/confirm"
+	"github.com/nmeilick/go-ui/durationpicker"
+	"github.com/nmeilick/go-ui/input"
+	"github.com/nmeilick/go-ui/internal/answer"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/pick"
+)
+
+// Component is implemented by the models that can be used as form fields, which includes all components of this
+// module. The form renders the title of the field itself, so components should be created without label.
+type Component interface {
+	tea.Model
+	ui.StandardModel
+	ui.AnswerableModel
+	ui.AccessibleModel
+	Focus() tea.Cmd
+	Blur()
+}
+
+// Field is a single field of a form, asking for a value using a component.
+type Field struct {
+	key         string          // key identifies the value of the field in the results.
+	title       string          // title is shown above the field.
+	description string          // description is shown below the title, if set.
+	model       Component       // model is the component asking for the value.
+	value       func() any      // value returns the typed value of the field.
+	validate    func(any) error // validate validates the value, if set.
+	masked      bool            // masked determines if the value must not be displayed, e.g. in summaries.
+	err         error           // err is the validation error shown below the field.
+}
+
+// Custom returns a field asking for a value using the given component. Its value is the answer of the component.
+func Custom(key, title string, model Component) *Field {
+	return &Field{key: key, title: title, model: model, value: model.Answer}
+}
+
+// Text returns a field asking for a line of text. Its value is a string.
+func Text(key, title string) *Field {
+	m := input.New("", "").WithShowHelp(false)
+	return &Field{key: key, title: title, model: m, value: func() any { return m.Value() }}
+}
+
+// Secret returns a field asking for a line of text without echoing it, e.g. a password. Its value is a string.
+func Secret(key, title string) *Field {
+	m := newSecret()
+	return &Field{key: key, title: title, model: m, value: func() any { return m.input.Value() }, masked: true}
+}
+
+// Number returns a field asking for a number. Its value is a float64.
+func Number(key, title string) *Field {
+	m := input.New("", "0").WithShowHelp(false).WithValidator(ui.ValidatorFunc[string](func(v string) error {
+		if _, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err != nil {
+			return errors.New("a number is required")
+		}
+		return nil
+	}))
+	return &Field{key: key, title: title, model: m, value: func() any {
+		f, _ := strconv.ParseFloat(strings.TrimSpace(m.Value()), 64)
+		return f
+	}}
+}
+
+// Int returns a field asking for an integer. Its value is an int.
+func Int(key, title string) *Field {
+	m := input.New("", "0").WithShowHelp(false).WithValidator(ui.ValidatorFunc[string](func(v string) error {
+		if _, err := strconv.Atoi(strings.TrimSpace(v)); err != nil {
+			return errors.New("an integer is required")
+		}
+		return nil
+	}))
+	return &Field{key: key, title: title, model: m, value: func() any {
+		n, _ := strconv.Atoi(strings.TrimSpace(m.Value()))
+		return n
+	}}
+}
+
+// Duration returns a field asking for a duration using a duration picker. Its value is a time.Duration.
+func Duration(key, title string) *Field {
+	m := durationpicker.New("")
+	return &Field{key: key, title: title, model: m, value: func() any { return m.Value() }}
+}
+
+// Path returns a field asking for a file system path. Its value is a string, cleaned and with a leading "~" expanded
+// to the home directory.
+func Path(key, title string) *Field {
+	m := input.New("", "").WithShowHelp(false)
+	return &Field{key: key, title: title, model: m, value: func() any { return expandPath(m.Value()) }}
+}
+
+// expandPath cleans the path and expands a leading "~" to the home directory. An empty path is returned unchanged.
+func expandPath(path string) string {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return ""
+	}
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = home + path[1:]
+		}
+	}
+	return filepath.Clean(path)
+}
+
+// Select returns a field asking to select one of the given items. Its value is the selected item.
+func Select(key, title string, items ...string) *Field {
+	m := pick.New(items)
+	return &Field{key: key, title: title, model: m, value: func() any { return m.SelectedItem() }}
+}
+
+// MultiSelect returns a field asking to check any number of the given items. Its value is a []string holding the
+// checked items.
+func MultiSelect(key, title string, items ...string) *Field {
+	m := checkboxgroup.New("", checkboxgroup.Strings(items...))
+	return &Field{key: key, title: title, model: m, value: func() any {
+		return append([]string{}, checkboxgroup.Values[string](m)...)
+	}}
+}
+
+// Confirm returns a field asking a yes/no question. Its value is a bool.
+func Confirm(key, title string) *Field {
+	m := confirm.New("")
+	return &Field{key: key, title: title, model: m, value: func() any { return m.Value() }}
+}
+
+// Description sets the description shown below the title and returns the field.
+func (f *Field) Description(s string) *Field {
+	f.description = s
+	return f
+}
+
+// Default sets the initial value of the field, in any form accepted by the SetAnswer method of its component, and
+// returns the field. It panics if the value is not accepted, as this is a programming error.
+func (f *Field) Default(v any) *Field {
+	if err := f.model.SetAnswer(v); err != nil {
+		panic(fmt.Sprintf("form: invalid default for field %s: %v", f.key, err))
+	}
+	return f
+}
+
+// Validate sets the function validating the value of the field and returns the field. Use Check to validate using a
+// typed ui.Validator.
+func (f *Field) Validate(fn func(v any) error) *Field {
+	f.validate = fn
+	return f
+}
+
+// Check adapts a typed validator to the untyped validation function of a field. Values of another type are rejected.
+func Check[T any](validator ui.Validator[T]) func(v any) error {
+	return func(v any) error {
+		t, ok := v.(T)
+		if !ok {
+			return fmt.Errorf("invalid value: %v", v)
+		}
+		return validator.Validate(t)
+	}
+}
+
+// Key returns the key identifying the value of the field.
+func (f *Field) Key() string {
+	return f.key
+}
+
+// Title returns the title of the field.
+func (f *Field) Title() string {
+	return f.title
+}
+
+// Model returns the component asking for the value, e.g. to configure it further.
+func (f *Field) Model() Component {
+	return f.model
+}
+
+// Value returns the typed value of the field.
+func (f *Field) Value() any {
+	return f.value()
+}
+
+// Masked returns whether the value must not be displayed, e.g. in summaries, which is the case for secret fields.
+func (f *Field) Masked() bool {
+	return f.masked
+}
+
+// Err returns the validation error of the field, if any.
+func (f *Field) Err() error {
+	return f.err
+}
+
+// check validates the value of the field and stores the error.
+func (f *Field) check() error {
+	f.err = nil
+	if f.validate != nil {
+		f.err = f.validate(f.value())
+	}
+	return f.err
+}
+
+var _ Component = (*secret)(nil)
+
+// secret is a minimal component asking for a line of text without echoing it.
+type secret struct {
+	input    textinput.Model // input is the masked text input.
+	keymap   ui.KeyMap       // keymap holds the key bindings of the model.
+	canceled bool            // canceled indicates whether the input was canceled
+	quit     bool            // quit indicates whether the input was quit
+}
+
+// newSecret returns a new secret component.
+func newSecret() *secret {
+	ti := textinput.New()
+	ti.KeyMap = ui.DefaultTextKeyMap()
+	ti.Prompt = ""
+	ti.EchoMode = textinput.EchoPassword
+	ti.EchoCharacter = '*'
+	ti.CharLimit = 256
+	ti.Width = 40
+	return &secret{input: ti, keymap: ui.DefaultKeyMap()}
+}
+
+// Key returns an empty key, as the form identifies its fields itself. It implements ui.AnswerableModel.
+func (m *secret) Key() string {
+	return ""
+}
+
+// SetAnswer sets the value. It implements ui.AnswerableModel.
+func (m *secret) SetAnswer(v any) error {
+	m.input.SetValue(answer.String(v))
+	return nil
+}
+
+// Answer returns the value. It implements ui.AnswerableModel.
+func (m *secret) Answer() any {
+	return m.input.Value()
+}
+
+// Focus focuses the model and returns the command starting the cursor blink.
+func (m *secret) Focus() tea.Cmd {
+	return m.input.Focus()
+}
+
+// Blur removes the focus from the model.
+func (m *secret) Blur() {
+	m.input.Blur()
+}
+
+// Canceled returns the canceled flag.
+func (m *secret) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *secret) Quit() bool {
+	return m.quit
+}
+
+// Init returns a nil command.
+func (m *secret) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles key messages like input.Model.
+func (m *secret) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && m.input.Focused() {
+		switch {
+		case key.Matches(msg, m.keymap.Confirm):
+			m.canceled, m.quit = false, false
+			return m, tea.Quit
+		case key.Matches(msg, m.keymap.Cancel):
+			m.canceled, m.quit = true, false
+			return m, tea.Quit
+		case key.Matches(msg, m.keymap.Quit):
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m, tea.Quit
+		}
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+// View renders the masked input.
+func (m *secret) View() string {
+	return m.input.View()
+}
+
+// RunAccessible asks for a line of input. As the line is echoed by the terminal, a note is shown. It implements
+// ui.AccessibleModel.
+func (m *secret) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	s, err := p.Line("(input is visible) ", "")
+	switch {
+	case errors.Is(err, io.EOF):
+		m.canceled, m.quit = true, false
+		return nil
+	case err != nil:
+		return err
+	}
+	if s != "" {
+		m.input.SetValue(s)
+	}
+	m.canceled, m.quit = false, false
+	return nil
+}
@@ -0,0 +1,683 @@
+package form
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/errorview"
+)
+
+var _ ui.Prompt[ui.Results] = (*Model)(nil)
+
+var (
+	nextFieldKey = key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "next field"))
+	prevFieldKey = key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "previous field"))
+	submitKey    = key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "submit"))
+)
+
+// Group is a titled group of fields. Groups are shown one below the other, or one per page in paged mode.
+type Group struct {
+	Title  string   // Title is shown above the fields of the group, if set.
+	Fields []*Field // Fields are the fields of the group.
+}
+
+// Model represents a form asking for several values at once. Each field is answered using one of the components;
+// tab and shift+tab move between the fields and enter in the last field submits the form.
+type Model struct {
+	title          string              // title is shown above the form.
+	groups         []Group             // groups are the groups of fields.
+	fields         []*Field            // fields are the fields of all groups, in order.
+	groupOf        []int               // groupOf holds the index of the group of each field.
+	current        int                 // current is the index of the focused field.
+	paged          bool                // paged determines if one group is shown at a time.
+	showHelp       bool                // showHelp determines if the key help is shown below the form.
+	cancelable     bool                // cancelable determines if the form can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	id             string              // id identifies the prompt, e.g. for preset answers
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                // focused determines if the model handles key messages
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error               // err is shown below the model, e.g. why the previous answer was rejected
+	errview        errorview.Model     // errview renders the errors.
+
+	canceled bool // canceled indicates whether the form was canceled
+	quit     bool // quit indicates whether the form was quit
+}
+
+// New creates and returns a new Model with the given title and fields, which form a single untitled group.
+func New(title string, fields ...*Field) *Model {
+	return NewGroups(title, []Group{{Fields: fields}})
+}
+
+// NewGroups creates and returns a new Model with the given title and groups of fields, configured by the given
+// options.
+func NewGroups(title string, groups []Group, opts ...Option) *Model {
+	m := &Model{
+		title:      title,
+		groups:     groups,
+		showHelp:   ui.HelpShown(),
+		cancelable: true,
+		quitable:   true,
+		focused:    true,
+		styles:     DefaultStyles(),
+		errview:    *errorview.New(nil),
+
+		canceled: false,
+		quit:     false,
+	}
+	for i, g := range groups {
+		for _, f := range g.Fields {
+			m.fields = append(m.fields, f)
+			m.groupOf = append(m.groupOf, i)
+		}
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithTitle sets the title of the Model and returns a new Model with the updated title.
+func (m *Model) WithTitle(title string) *Model {
+	return m.With(Title(title))
+}
+
+// WithPaged sets whether one group is shown at a time and returns a new Model with the updated flag.
+func (m *Model) WithPaged(paged bool) *Model {
+	return m.With(Paged(paged))
+}
+
+// WithShowHelp sets whether the key help is shown below the form and returns a new Model with the updated flag.
+func (m *Model) WithShowHelp(show bool) *Model {
+	return m.With(ShowHelp(show))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the title is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Fields returns all fields of the form.
+func (m *Model) Fields() []*Field {
+	return m.fields
+}
+
+// Field returns the field with the given key, or nil if there is none.
+func (m *Model) Field(key string) *Field {
+	for _, f := range m.fields {
+		if f.key == key {
+			return f
+		}
+	}
+	return nil
+}
+
+// Results returns the typed values of all fields, keyed by field.
+func (m *Model) Results() ui.Results {
+	results := make(ui.Results, len(m.fields))
+	for _, f := range m.fields {
+		results[f.key] = f.Value()
+	}
+	return results
+}
+
+// Get returns the value of the field with the given key if it exists and is of type T.
+func Get[T any](m *Model, key string) (T, bool) {
+	var zero T
+	f := m.Field(key)
+	if f == nil {
+		return zero, false
+	}
+	v, ok := f.Value().(T)
+	return v, ok
+}
+
+// Decode stores the values of the fields in the struct pointed to by dst. A value is stored in the struct field
+// tagged `form:"<key>"` or, if there is none, in the field whose name equals the key ignoring case. Values are
+// converted to the type of the struct field if possible; fields without matching struct field are ignored.
+func (m *Model) Decode(dst any) error {
+	return DecodeResults(m.Results(), dst)
+}
+
+// DecodeResults stores the given results in the struct pointed to by dst, like Model.Decode. It allows decoding
+// results collected by other models, e.g. a wizard.
+func DecodeResults(results ui.Results, dst any) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("form: decode target must be a pointer to a struct, got %T", dst)
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	keys := make([]string, 0, len(results))
+	for k := range results {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		idx := -1
+		for i := 0; i < rt.NumField(); i++ {
+			sf := rt.Field(i)
+			if !sf.IsExported() {
+				continue
+			}
+			if tag, ok := sf.Tag.Lookup("form"); ok {
+				if tag == k {
+					idx = i
+					break
+				}
+				continue
+			}
+			if idx < 0 && strings.EqualFold(sf.Name, k) {
+				idx = i
+			}
+		}
+		if idx < 0 {
+			continue
+		}
+
+		target := rv.Field(idx)
+		v := reflect.ValueOf(results[k])
+		switch {
+		case !v.IsValid():
+			continue
+		case v.Type().AssignableTo(target.Type()):
+			target.Set(v)
+		case convertible(v.Type(), target.Type()):
+			target.Set(v.Convert(target.Type()))
+		default:
+			return fmt.Errorf("form: cannot store %s (%T) in field %s of type %s", k, results[k],
+				rt.Field(idx).Name, target.Type())
+		}
+	}
+	return nil
+}
+
+// convertible returns whether values of type from can be converted to type to without changing their meaning, i.e.
+// between numbers, between strings and between bools, but not from numbers to strings.
+func convertible(from, to reflect.Type) bool {
+	return from.ConvertibleTo(to) && kindClass(from) == kindClass(to) && kindClass(from) != reflect.Invalid
+}
+
+// kindClass returns reflect.Float64 for all numeric types, the kind for strings, bools and slices and reflect.Invalid
+// for all other types.
+func kindClass(t reflect.Type) reflect.Kind {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8,
+		reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	case reflect.String, reflect.Bool, reflect.Slice:
+		return t.Kind()
+	}
+	return reflect.Invalid
+}
+
+// Key returns the ID of the prompt, or its title if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.title
+}
+
+// SetAnswer applies a preset answer, which is a map of field keys to values accepted by the components of the
+// fields. Fields not in the map keep their value. It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	answers, ok := v.(map[string]any)
+	if !ok {
+		if results, isResults := v.(ui.Results); isResults {
+			answers, ok = results, true
+		}
+	}
+	if !ok {
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+	for k, v := range answers {
+		f := m.Field(k)
+		if f == nil {
+			return fmt.Errorf("unknown field: %s", k)
+		}
+		if err := f.model.SetAnswer(v); err != nil {
+			return fmt.Errorf("%s: %w", k, err)
+		}
+	}
+	if err := m.validate(); err != nil {
+		return err
+	}
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the answers of the components of all fields, keyed by field. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	answers := make(map[string]any, len(m.fields))
+	for _, f := range m.fields {
+		answers[f.key] = f.model.Answer()
+	}
+	return answers
+}
+
+// Focus focuses the model and its current field, and returns the command of the field.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return m.focus(m.current)
+}
+
+// Blur removes the focus from the model and its current field.
+func (m *Model) Blur() {
+	m.focused = false
+	if m.current < len(m.fields) {
+		m.fields[m.current].model.Blur()
+	}
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// fieldDoneMsg is sent when the component of the field with the given index quit.
+type fieldDoneMsg struct {
+	form  *Model
+	field int
+}
+
+// wrap wraps the command returned by the component of the field with the given index, so that quitting the program
+// finishes the field instead.
+func (m *Model) wrap(field int, cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		switch msg := cmd().(type) {
+		case tea.QuitMsg:
+			return fieldDoneMsg{form: m, field: field}
+		case tea.BatchMsg:
+			batch := make(tea.BatchMsg, len(msg))
+			for i, cmd := range msg {
+				batch[i] = m.wrap(field, cmd)
+			}
+			return batch
+		default:
+			return msg
+		}
+	}
+}
+
+// Init blurs all fields but the first and returns the commands of the fields.
+func (m *Model) Init() tea.Cmd {
+	var cmds []tea.Cmd
+	for i, f := range m.fields {
+		cmds = append(cmds, m.wrap(i, f.model.Init()))
+		f.model.Blur()
+	}
+	if m.focused {
+		cmds = append(cmds, m.focus(m.current))
+	}
+	return tea.Batch(cmds...)
+}
+
+// focus focuses the field with the given index, blurring the previously focused one.
+func (m *Model) focus(i int) tea.Cmd {
+	if len(m.fields) == 0 {
+		return nil
+	}
+	if m.current < len(m.fields) {
+		m.fields[m.current].model.Blur()
+	}
+	m.current = min(max(i, 0), len(m.fields)-1)
+	return m.wrap(m.current, m.fields[m.current].model.Focus())
+}
+
+// validate validates all fields, focusing the first invalid one, and returns an error if any field is invalid.
+func (m *Model) validate() error {
+	first := -1
+	for i, f := range m.fields {
+		if f.check() != nil && first < 0 {
+			first = i
+		}
+	}
+	if first < 0 {
+		return nil
+	}
+	m.focus(first)
+	return fmt.Errorf("%s: %w", m.fields[first].title, m.fields[first].err)
+}
+
+// submit validates all fields and finishes the form if they are valid.
+func (m *Model) submit() tea.Cmd {
+	if m.validate() != nil {
+		f := m.fields[m.current]
+		ui.RecordValidationFailure(f.Key(), f.err)
+		return m.focus(m.current)
+	}
+	m.canceled, m.quit = false, false
+	return m.done()
+}
+
+// finishField handles a finished component: cancelation and quitting are passed on to the form, otherwise the field
+// is validated and the next field is focused, or the form is submitted after the last field.
+func (m *Model) finishField(i int) tea.Cmd {
+	f := m.fields[i]
+	switch {
+	case f.model.Quit():
+		if m.quitable {
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m.done()
+		}
+		return nil
+	case f.model.Canceled():
+		if m.cancelable {
+			m.canceled, m.quit = true, false
+			return m.done()
+		}
+		return nil
+	case f.check() != nil:
+		ui.RecordValidationFailure(f.Key(), f.err)
+		return nil
+	case i == len(m.fields)-1:
+		return m.submit()
+	}
+	return m.focus(i + 1)
+}
+
+// UsesKey reports whether the form handles the key itself, like tab and ctrl+s. shift+tab is only used if a field other
+// than the first one is focused, so that a wizard or sequence running the form can use it to go back. It implements
+// ui.KeyModel.
+func (m *Model) UsesKey(msg tea.KeyMsg) bool {
+	return key.Matches(msg, nextFieldKey, submitKey) || (key.Matches(msg, prevFieldKey) && m.current > 0)
+}
+
+// Update handles tab and shift+tab to move between the fields and passes all other messages to the focused field.
+// Window size messages are passed to all fields.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		var cmds []tea.Cmd
+		for i, f := range m.fields {
+			_, cmd := f.model.Update(msg)
+			cmds = append(cmds, m.wrap(i, cmd))
+		}
+		return m, tea.Batch(cmds...)
+	}
+	switch msg := msg.(type) {
+	case fieldDoneMsg:
+		if msg.form == m {
+			return m, m.finishField(msg.field)
+		}
+	case tea.WindowSizeMsg:
+		m.errview.Update(msg)
+		var cmds []tea.Cmd
+		for i, f := range m.fields {
+			var cmd tea.Cmd
+			_, cmd = f.model.Update(msg)
+			cmds = append(cmds, m.wrap(i, cmd))
+		}
+		return m, tea.Batch(cmds...)
+	case tea.KeyMsg:
+		if !m.focused || len(m.fields) == 0 {
+			return m, nil
+		}
+		switch {
+		case m.errview.Toggle(msg, m.focusedErr()):
+			return m, nil
+		case key.Matches(msg, nextFieldKey):
+			m.fields[m.current].check()
+			return m, m.focus((m.current + 1) % len(m.fields))
+		case key.Matches(msg, prevFieldKey):
+			m.fields[m.current].check()
+			return m, m.focus((m.current + len(m.fields) - 1) % len(m.fields))
+		case key.Matches(msg, submitKey):
+			return m, m.submit()
+		}
+	}
+
+	if len(m.fields) == 0 {
+		return m, nil
+	}
+	f := m.fields[m.current]
+	_, cmd := f.model.Update(msg)
+	if f.err != nil {
+		// Clear the error as soon as the value is valid again.
+		f.check()
+	}
+	return m, m.wrap(m.current, cmd)
+}
+
+// focusedErr returns the error of the focused field, or the error of the form if the field has none.
+func (m *Model) focusedErr() error {
+	if err := m.fields[m.current].err; err != nil {
+		return err
+	}
+	return m.err
+}
+
+// View renders the title and the visible groups with their fields. The focused field is marked by a bar on its left.
+func (m *Model) View() string {
+	var b strings.Builder
+	g := ui.Glyphs()
+	if m.title != "" {
+		fmt.Fprintf(&b, "%s\n", m.styles.Title.Render(m.title))
+	}
+
+	page := -1
+	if m.paged && len(m.fields) > 0 {
+		page = m.groupOf[m.current]
+		fmt.Fprintf(&b, "%s\n", m.styles.Page.Render(fmt.Sprintf("Page %d of %d", page+1, len(m.groups))))
+	}
+
+	for gi, group := range m.groups {
+		if page >= 0 && gi != page {
+			continue
+		}
+		if group.Title != "" {
+			fmt.Fprintf(&b, "\n%s\n", m.styles.Group.Render(group.Title))
+		}
+		for _, f := range group.Fields {
+			focused := m.focused && len(m.fields) > 0 && f == m.fields[m.current]
+			bar := "  "
+			if focused {
+				bar = m.styles.Bar.Render(g.FocusBar) + " "
+			}
+
+			lines := []string{m.styles.FieldTitle.Render(f.title)}
+			if focused {
+				lines[0] = m.styles.FocusedTitle.Render(f.title)
+			}
+			if f.description != "" {
+				lines = append(lines, m.styles.Description.Render(f.description))
+			}
+			lines = append(lines, strings.Split(f.model.View(), "\n")...)
+			if f.err != nil {
+				lines = append(lines, strings.Split(m.errview.RenderWith(m.styles.Error, f.err), "\n")...)
+			}
+
+			b.WriteString("\n")
+			for _, line := range lines {
+				fmt.Fprintf(&b, "%s%s\n", bar, line)
+			}
+		}
+	}
+
+	if m.err != nil {
+		fmt.Fprintf(&b, "\n%s\n", m.errview.RenderWith(m.styles.Error, m.err))
+	}
+	if m.showHelp {
+		fmt.Fprintf(&b, "\n%s\n", m.styles.Help.Render("tab next · shift+tab previous · enter next/submit · ctrl+s submit · esc cancel"))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// Run runs the model and returns the typed values of all fields. It implements ui.Prompt[ui.Results].
+func (m *Model) Run(ctx context.Context) (ui.Results, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return nil, err
+	}
+	return m.Results(), nil
+}
+
+// RunAccessible asks for the fields one after another using line-based prompts instead of the terminal UI. It
+// implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	if m.title != "" {
+		fmt.Fprintln(out, m.title)
+	}
+	group := -1
+	for i, f := range m.fields {
+		if m.groupOf[i] != group {
+			group = m.groupOf[i]
+			if title := m.groups[group].Title; title != "" {
+				fmt.Fprintf(out, "\n%s\n", title)
+			}
+		}
+		for {
+			fmt.Fprintln(out, f.title)
+			if f.description != "" {
+				fmt.Fprintln(out, f.description)
+			}
+			if err := f.model.RunAccessible(in, out); err != nil {
+				return err
+			}
+			if f.model.Canceled() || f.model.Quit() {
+				m.canceled, m.quit = f.model.Canceled(), f.model.Quit()
+				return nil
+			}
+			if err := f.check(); err != nil {
+				ui.RecordValidationFailure(f.Key(), err)
+				fmt.Fprintln(out, "Error:", err)
+				continue
+			}
+			break
+		}
+	}
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Ask runs a form with the given title and fields and stores the values in the struct pointed to by dst, see Decode.
+func Ask(ctx context.Context, dst any, title string, fields ...*Field) error {
+	m := New(title, fields...)
+	if _, err := m.Run(ctx); err != nil {
+		return err
+	}
+	return m.Decode(dst)
+}
+
+// Showcase demonstrates the Model component with grouped fields, validation and struct binding.
+func Showcase() {
+	fmt.Println("=== Form Showcase ===")
+
+	var cfg struct {
+		Name     string
+		Password string
+		Port     int
+		Region   string
+		Features []string
+		Data     string `form:"dir"`
+		Start    bool
+	}
+
+	m := NewGroups("Create a service", []Group{
+		{Title: "Account", Fields: []*Field{
+			Text("name", "Name").Validate(Check(ui.NotEmpty())),
+			Secret("password", "Password").Description("At least 8 characters").Validate(Check(ui.MinLength(8))),
+		}},
+		{Title: "Deployment", Fields: []*Field{
+			Int("port", "Port").Default(8080).Validate(Check(ui.ValidatorFunc[int](func(v int) error {
+				if v < 1 || v > 65535 {
+					return errors.New("port must be between 1 and 65535")
+				}
+				return nil
+			}))),
+			Select("region", "Region", "eu-central-1", "us-east-1", "us-west-2"),
+			MultiSelect("features", "Features", "Logging", "Metrics", "Tracing"),
+			Path("dir", "Data directory").Default("~/data"),
+			Confirm("start", "Start after creation?"),
+		}},
+	})
+	if _, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) != nil {
+		return
+	}
+	if err := m.Decode(&cfg); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Name: %s, port: %d, region: %s, features: %v, directory: %s, start: %t\n",
+		cfg.Name, cfg.Port, cfg.Region, cfg.Features, cfg.Data, cfg.Start)
+}
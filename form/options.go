@@ -0,0 +1,83 @@
+package form
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to NewGroups or
+// applied to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Title sets the title shown above the form.
+func Title(title string) Option {
+	return func(m *Model) {
+		m.title = title
+	}
+}
+
+// Paged sets whether one group is shown at a time. Moving past the last field of a group shows the next one.
+func Paged(paged bool) Option {
+	return func(m *Model) {
+		m.paged = paged
+	}
+}
+
+// ShowHelp sets whether the key help is shown below the form, overriding the package-wide setting of ui.ShowHelp.
+func ShowHelp(show bool) Option {
+	return func(m *Model) {
+		m.showHelp = show
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the title is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
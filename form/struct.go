@@ -0,0 +1,295 @@
+package form
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nmeilick/go-ui"
+)
+
+// durationType is the type of time.Duration, which is asked for using a duration picker instead of an integer field.
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// AskStruct asks for the exported fields of the struct pointed to by dst in a form and stores the answers in them.
+// See FromStruct for the supported field types and tags:
+//
+//	var cfg struct {
+//		Name   string        `prompt:"Service name" validate:"required,max=32"`
+//		Port   int           `prompt:"Port" default:"8080" validate:"min=1,max=65535"`
+//		Region string        `prompt:"Region" options:"eu-central-1,us-east-1"`
+//		Token  string        `prompt:"API token" secret:"true"`
+//		TTL    time.Duration `prompt:"Cache TTL" default:"1h"`
+//	}
+//	err := form.AskStruct(ctx, &cfg, form.Title("Create a service"))
+func AskStruct(ctx context.Context, dst any, opts ...Option) error {
+	fields, err := FromStruct(dst)
+	if err != nil {
+		return err
+	}
+	m := NewGroups("", []Group{{Fields: fields}}, opts...)
+	if _, err := m.Run(ctx); err != nil {
+		return err
+	}
+	return StoreStruct(fields, dst)
+}
+
+// FromStruct returns a field for each exported field of the struct pointed to by dst, keyed by the name of the
+// struct field. Fields are configured by tags:
+//
+//   - prompt sets the title, which defaults to the name of the struct field; "-" skips the field.
+//   - default sets the initial value. Otherwise, the current value is used unless it is the zero value.
+//   - options turns a string into a Select and a []string into a MultiSelect of the comma-separated items.
+//   - secret:"true" asks for a string without echoing it.
+//   - validate holds comma-separated rules: required, min=N and max=N, which limit the length of strings, the value
+//     of numbers and the number of items of slices, and regexp=PATTERN, which must be the last rule.
+//
+// Strings, bools, integers, floats, time.Duration and, with options, []string are supported, as well as types
+// based on them. Other types result in an error. Unsigned integers are checked not to be negative.
+func FromStruct(dst any) ([]*Field, error) {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("form: target must be a pointer to a struct, got %T", dst)
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	var fields []*Field
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		title, ok := sf.Tag.Lookup("prompt")
+		if !sf.IsExported() || title == "-" {
+			continue
+		}
+		if !ok || title == "" {
+			title = sf.Name
+		}
+		f, err := structField(sf, title)
+		if err != nil {
+			return nil, fmt.Errorf("form: field %s: %w", sf.Name, err)
+		}
+		if err := structDefault(f, sf, rv.Field(i)); err != nil {
+			return nil, fmt.Errorf("form: field %s: invalid default: %w", sf.Name, err)
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// StoreStruct stores the values of fields created by FromStruct in the struct pointed to by dst, converting them to
+// the types of the struct fields.
+func StoreStruct(fields []*Field, dst any) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("form: target must be a pointer to a struct, got %T", dst)
+	}
+	rv = rv.Elem()
+	for _, f := range fields {
+		target := rv.FieldByName(f.key)
+		if !target.IsValid() {
+			continue
+		}
+		v := reflect.ValueOf(f.Value())
+		if !v.Type().ConvertibleTo(target.Type()) {
+			return fmt.Errorf("form: cannot store %s (%T) in field of type %s", f.key, f.Value(), target.Type())
+		}
+		target.Set(v.Convert(target.Type()))
+	}
+	return nil
+}
+
+// structField returns the field asking for the value of the struct field.
+func structField(sf reflect.StructField, title string) (*Field, error) {
+	key, t := sf.Name, sf.Type
+	var options []string
+	if s, ok := sf.Tag.Lookup("options"); ok {
+		for _, o := range strings.Split(s, ",") {
+			if o = strings.TrimSpace(o); o != "" {
+				options = append(options, o)
+			}
+		}
+		if len(options) == 0 {
+			return nil, errors.New("no options")
+		}
+	}
+	secret, _ := strconv.ParseBool(sf.Tag.Get("secret"))
+
+	var f *Field
+	switch k := t.Kind(); {
+	case options != nil && k == reflect.String:
+		f = Select(key, title, options...)
+	case options != nil && k == reflect.Slice && t.Elem().Kind() == reflect.String:
+		f = MultiSelect(key, title, options...)
+	case options != nil:
+		return nil, fmt.Errorf("options are not supported for %s", t)
+	case secret && k == reflect.String:
+		f = Secret(key, title)
+	case secret:
+		return nil, fmt.Errorf("secret is not supported for %s", t)
+	case k == reflect.String:
+		f = Text(key, title)
+	case k == reflect.Bool:
+		f = Confirm(key, title)
+	case t == durationType:
+		f = Duration(key, title)
+	case isInteger(k):
+		f = Int(key, title)
+	case k == reflect.Float32 || k == reflect.Float64:
+		f = Number(key, title)
+	default:
+		return nil, fmt.Errorf("unsupported type %s", t)
+	}
+
+	validate, err := structValidator(sf.Tag.Get("validate"), t)
+	if err != nil {
+		return nil, err
+	}
+	if isUnsigned(t.Kind()) {
+		validate = append(validate, func(v any) error {
+			if n, ok := v.(int); ok && n < 0 {
+				return errors.New("must not be negative")
+			}
+			return nil
+		})
+	}
+	if len(validate) > 0 {
+		f.Validate(func(v any) error {
+			for _, fn := range validate {
+				if err := fn(v); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	return f, nil
+}
+
+// structDefault sets the initial value of the field from the default tag or, if there is none, the current value
+// of the struct field unless it is the zero value.
+func structDefault(f *Field, sf reflect.StructField, v reflect.Value) error {
+	if s, ok := sf.Tag.Lookup("default"); ok {
+		if sf.Type.Kind() == reflect.Bool {
+			b, err := strconv.ParseBool(s)
+			if err != nil {
+				return err
+			}
+			return f.model.SetAnswer(b)
+		}
+		return f.model.SetAnswer(s)
+	}
+	if v.IsZero() {
+		return nil
+	}
+	switch k := v.Kind(); {
+	case v.Type() == durationType:
+		return f.model.SetAnswer(time.Duration(v.Int()))
+	case k == reflect.Slice:
+		items := make([]string, v.Len())
+		for i := range items {
+			items[i] = v.Index(i).String()
+		}
+		return f.model.SetAnswer(items)
+	case k == reflect.Bool:
+		return f.model.SetAnswer(v.Bool())
+	case k == reflect.String:
+		return f.model.SetAnswer(v.String())
+	case isUnsigned(k):
+		return f.model.SetAnswer(strconv.FormatUint(v.Uint(), 10))
+	case isInteger(k):
+		return f.model.SetAnswer(strconv.FormatInt(v.Int(), 10))
+	}
+	return f.model.SetAnswer(strconv.FormatFloat(v.Float(), 'g', -1, 64))
+}
+
+// structValidator returns the validation functions for the rules of a validate tag.
+func structValidator(tag string, t reflect.Type) ([]func(any) error, error) {
+	var fns []func(any) error
+	for tag = strings.TrimSpace(tag); tag != ""; tag = strings.TrimSpace(tag) {
+		var rule string
+		if strings.HasPrefix(tag, "regexp=") {
+			rule, tag = tag, ""
+		} else {
+			rule, tag, _ = strings.Cut(tag, ",")
+		}
+		name, arg, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		switch name {
+		case "":
+		case "required":
+			fns = append(fns, required)
+		case "min", "max":
+			limit, err := strconv.ParseFloat(arg, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s rule: %q", name, arg)
+			}
+			fns = append(fns, limitValidator(name == "min", limit))
+		case "regexp":
+			re, err := regexp.Compile(arg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regexp rule: %w", err)
+			}
+			if t.Kind() != reflect.String {
+				return nil, fmt.Errorf("regexp rule is not supported for %s", t)
+			}
+			fns = append(fns, Check(ui.Matches(re, "must match "+arg)))
+		default:
+			return nil, fmt.Errorf("unknown validation rule %q", name)
+		}
+	}
+	return fns, nil
+}
+
+// required rejects empty or whitespace-only strings, empty slices and other zero values.
+func required(v any) error {
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Kind() == reflect.String && strings.TrimSpace(rv.String()) == "",
+		rv.Kind() == reflect.Slice && rv.Len() == 0,
+		rv.IsZero():
+		return errors.New("a value is required")
+	}
+	return nil
+}
+
+// limitValidator returns a validation function checking that the length of strings, the value of numbers and the
+// number of items of slices is at least (min) or at most (max) limit.
+func limitValidator(min bool, limit float64) func(any) error {
+	return func(v any) error {
+		var n float64
+		what := "the value"
+		switch v := v.(type) {
+		case string:
+			n, what = float64(len([]rune(v))), "the length"
+		case []string:
+			n, what = float64(len(v)), "the number of items"
+		case int:
+			n = float64(v)
+		case float64:
+			n = v
+		default:
+			return nil
+		}
+		if min && n < limit {
+			return fmt.Errorf("%s must be at least %s", what, strconv.FormatFloat(limit, 'f', -1, 64))
+		}
+		if !min && n > limit {
+			return fmt.Errorf("%s must be at most %s", what, strconv.FormatFloat(limit, 'f', -1, 64))
+		}
+		return nil
+	}
+}
+
+// isInteger reports whether k is a signed or unsigned integer kind.
+func isInteger(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Uint64
+}
+
+// isUnsigned reports whether k is an unsigned integer kind.
+func isUnsigned(k reflect.Kind) bool {
+	return k >= reflect.Uint && k <= reflect.Uint64
+}
@@ -0,0 +1,48 @@
+package form
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/errorview"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Title        lipgloss.Style // Title is the style of the title of the form.
+	Page         lipgloss.Style // Page is the style of the page indicator in paged mode.
+	Group        lipgloss.Style // Group is the style of the titles of the groups.
+	FieldTitle   lipgloss.Style // FieldTitle is the style of the titles of the unfocused fields.
+	FocusedTitle lipgloss.Style // FocusedTitle is the style of the title of the focused field.
+	Description  lipgloss.Style // Description is the style of the descriptions of the fields.
+	Bar          lipgloss.Style // Bar is the style of the bar marking the focused field.
+	Help         lipgloss.Style // Help is the style of the key help below the form.
+	Error        lipgloss.Style // Error is the style of the errors shown below the fields.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Title:        lipgloss.NewStyle().Foreground(t.Label).Bold(true).Underline(true),
+		Page:         lipgloss.NewStyle().Faint(true),
+		Group:        lipgloss.NewStyle().Foreground(t.Accent).Bold(true),
+		FieldTitle:   lipgloss.NewStyle().Foreground(t.Label),
+		FocusedTitle: lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Description:  lipgloss.NewStyle().Faint(true),
+		Bar:          lipgloss.NewStyle().Foreground(t.Accent),
+		Help:         lipgloss.NewStyle().Faint(true),
+		Error:        t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+	m.errview = *m.errview.WithStyles(errorview.ThemedStyles(t))
+}
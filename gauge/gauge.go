@@ -0,0 +1,240 @@
+package gauge
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Level is the band a gauge value falls into.
+type Level int
+
+const (
+	Normal   Level = iota // Normal values are below the warning threshold.
+	Warning               // Warning values are at or above the warning threshold.
+	Critical              // Critical values are at or above the critical threshold.
+)
+
+// String returns the name of the level.
+func (l Level) String() string {
+	switch l {
+	case Normal:
+		return "normal"
+	case Warning:
+		return "warning"
+	case Critical:
+		return "critical"
+	default:
+		return fmt.Sprintf("Level(%d)", int(l))
+	}
+}
+
+// SetMsg sets the value of the gauge with the given ID. Send it using tea.Program.Send, or return it from a command
+// using Set.
+type SetMsg struct {
+	ID    string  // ID identifies the gauge.
+	Value float64 // Value is the new value.
+	Max   float64 // Max is the new maximum value; 0 keeps the current maximum.
+}
+
+// Set returns a command setting the value of the gauge with the given ID.
+func Set(id string, value float64) tea.Cmd {
+	return func() tea.Msg { return SetMsg{ID: id, Value: value} }
+}
+
+// Model represents a gauge showing a value between zero and a maximum as a bar and a percentage, e.g. the
+// utilization of a resource or the usage of a quota. The bar is colored by the band the value falls into: normal,
+// warning or critical. It is not interactive; its value is set by the embedding model or updated by messages.
+type Model struct {
+	id           string               // id identifies the gauge for updates.
+	label        string               // label is shown before the bar.
+	value        float64              // value is the current value.
+	max          float64              // max is the value of a full gauge.
+	warning      float64              // warning is the fraction of max starting the warning band.
+	critical     float64              // critical is the fraction of max starting the critical band.
+	inverted     bool                 // inverted determines if low values are critical, e.g. for remaining capacity.
+	width        int                  // width is the width of the bar.
+	format       func(float64) string // format formats the value and the maximum, nil to show only the percentage.
+	styles       Styles               // styles holds the styles of the model.
+	customStyles bool                 // customStyles is set if styles were set explicitly, which a theme switch keeps
+}
+
+// New creates and returns a new Model with the given label, configured by the given options. By default, the
+// maximum is 100, and the warning and critical bands start at 70% and 90%.
+func New(label string, opts ...Option) *Model {
+	m := &Model{
+		label:    label,
+		max:      100,
+		warning:  0.7,
+		critical: 0.9,
+		width:    30,
+		styles:   DefaultStyles(),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithID sets the ID identifying the gauge for updates and returns a new Model with the updated ID.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithLabel sets the label and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithValue sets the value and returns a new Model with the updated value.
+func (m *Model) WithValue(value float64) *Model {
+	return m.With(Value(value))
+}
+
+// WithMax sets the value of a full gauge and returns a new Model with the updated maximum.
+func (m *Model) WithMax(max float64) *Model {
+	return m.With(Max(max))
+}
+
+// WithThresholds sets the fractions of the maximum starting the warning and critical bands and returns a new Model
+// with the updated thresholds.
+func (m *Model) WithThresholds(warning, critical float64) *Model {
+	return m.With(Thresholds(warning, critical))
+}
+
+// WithInverted sets whether low values are critical and returns a new Model with the updated flag.
+func (m *Model) WithInverted(inverted bool) *Model {
+	return m.With(Inverted(inverted))
+}
+
+// WithWidth sets the width of the bar and returns a new Model with the updated width.
+func (m *Model) WithWidth(width int) *Model {
+	return m.With(Width(width))
+}
+
+// WithFormat sets the function formatting the value and the maximum and returns a new Model with the updated
+// function.
+func (m *Model) WithFormat(fn func(float64) string) *Model {
+	return m.With(Format(fn))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Value returns the current value.
+func (m *Model) Value() float64 {
+	return m.value
+}
+
+// SetValue sets the current value.
+func (m *Model) SetValue(value float64) {
+	m.value = value
+}
+
+// Max returns the value of a full gauge.
+func (m *Model) Max() float64 {
+	return m.max
+}
+
+// SetMax sets the value of a full gauge. Values of zero or less are ignored.
+func (m *Model) SetMax(max float64) {
+	if max > 0 {
+		m.max = max
+	}
+}
+
+// Percent returns the value as a fraction of the maximum, between 0 and 1.
+func (m *Model) Percent() float64 {
+	if m.max <= 0 || math.IsNaN(m.value) {
+		return 0
+	}
+	return math.Max(0, math.Min(1, m.value/m.max))
+}
+
+// Level returns the band the value falls into. For inverted gauges, the thresholds apply to the remaining fraction.
+func (m *Model) Level() Level {
+	p := m.Percent()
+	if m.inverted {
+		p = 1 - p
+	}
+	switch {
+	case p >= m.critical:
+		return Critical
+	case p >= m.warning:
+		return Warning
+	default:
+		return Normal
+	}
+}
+
+// Init initializes the Model.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles the messages setting the value of the gauge.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	if msg, ok := msg.(SetMsg); ok && msg.ID == m.id {
+		m.SetValue(msg.Value)
+		m.SetMax(msg.Max)
+	}
+	return m, nil
+}
+
+// style returns the style of the band the value falls into.
+func (m *Model) style() lipgloss.Style {
+	switch m.Level() {
+	case Critical:
+		return m.styles.Critical
+	case Warning:
+		return m.styles.Warning
+	default:
+		return m.styles.Normal
+	}
+}
+
+// View renders the label, the bar, the percentage and, if a format is set, the value and the maximum.
+func (m *Model) View() string {
+	g := ui.Glyphs()
+	var b strings.Builder
+	if m.label != "" {
+		fmt.Fprintf(&b, "%s ", m.styles.Label.Render(m.label))
+	}
+	p := m.Percent()
+	filled := int(math.Round(p * float64(m.width)))
+	style := m.style()
+	b.WriteString(style.Render(strings.Repeat(g.Block, filled)))
+	b.WriteString(m.styles.Track.Render(strings.Repeat(g.SliderTrack, m.width-filled)))
+	fmt.Fprintf(&b, " %s", style.Render(fmt.Sprintf("%3.0f%%", p*100)))
+	if m.format != nil {
+		fmt.Fprintf(&b, " %s", m.styles.Value.Render(fmt.Sprintf("(%s/%s)", m.format(m.value), m.format(m.max))))
+	}
+	return b.String()
+}
+
+// Showcase demonstrates the Model component with values in all bands and an inverted gauge.
+func Showcase() {
+	fmt.Println("=== Gauge Showcase ===")
+	fmt.Println()
+
+	gb := func(v float64) string { return fmt.Sprintf("%.0f GB", v) }
+	fmt.Println(New("CPU    ").WithValue(42).View())
+	fmt.Println(New("Memory ").WithValue(12.6).WithMax(16).WithFormat(gb).View())
+	fmt.Println(New("Disk   ").WithValue(470).WithMax(500).WithFormat(gb).View())
+	fmt.Println(New("Battery").WithValue(15).WithInverted(true).WithThresholds(0.7, 0.85).View())
+}
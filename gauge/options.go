@@ -0,0 +1,81 @@
+package gauge
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// ID sets the ID identifying the gauge for updates by SetMsg. Gauges without ID receive the messages without ID.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Label sets the label shown before the bar.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Value sets the current value.
+func Value(value float64) Option {
+	return func(m *Model) {
+		m.value = value
+	}
+}
+
+// Max sets the value of a full gauge. The default is 100, so that values are percentages.
+func Max(max float64) Option {
+	return func(m *Model) {
+		m.SetMax(max)
+	}
+}
+
+// Thresholds sets the fractions of the maximum starting the warning and critical bands, e.g. 0.7 and 0.9 (the
+// default). A threshold above 1 disables its band.
+func Thresholds(warning, critical float64) Option {
+	return func(m *Model) {
+		m.warning, m.critical = warning, critical
+	}
+}
+
+// Inverted sets whether low values are critical, e.g. for the remaining capacity of a battery or a quota. The
+// thresholds then apply to the missing fraction: with the default thresholds, values below 30% are a warning and
+// values below 10% are critical.
+func Inverted(inverted bool) Option {
+	return func(m *Model) {
+		m.inverted = inverted
+	}
+}
+
+// Width sets the width of the bar. The default is 30.
+func Width(width int) Option {
+	return func(m *Model) {
+		m.width = max(1, width)
+	}
+}
+
+// Format sets the function formatting the value and the maximum, which are then shown after the percentage.
+func Format(fn func(float64) string) Option {
+	return func(m *Model) {
+		m.format = fn
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
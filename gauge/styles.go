@@ -0,0 +1,41 @@
+package gauge
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model. The style of the band the value falls into is applied to the bar and the
+// percentage.
+type Styles struct {
+	Label    lipgloss.Style // Label is the style of the label.
+	Normal   lipgloss.Style // Normal is the style of values below the warning threshold.
+	Warning  lipgloss.Style // Warning is the style of values in the warning band.
+	Critical lipgloss.Style // Critical is the style of values in the critical band.
+	Track    lipgloss.Style // Track is the style of the empty part of the bar.
+	Value    lipgloss.Style // Value is the style of the value and the maximum.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:    lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Normal:   lipgloss.NewStyle().Foreground(t.Success),
+		Warning:  lipgloss.NewStyle().Foreground(t.Label),
+		Critical: lipgloss.NewStyle().Foreground(t.Failure),
+		Track:    lipgloss.NewStyle().Faint(true),
+		Value:    lipgloss.NewStyle().Faint(true),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
@@ -0,0 +1,206 @@
+package ui
+
+import (
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/muesli/termenv"         // Detects terminal capabilities
+)
+
+// GlyphSet holds the decorative characters used by the components.
+type GlyphSet struct {
+	SelectedLeft  string          // SelectedLeft is shown left of the selected item.
+	SelectedRight string          // SelectedRight is shown right of the selected item.
+	Bullet        string          // Bullet is used for unordered items.
+	Success       string          // Success marks a successful operation.
+	Failure       string          // Failure marks a failed operation.
+	Warning       string          // Warning marks a warning.
+	Skipped       string          // Skipped marks a skipped operation.
+	Ellipsis      string          // Ellipsis marks truncated text.
+	Ascending     string          // Ascending marks a column sorted in ascending order.
+	Descending    string          // Descending marks a column sorted in descending order.
+	Expanded      string          // Expanded marks an expanded node of a tree.
+	Collapsed     string          // Collapsed marks a collapsed node of a tree.
+	TreeBranch    string          // TreeBranch connects a node of a tree that has following siblings.
+	TreeLast      string          // TreeLast connects the last child node of a tree.
+	TreeLine      string          // TreeLine continues the guide of an ancestor that has following siblings.
+	SliderTrack   string          // SliderTrack is the unfilled part of a slider.
+	SliderFill    string          // SliderFill is the filled part of a slider, up to the handle.
+	SliderHandle  string          // SliderHandle marks the value of a slider.
+	Tick          string          // Tick marks a labeled position below a slider.
+	ToggleLeft    string          // ToggleLeft is shown left of the state of a toggle.
+	ToggleRight   string          // ToggleRight is shown right of the state of a toggle.
+	SwitchOn      string          // SwitchOn is a switch in the on position.
+	SwitchOff     string          // SwitchOff is a switch in the off position.
+	Checked       string          // Checked is a checked checkbox.
+	Unchecked     string          // Unchecked is an unchecked checkbox.
+	FocusBar      string          // FocusBar marks the focused field of a form.
+	Separator     string          // Separator is repeated to draw a separator line, e.g. in a menu.
+	Submenu       string          // Submenu marks a menu item opening a submenu.
+	Block         string          // Block is a filled cell, e.g. a pixel of a large banner title.
+	Levels        string          // Levels holds cells filled to increasing heights, the last one completely.
+	Border        lipgloss.Border // Border is used for boxes.
+}
+
+var (
+	// UnicodeGlyphs is the glyph set used on terminals supporting Unicode.
+	UnicodeGlyphs = GlyphSet{
+		SelectedLeft:  "►",
+		SelectedRight: "◄",
+		Bullet:        "•",
+		Success:       "✓",
+		Failure:       "✗",
+		Warning:       "⚠",
+		Skipped:       "-",
+		Ellipsis:      "…",
+		Ascending:     "▲",
+		Descending:    "▼",
+		Expanded:      "▾",
+		Collapsed:     "▸",
+		TreeBranch:    "├── ",
+		TreeLast:      "└── ",
+		TreeLine:      "│   ",
+		SliderTrack:   "─",
+		SliderFill:    "━",
+		SliderHandle:  "●",
+		Tick:          "╵",
+		ToggleLeft:    "‹",
+		ToggleRight:   "›",
+		SwitchOn:      "━●",
+		SwitchOff:     "○─",
+		Checked:       "[✓]",
+		Unchecked:     "[ ]",
+		FocusBar:      "┃",
+		Separator:     "─",
+		Submenu:       "›",
+		Block:         "█",
+		Levels:        "▁▂▃▄▅▆▇█",
+		Border:        lipgloss.RoundedBorder(),
+	}
+
+	// ASCIIGlyphs is the glyph set used on limited terminals.
+	ASCIIGlyphs = GlyphSet{
+		SelectedLeft:  ">",
+		SelectedRight: "<",
+		Bullet:        "*",
+		Success:       "+",
+		Failure:       "x",
+		Warning:       "!",
+		Skipped:       "-",
+		Ellipsis:      "...",
+		Ascending:     "^",
+		Descending:    "v",
+		Expanded:      "-",
+		Collapsed:     "+",
+		TreeBranch:    "|-- ",
+		TreeLast:      "`-- ",
+		TreeLine:      "|   ",
+		SliderTrack:   "-",
+		SliderFill:    "=",
+		SliderHandle:  "O",
+		Tick:          "'",
+		ToggleLeft:    "<",
+		ToggleRight:   ">",
+		SwitchOn:      "=O",
+		SwitchOff:     "o-",
+		Checked:       "[x]",
+		Unchecked:     "[ ]",
+		FocusBar:      "|",
+		Separator:     "-",
+		Submenu:       ">",
+		Block:         "#",
+		Levels:        "._-=+*#",
+		Border: lipgloss.Border{
+			Top: "-", Bottom: "-", Left: "|", Right: "|",
+			TopLeft: "+", TopRight: "+", BottomLeft: "+", BottomRight: "+",
+			MiddleLeft: "+", MiddleRight: "+", Middle: "+", MiddleTop: "+", MiddleBottom: "+",
+		},
+	}
+)
+
+// Capabilities describes what the terminal supports.
+type Capabilities struct {
+	Unicode bool            // Unicode indicates whether the terminal can display Unicode characters.
+	Colors  termenv.Profile // Colors is the color profile of the terminal.
+	Legacy  bool            // Legacy indicates whether the terminal is a legacy Windows console.
+}
+
+var (
+	capabilities     Capabilities
+	capabilitiesOnce sync.Once
+)
+
+// DetectCapabilities returns the capabilities of the terminal, as detected from the locale, TERM, the color
+// profile of the output and the environment. The result is cached.
+func DetectCapabilities() Capabilities {
+	capabilitiesOnce.Do(func() {
+		capabilities = Capabilities{
+			Unicode: detectUnicode(),
+			Colors:  lipgloss.ColorProfile(),
+			Legacy:  detectLegacyConsole(),
+		}
+	})
+	return capabilities
+}
+
+// detectUnicode reports whether the terminal is expected to display Unicode characters.
+func detectUnicode() bool {
+	if os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if locale := strings.ToLower(os.Getenv(name)); locale != "" {
+			return strings.Contains(locale, "utf-8") || strings.Contains(locale, "utf8")
+		}
+	}
+	if runtime.GOOS == "windows" {
+		// Windows Terminal supports Unicode, the legacy console host does not reliably.
+		return os.Getenv("WT_SESSION") != ""
+	}
+	return true
+}
+
+const (
+	glyphsAuto int32 = iota
+	glyphsASCII
+	glyphsUnicode
+)
+
+var glyphMode atomic.Int32
+
+// unicodeGlyphs returns the glyphs of the current theme if it has any, UnicodeGlyphs otherwise.
+func unicodeGlyphs() GlyphSet {
+	if g := CurrentTheme().Glyphs; g != nil {
+		return *g
+	}
+	return UnicodeGlyphs
+}
+
+// ForceASCII makes all components use ASCIIGlyphs, regardless of the detected capabilities.
+func ForceASCII() {
+	glyphMode.Store(glyphsASCII)
+}
+
+// ForceUnicode makes all components use UnicodeGlyphs, regardless of the detected capabilities.
+func ForceUnicode() {
+	glyphMode.Store(glyphsUnicode)
+}
+
+// Glyphs returns the glyph set to use: ASCIIGlyphs if forced, if the terminal does not support Unicode or in the
+// compatibility mode for legacy consoles, UnicodeGlyphs or the glyphs of the theme otherwise.
+func Glyphs() GlyphSet {
+	switch glyphMode.Load() {
+	case glyphsASCII:
+		return ASCIIGlyphs
+	case glyphsUnicode:
+		return unicodeGlyphs()
+	}
+	if DetectCapabilities().Unicode && !LegacyConsole() {
+		return unicodeGlyphs()
+	}
+	return ASCIIGlyphs
+}
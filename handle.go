@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// HandleOptions configures Handle. Exit codes left 0 are those of the default exit policy, see DefaultExitPolicy.
+type HandleOptions struct {
+	Output      io.Writer // Output receives the messages, os.Stderr if nil.
+	Quiet       bool      // Quiet suppresses the messages printed for canceled, quit and timed out prompts.
+	QuitCode    int       // QuitCode is the exit code used on QuitError, 130 as for SIGINT by default.
+	TimeoutCode int       // TimeoutCode is the exit code used if the deadline of the context expired, 124 by default.
+	ErrorCode   int       // ErrorCode is the exit code used on other errors. If 0, the error is returned instead.
+}
+
+// exit terminates the program, replaceable for testing.
+var exit = os.Exit
+
+// Handle implements the error handling most applications need after running a prompt: on CanceledError, it prints
+// "Canceled" and returns CanceledError, so that the caller can skip the answer; on QuitError (including
+// TerminatedError) and TimeoutError, it prints "Quit" or "Timed out" and exits with the code of the exit policy used
+// by Main, unless configured otherwise; other errors are printed and, unless an exit code is configured for them,
+// returned. If err is nil, nil is returned.
+func Handle(err error, opts HandleOptions) error {
+	out := opts.Output
+	if out == nil {
+		out = os.Stderr
+	}
+	p := DefaultExitPolicy()
+	p.Quiet = opts.Quiet
+	if opts.QuitCode != 0 {
+		p.Quit = opts.QuitCode
+	}
+	if opts.TimeoutCode != 0 {
+		p.Timeout = opts.TimeoutCode
+	}
+	p.Error = opts.ErrorCode
+	if msg := p.message(err); msg != "" {
+		fmt.Fprintln(out, msg)
+	}
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, QuitError), errors.Is(err, context.DeadlineExceeded):
+		exit(p.Code(err))
+	case errors.Is(err, CanceledError):
+		return CanceledError
+	case p.Error != 0:
+		exit(p.Error)
+	}
+	return err
+}
@@ -0,0 +1,18 @@
+package ui
+
+import "sync/atomic"
+
+// helpHidden is inverted, so that the zero value shows help.
+var helpHidden atomic.Bool
+
+// ShowHelp sets whether components created afterwards show their help footer. Hiding the help is useful for
+// applications that document the key bindings elsewhere or need the vertical space. Components can override the
+// setting using their WithShowHelp method.
+func ShowHelp(show bool) {
+	helpHidden.Store(!show)
+}
+
+// HelpShown returns whether components show their help footer by default.
+func HelpShown() bool {
+	return !helpHidden.Load()
+}
@@ -0,0 +1,357 @@
+package helpscreen
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"      // Provides help view for key bindings
+	"github.com/charmbracelet/bubbles/key"       // Manages key bindings
+	"github.com/charmbracelet/bubbles/textinput" // Provides text input model
+	tea "github.com/charmbracelet/bubbletea"     // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"          // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/pick"
+	"github.com/nmeilick/go-ui/styles"
+)
+
+var (
+	upKey       = key.NewBinding(key.WithKeys("up", "ctrl+p"), key.WithHelp("↑", "scroll up"))
+	downKey     = key.NewBinding(key.WithKeys("down", "ctrl+n"), key.WithHelp("↓", "scroll down"))
+	pageUpKey   = key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "page up"))
+	pageDownKey = key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdown", "page down"))
+)
+
+// defaultHeight is the height of the help screen until the window size is known.
+const defaultHeight = 24
+
+// section is a category of key bindings.
+type section struct {
+	title    string        // title is shown above the bindings.
+	bindings []key.Binding // bindings are the key bindings of the category.
+}
+
+// Model represents a full-screen overview of key bindings shown on top of another model, the content. The bindings
+// are grouped in sections, e.g. one for each key map of a composed application, and can be searched by typing. The
+// toggle key ("?" by default) shows and hides the overview; while it is hidden, all messages are passed on to the
+// content.
+type Model struct {
+	content        tea.Model           // content is the model the help is shown for, nil for none.
+	title          string              // title is shown at the top of the help screen.
+	sections       []section           // sections holds the categories of key bindings.
+	toggleKey      key.Binding         // toggleKey shows and hides the help screen.
+	visible        bool                // visible determines if the help screen is shown instead of the content.
+	queryInput     textinput.Model     // queryInput reads the search query.
+	offset         int                 // offset is the index of the first line shown.
+	width          int                 // width is the window width, updated from window size messages.
+	height         int                 // height is the window height, updated from window size messages.
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+}
+
+// New creates and returns a new Model showing the help for the given content, configured by the given options. The
+// content may be nil, e.g. if an embedding model passes key messages to the help screen itself while it is visible.
+func New(content tea.Model, opts ...Option) *Model {
+	ti := textinput.New()
+	ti.KeyMap = ui.DefaultTextKeyMap()
+	ti.Prompt = ""
+	ti.Placeholder = "type to search"
+
+	m := &Model{
+		content:    content,
+		title:      "Help",
+		toggleKey:  key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "toggle help")),
+		queryInput: ti,
+		keymap:     ui.DefaultKeyMap(),
+		styles:     DefaultStyles(),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithTitle sets the title shown at the top of the help screen and returns a new Model with the updated title.
+func (m *Model) WithTitle(title string) *Model {
+	return m.With(Title(title))
+}
+
+// WithSection adds a section listing the full help of the given key map and returns a new Model with the updated
+// sections.
+func (m *Model) WithSection(title string, km help.KeyMap) *Model {
+	return m.With(Section(title, km))
+}
+
+// WithBindings adds a section listing the given key bindings and returns a new Model with the updated sections.
+func (m *Model) WithBindings(title string, bindings ...key.Binding) *Model {
+	return m.With(Bindings(title, bindings...))
+}
+
+// WithToggleKey sets the key binding showing and hiding the help screen and returns a new Model with the updated
+// binding.
+func (m *Model) WithToggleKey(binding key.Binding) *Model {
+	return m.With(ToggleKey(binding))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings. The cancel binding closes the help screen.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Content returns the model the help is shown for.
+func (m *Model) Content() tea.Model {
+	return m.content
+}
+
+// Visible returns whether the help screen is shown.
+func (m *Model) Visible() bool {
+	return m.visible
+}
+
+// Show shows the help screen with an empty search query and returns the command starting the cursor blink.
+func (m *Model) Show() tea.Cmd {
+	m.visible, m.offset = true, 0
+	m.queryInput.Reset()
+	return m.queryInput.Focus()
+}
+
+// Hide hides the help screen.
+func (m *Model) Hide() {
+	m.visible = false
+	m.queryInput.Blur()
+}
+
+// Toggle shows the help screen if it is hidden and hides it otherwise.
+func (m *Model) Toggle() tea.Cmd {
+	if m.visible {
+		m.Hide()
+		return nil
+	}
+	return m.Show()
+}
+
+// Canceled returns whether the content was canceled, if it implements ui.StandardModel.
+func (m *Model) Canceled() bool {
+	if sm, ok := m.content.(ui.StandardModel); ok {
+		return sm.Canceled()
+	}
+	return false
+}
+
+// Quit returns whether the content was quit, if it implements ui.StandardModel.
+func (m *Model) Quit() bool {
+	if sm, ok := m.content.(ui.StandardModel); ok {
+		return sm.Quit()
+	}
+	return false
+}
+
+// Init initializes the content.
+func (m *Model) Init() tea.Cmd {
+	if m.content == nil {
+		return nil
+	}
+	return m.content.Init()
+}
+
+// Update handles the toggle key and, while the help screen is shown, the keys scrolling and searching it. All other
+// messages are passed on to the content, so that it keeps running in the background.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+	}
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+	case tea.KeyMsg:
+		if key.Matches(msg, m.toggleKey) {
+			return m, m.Toggle()
+		}
+		if !m.visible {
+			break
+		}
+		page := max(1, m.pageHeight()-1)
+		switch {
+		case key.Matches(msg, m.keymap.Cancel):
+			m.Hide()
+		case key.Matches(msg, upKey):
+			m.scroll(-1)
+		case key.Matches(msg, downKey):
+			m.scroll(1)
+		case key.Matches(msg, pageUpKey):
+			m.scroll(-page)
+		case key.Matches(msg, pageDownKey):
+			m.scroll(page)
+		case key.Matches(msg, m.keymap.Quit):
+			// Quitting is left to the content.
+			m.Hide()
+			return m.updateContent(msg)
+		default:
+			query := m.queryInput.Value()
+			var cmd tea.Cmd
+			m.queryInput, cmd = m.queryInput.Update(msg)
+			if m.queryInput.Value() != query {
+				m.offset = 0
+			}
+			return m, cmd
+		}
+		return m, nil
+	default:
+		if m.visible {
+			var cmd tea.Cmd
+			m.queryInput, cmd = m.queryInput.Update(msg)
+			m2, cmd2 := m.updateContent(msg)
+			return m2, tea.Batch(cmd, cmd2)
+		}
+	}
+	return m.updateContent(msg)
+}
+
+// updateContent passes the given message on to the content.
+func (m *Model) updateContent(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.content == nil {
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.content, cmd = m.content.Update(msg)
+	return m, cmd
+}
+
+// scroll moves the shown lines by the given number of lines.
+func (m *Model) scroll(n int) {
+	m.offset = max(0, min(m.offset+n, len(m.lines())-m.pageHeight()))
+}
+
+// pageHeight returns the number of lines of bindings shown at once.
+func (m *Model) pageHeight() int {
+	height := m.height
+	if height <= 0 {
+		height = defaultHeight
+	}
+	return max(1, height-4) // title, search, blank line and hint
+}
+
+// matches reports whether the binding matches the search query, which is compared with its help keys, its
+// description and its actual keys.
+func matches(b key.Binding, query string) bool {
+	if query == "" {
+		return true
+	}
+	h := b.Help()
+	text := strings.ToLower(h.Key + " " + h.Desc + " " + strings.Join(b.Keys(), " "))
+	return strings.Contains(text, query)
+}
+
+// lines renders the sections whose bindings match the search query, one line per binding and a heading per section.
+func (m *Model) lines() []string {
+	query := strings.ToLower(strings.TrimSpace(m.queryInput.Value()))
+
+	keyWidth := 0
+	for _, s := range m.sections {
+		for _, b := range s.bindings {
+			keyWidth = max(keyWidth, styles.Width(b.Help().Key))
+		}
+	}
+
+	var lines []string
+	for _, s := range m.sections {
+		var rows []string
+		for _, b := range s.bindings {
+			if !b.Enabled() || !matches(b, query) {
+				continue
+			}
+			h := b.Help()
+			rows = append(rows, "  "+m.styles.Key.Render(styles.Pad(h.Key, keyWidth, lipgloss.Left))+"  "+
+				m.styles.Desc.Render(h.Desc))
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, m.styles.Section.Render(s.title))
+		lines = append(lines, rows...)
+	}
+	return lines
+}
+
+// View renders the help screen if it is shown, and the content otherwise.
+func (m *Model) View() string {
+	if !m.visible {
+		if m.content == nil {
+			return ""
+		}
+		return m.content.View()
+	}
+
+	var b strings.Builder
+	b.WriteString(m.styles.Title.Render(m.title) + "\n")
+	b.WriteString(m.styles.Prompt.Render("/ ") + m.queryInput.View() + "\n")
+
+	lines := m.lines()
+	page := m.pageHeight()
+	offset := min(m.offset, max(0, len(lines)-page))
+	shown := lines[offset:min(len(lines), offset+page)]
+	if len(lines) == 0 {
+		shown = []string{m.styles.Hint.Render("no matching keys")}
+	}
+	for _, line := range shown {
+		if m.width > 0 {
+			line = styles.Truncate(line, m.width)
+		}
+		b.WriteString(line + "\n")
+	}
+	if m.height > 0 {
+		b.WriteString(strings.Repeat("\n", max(0, page-len(shown))))
+	}
+
+	hint := "type to search · ↑/↓ scroll · esc close"
+	if len(lines) > page {
+		hint = fmt.Sprintf("%d-%d of %d · %s", offset+1, offset+len(shown), len(lines), hint)
+	}
+	b.WriteString("\n" + m.styles.Hint.Render(hint))
+	return b.String()
+}
+
+// Run runs the model until the content quits the program. Cancellation of the content is reported as an error like
+// for the content itself.
+func (m *Model) Run(ctx context.Context) error {
+	return ui.RunContext(ctx, m, m.programOptions...)
+}
+
+// Showcase demonstrates the Model component with the help for a pick list and additional application keys.
+func Showcase() {
+	fmt.Println("=== Help Screen Showcase ===")
+
+	list := pick.New([]string{"production", "staging", "development"}).WithLabel("Environment (press ? for help)")
+	m := New(list).
+		WithSection("Navigation", ui.DefaultKeyMap()).
+		WithBindings("Application",
+			key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "reload environments")),
+			key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "show deployments")),
+			key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "toggle this help")),
+		)
+	if err := ui.Handle(m.Run(context.Background()), ui.HandleOptions{}); err == nil {
+		fmt.Printf("Chosen: %s\n", list.SelectedItem())
+	}
+}
@@ -0,0 +1,95 @@
+package helpscreen
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help" // Provides help view for key bindings
+	"github.com/charmbracelet/bubbles/key"  // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	newModel.sections = append([]section(nil), m.sections...)
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Title sets the title shown at the top of the help screen. The default is "Help".
+func Title(title string) Option {
+	return func(m *Model) {
+		m.title = title
+	}
+}
+
+// Section adds a section listing the full help of the given key map, e.g. a ui.KeyMap or the key map of a bubbles
+// component. Bindings with the same keys as a binding listed before in the section are omitted.
+func Section(title string, km help.KeyMap) Option {
+	return func(m *Model) {
+		var bindings []key.Binding
+		for _, group := range km.FullHelp() {
+			bindings = append(bindings, group...)
+		}
+		m.sections = append(m.sections, section{title: title, bindings: dedupe(bindings)})
+	}
+}
+
+// Bindings adds a section listing the given key bindings.
+func Bindings(title string, bindings ...key.Binding) Option {
+	return func(m *Model) {
+		m.sections = append(m.sections, section{title: title, bindings: dedupe(bindings)})
+	}
+}
+
+// dedupe returns the bindings without those having the same keys as a binding before them.
+func dedupe(bindings []key.Binding) []key.Binding {
+	seen := make(map[string]bool, len(bindings))
+	var out []key.Binding
+	for _, b := range bindings {
+		keys := strings.Join(b.Keys(), "\x00")
+		if seen[keys] {
+			continue
+		}
+		seen[keys] = true
+		out = append(out, b)
+	}
+	return out
+}
+
+// ToggleKey sets the key binding showing and hiding the help screen. The default is "?". Choose a different key if
+// the content reads text, where "?" would be typed.
+func ToggleKey(binding key.Binding) Option {
+	return func(m *Model) {
+		m.toggleKey = binding
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
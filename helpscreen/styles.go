@@ -0,0 +1,40 @@
+package helpscreen
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Title   lipgloss.Style // Title is the style of the title.
+	Prompt  lipgloss.Style // Prompt is the style of the prompt in front of the search query.
+	Section lipgloss.Style // Section is the style of the section headings.
+	Key     lipgloss.Style // Key is the style of the keys of a binding.
+	Desc    lipgloss.Style // Desc is the style of the description of a binding.
+	Hint    lipgloss.Style // Hint is the style of the hint on the available keys and of the empty result.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Title:   lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Prompt:  lipgloss.NewStyle().Foreground(t.Accent),
+		Section: lipgloss.NewStyle().Foreground(t.Accent).Bold(true),
+		Key:     lipgloss.NewStyle().Foreground(t.Text).Bold(true),
+		Desc:    lipgloss.NewStyle().Faint(true),
+		Hint:    lipgloss.NewStyle().Faint(true),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
@@ -0,0 +1,227 @@
+// Package history stores the previous answers of prompts in a file, so they can be recalled in later sessions. The
+// entries are namespaced, usually by the ID of the prompt, and kept newest first:
+//
+//	h, err := history.Default("mytool")
+//	if err != nil {
+//		return err
+//	}
+//	name, err := input.New("Name: ", "").WithID("name").With(input.History(h)).Run(ctx)
+//
+// Components add the answers themselves: input recalls them with the up and down keys, and finder lists recently
+// chosen candidates first. The file is read and written on each access, so concurrent sessions of a program share
+// their history.
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// DefaultMaxEntries is the number of entries kept per namespace unless set by MaxEntries.
+const DefaultMaxEntries = 100
+
+// Store is a history kept in a JSON file mapping namespaces to their entries, newest first. It is safe for
+// concurrent use.
+type Store struct {
+	mu     sync.Mutex // mu serializes changes of the file.
+	path   string     // path is the path of the file.
+	max    int        // max is the number of entries kept per namespace, unlimited if not positive.
+	dedupe bool       // dedupe determines if an entry added again replaces its older copies.
+}
+
+// Option configures a Store.
+type Option func(*Store)
+
+// MaxEntries sets the number of entries kept per namespace, dropping the oldest ones. Zero keeps all entries.
+func MaxEntries(n int) Option {
+	return func(s *Store) {
+		s.max = n
+	}
+}
+
+// Dedupe sets whether an entry that is added again replaces its older copies, so it moves to the top instead of
+// appearing several times. It is enabled by default.
+func Dedupe(dedupe bool) Option {
+	return func(s *Store) {
+		s.dedupe = dedupe
+	}
+}
+
+// New returns a store keeping its entries in the file at the given path, which is created when the first entry is
+// added.
+func New(path string, opts ...Option) *Store {
+	s := &Store{path: path, max: DefaultMaxEntries, dedupe: true}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Default returns a store keeping its entries in the history file of the given application in the user's state
+// directory: $XDG_STATE_HOME/<app>/history.json, ~/.local/state/<app>/history.json on Linux, and the config
+// directory elsewhere.
+func Default(app string, opts ...Option) (*Store, error) {
+	path, err := StateFile(app, "history.json")
+	if err != nil {
+		return nil, err
+	}
+	return New(path, opts...), nil
+}
+
+// StateFile returns the path of the file with the given name in the state directory of the application, like the
+// history file used by Default.
+func StateFile(app, name string) (string, error) {
+	dir, err := stateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, app, name), nil
+}
+
+// stateDir returns the directory for state that should persist between runs.
+func stateDir() (string, error) {
+	if dir := os.Getenv("XDG_STATE_HOME"); dir != "" {
+		return dir, nil
+	}
+	if runtime.GOOS != "windows" && runtime.GOOS != "darwin" {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, ".local", "state"), nil
+		}
+	}
+	return os.UserConfigDir()
+}
+
+// Path returns the path of the file.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Entries returns the entries of the namespace, newest first.
+func (s *Store) Entries(namespace string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	return data[namespace], nil
+}
+
+// Add adds the entries to the namespace, the last one becoming the newest. Empty entries are ignored.
+func (s *Store) Add(namespace string, entries ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := s.load()
+	if err != nil {
+		return err
+	}
+	list := data[namespace]
+	changed := false
+	for _, e := range entries {
+		if strings.TrimSpace(e) == "" {
+			continue
+		}
+		if s.dedupe {
+			list = remove(list, e)
+		}
+		list = append([]string{e}, list...)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	if s.max > 0 && len(list) > s.max {
+		list = list[:s.max]
+	}
+	data[namespace] = list
+	return s.save(data)
+}
+
+// Remove removes all copies of the entry from the namespace.
+func (s *Store) Remove(namespace, entry string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := s.load()
+	if err != nil {
+		return err
+	}
+	data[namespace] = remove(data[namespace], entry)
+	return s.save(data)
+}
+
+// Clear removes all entries of the namespace.
+func (s *Store) Clear(namespace string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := s.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := data[namespace]; !ok {
+		return nil
+	}
+	delete(data, namespace)
+	return s.save(data)
+}
+
+// load reads the file. A missing file is an empty history.
+func (s *Store) load() (map[string][]string, error) {
+	data := make(map[string][]string)
+	b, err := os.ReadFile(s.path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return data, nil
+	case err != nil:
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, &os.PathError{Op: "parse", Path: s.path, Err: err}
+	}
+	return data, nil
+}
+
+// save writes the file, replacing it atomically. It is only readable by the user, as answers may be sensitive.
+func (s *Store) save(data map[string][]string) error {
+	for ns, list := range data {
+		if len(list) == 0 {
+			delete(data, ns)
+		}
+	}
+	b, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(s.path), ".history-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.path)
+}
+
+// remove returns the list without the copies of the entry.
+func remove(list []string, entry string) []string {
+	out := list[:0:0]
+	for _, e := range list {
+		if e != entry {
+			out = append(out, e)
+		}
+	}
+	return out
+}
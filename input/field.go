@@ -0,0 +1,200 @@
+package input
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"       // Manages key bindings
+	"github.com/charmbracelet/bubbles/textinput" // Provides text input model
+	tea "github.com/charmbracelet/bubbletea"     // Framework for building terminal applications
+	"github.com/nmeilick/go-ui/styles"
+)
+
+// cluster is a grapheme cluster of the value, the unit the cursor moves over and the field is scrolled by.
+type cluster struct {
+	text  string // text is the text of the cluster.
+	start int    // start is the position of the first rune of the cluster in the value.
+	runes int    // runes is the number of runes of the cluster.
+	width int    // width is the display width of the cluster.
+}
+
+// clusters splits the value into grapheme clusters.
+func clusters(value string) []cluster {
+	var cs []cluster
+	pos := 0
+	styles.Graphemes(value, func(_ int, text string, width int) bool {
+		n := len([]rune(text))
+		cs = append(cs, cluster{text: text, start: pos, runes: n, width: width})
+		pos += n
+		return true
+	})
+	return cs
+}
+
+// clusterAt returns the index of the cluster containing the rune at pos, or len(cs) if pos is at the end.
+func clusterAt(cs []cluster, pos int) int {
+	for i, c := range cs {
+		if pos < c.start+c.runes {
+			return i
+		}
+	}
+	return len(cs)
+}
+
+// updateText passes msg to the text input. The text input moves the cursor and deletes by runes, so keys moving over or
+// deleting a character are repeated for each rune of the grapheme cluster they act on; this keeps emoji sequences and
+// characters with combining marks intact. Afterwards, the cursor is moved to the end of the cluster it is in, if any.
+func (m *Model) updateText(msg tea.Msg) tea.Cmd {
+	n := 1
+	if k, ok := msg.(tea.KeyMsg); ok {
+		km := m.textInput.KeyMap
+		cs := clusters(m.textInput.Value())
+		i := clusterAt(cs, m.textInput.Position())
+		switch {
+		case key.Matches(k, km.AcceptSuggestion, km.DeleteWordBackward, km.WordBackward, km.WordForward):
+		case key.Matches(k, km.DeleteCharacterBackward, km.CharacterBackward):
+			if i > 0 {
+				n = cs[i-1].runes
+			}
+		case key.Matches(k, km.DeleteCharacterForward, km.CharacterForward):
+			if i < len(cs) {
+				n = cs[i].runes
+			}
+		}
+	}
+	cmds := make([]tea.Cmd, n)
+	for i := range cmds {
+		m.textInput, cmds[i] = m.textInput.Update(msg)
+	}
+	m.snapCursor()
+	return tea.Batch(cmds...)
+}
+
+// snapCursor moves the cursor to the end of the grapheme cluster it is in, so that it never splits a cluster.
+func (m *Model) snapCursor() {
+	pos := m.textInput.Position()
+	cs := clusters(m.textInput.Value())
+	if i := clusterAt(cs, pos); i < len(cs) && cs[i].start != pos {
+		m.textInput.SetCursor(cs[i].start + cs[i].runes)
+	}
+}
+
+// window returns the range of clusters shown in the field of the given width, starting at the offset if possible and
+// including the cluster at the cursor, or the cursor at the end. A width of zero or less shows all clusters.
+func window(cs []cluster, cursor, offset, width int) (start, end int) {
+	if width <= 0 {
+		return 0, len(cs)
+	}
+	cursorWidth := 0
+	if cursor < len(cs) {
+		cursorWidth = max(1, cs[cursor].width)
+	}
+	start = min(offset, cursor)
+	used := cursorWidth
+	for i := start; i < cursor; i++ {
+		used += cs[i].width
+	}
+	for start < cursor && used > width {
+		used -= cs[start].width
+		start++
+	}
+	end = min(cursor+1, len(cs))
+	for end < len(cs) && used+cs[end].width <= width {
+		used += cs[end].width
+		end++
+	}
+	return start, end
+}
+
+// scroll remembers the first position shown in the field, so that the field only scrolls when the cursor leaves it.
+func (m *Model) scroll() {
+	cs := clusters(m.textInput.Value())
+	start, _ := window(cs, clusterAt(cs, m.textInput.Position()), clusterAt(cs, m.offset), m.textInput.Width)
+	m.offset = 0
+	if start < len(cs) {
+		m.offset = cs[start].start
+	}
+}
+
+// echo transforms text according to the echo mode of the text input.
+func (m *Model) echo(text string) string {
+	switch m.textInput.EchoMode {
+	case textinput.EchoPassword:
+		return strings.Repeat(string(m.textInput.EchoCharacter), styles.Width(text))
+	case textinput.EchoNone:
+		return ""
+	}
+	return text
+}
+
+// suggestion returns the rest of the selected suggestion completing the value, or an empty string if there is none.
+func (m *Model) suggestion() (rest string) {
+	if !m.textInput.ShowSuggestions || len(m.textInput.AvailableSuggestions()) == 0 {
+		return ""
+	}
+	// The text input panics if no suggestion matches the value and offers no way to check for it.
+	defer func() {
+		if recover() != nil {
+			rest = ""
+		}
+	}()
+	value, s := []rune(m.textInput.Value()), []rune(m.textInput.CurrentSuggestion())
+	if len(value) >= len(s) {
+		return ""
+	}
+	return string(s[len(value):])
+}
+
+// fieldView renders the prompt and the text field. In contrast to the text input, it measures the value by grapheme
+// clusters and their display width, so that the cursor stays on the character it is on and the field scrolls by whole
+// characters, also with wide characters and emoji. The field takes the width of the text input plus a column for the
+// cursor at the end.
+func (m *Model) fieldView() string {
+	ti := m.textInput
+	if ti.Value() == "" && ti.Placeholder != "" {
+		return ti.View()
+	}
+	text := ti.TextStyle.Inline(true).Render
+	cs := clusters(ti.Value())
+	cursor := clusterAt(cs, ti.Position())
+	start, end := window(cs, cursor, clusterAt(cs, m.offset), ti.Width)
+
+	var b strings.Builder
+	join := func(cs []cluster) string {
+		var s strings.Builder
+		for _, c := range cs {
+			s.WriteString(c.text)
+		}
+		return m.echo(s.String())
+	}
+	b.WriteString(text(join(cs[start:min(cursor, end)])))
+	cur := ti.Cursor
+	var rest string
+	if end == len(cs) {
+		rest = m.echo(m.suggestion())
+	}
+	switch {
+	case cursor < len(cs):
+		cur.SetChar(m.echo(cs[cursor].text))
+		b.WriteString(cur.View())
+		b.WriteString(text(join(cs[cursor+1 : end])))
+	case rest != "":
+		first := clusters(rest)[0].text
+		cur.TextStyle = ti.CompletionStyle
+		cur.SetChar(first)
+		b.WriteString(cur.View())
+		rest = rest[len(first):]
+	default:
+		cur.SetChar(" ")
+		b.WriteString(cur.View())
+	}
+	if ti.Width > 0 {
+		rest = styles.Cut(rest, ti.Width+1-styles.Width(b.String()))
+	}
+	b.WriteString(ti.PlaceholderStyle.Inline(true).Render(rest))
+	if ti.Width > 0 {
+		if gap := ti.Width + 1 - styles.Width(b.String()); gap > 0 {
+			b.WriteString(text(strings.Repeat(" ", gap)))
+		}
+	}
+	return ti.PromptStyle.Render(ti.Prompt) + b.String()
+}
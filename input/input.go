@@ -1,9 +1,11 @@
 package input
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"os"
+	"io"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/help"      // Provides help view for key bindings
 	"github.com/charmbracelet/bubbles/key"       // Manages key bindings
@@ -11,31 +13,82 @@ import (
 	tea "github.com/charmbracelet/bubbletea"     // Framework for building terminal applications
 	"github.com/charmbracelet/lipgloss"          // Styles terminal UI components
 	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/clipboard"
+	"github.com/nmeilick/go-ui/errorview"
+	"github.com/nmeilick/go-ui/history"
+	"github.com/nmeilick/go-ui/internal/answer"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/styles"
+)
+
+var _ ui.Prompt[string] = (*Model)(nil)
+
+// EchoMode determines how the entered text is shown, see WithEchoMode.
+type EchoMode = textinput.EchoMode
+
+const (
+	EchoNormal   = textinput.EchoNormal   // EchoNormal shows the text as it is entered.
+	EchoPassword = textinput.EchoPassword // EchoPassword shows an asterisk for each entered character.
+	EchoNone     = textinput.EchoNone     // EchoNone shows nothing at all.
+)
+
+var (
+	historyPrevKey = key.NewBinding(key.WithKeys("up"), key.WithHelp("↑/↓", "history"))
+	historyNextKey = key.NewBinding(key.WithKeys("down"))
 )
 
 // Model is the model handling user input.
 type Model struct {
-	textInput  textinput.Model // textInput is the text input model.
-	help       help.Model      // help is the help model for displaying key bindings.
-	keymap     keymap          // keymap is for managing key bindings.
-	abort      bool            // abort indicates if the input operation was aborted.
-	cancelable bool            // cancelable determines if selection can be canceled with escape key
-	quitable   bool            // quitable determines if execution can be quit via ctrl+c
+	textInput      textinput.Model      // textInput is the text input model.
+	help           help.Model           // help is the help model for displaying key bindings.
+	styles         Styles               // styles holds the styles of the model.
+	customStyles   bool                 // customStyles is set if styles were set explicitly, which a theme switch keeps
+	keymap         keymap               // keymap is for managing key bindings.
+	err            error                // err is shown below the model, e.g. why the previous answer was rejected
+	errview        errorview.Model      // errview renders the error.
+	validator      ui.Validator[string] // validator validates the value before it is accepted
+	abort          bool                 // abort indicates if the input operation was aborted.
+	cancelable     bool                 // cancelable determines if selection can be canceled with escape key
+	quitable       bool                 // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption  // programOptions are passed to the program running the model
+	id             string               // id identifies the prompt, e.g. for preset answers
+	embedded       bool                 // embedded determines if a DoneMsg is emitted instead of quitting the program
+	showHelp       bool                 // showHelp determines if the help footer is shown
+	prompt         string               // prompt is the prompt, which may span multiple lines
+	header         string               // header is the part of the prompt shown above the text field
+	width          int                  // width is the width of the text field unless the terminal is too narrow
+	termWidth      int                  // termWidth is the width of the terminal, 0 if unknown
+	offset         int                  // offset is the position of the first rune shown in the text field
+	history        *history.Store       // history stores the entered values, recalled with the up and down keys
+	historyEntries []string             // historyEntries holds the entries being recalled, newest first
+	historyPos     int                  // historyPos is the position of the recalled entry, -1 for the new value
+	historyDraft   string               // historyDraft is the value entered before recalling entries
 
 	canceled bool // canceled indicates whether the selection was canceled
 	quit     bool // quit indicates whether the selection was quit
 }
 
-type keymap struct{}
+// keymap holds the key bindings of the model.
+type keymap struct {
+	ui.KeyMap
+	history     bool // history determines if the keys recalling the history are shown.
+	suggestions bool // suggestions determines if the keys completing the value are shown.
+}
 
 // ShortHelp returns a list of key bindings for short help.
 func (k keymap) ShortHelp() []key.Binding {
-	return []key.Binding{
-		key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "complete")),
-		key.NewBinding(key.WithKeys("ctrl+n"), key.WithHelp("ctrl+n", "next")),
-		key.NewBinding(key.WithKeys("ctrl+p"), key.WithHelp("ctrl+p", "prev")),
-		key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "quit")),
+	var bindings []key.Binding
+	if k.suggestions {
+		bindings = append(bindings,
+			key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "complete")),
+			key.NewBinding(key.WithKeys("ctrl+n"), key.WithHelp("ctrl+n", "next")),
+			key.NewBinding(key.WithKeys("ctrl+p"), key.WithHelp("ctrl+p", "prev")),
+		)
+	}
+	if k.history {
+		bindings = append(bindings, historyPrevKey)
 	}
+	return append(bindings, k.Cancel)
 }
 
 // FullHelp returns a list of key bindings for full help.
@@ -46,103 +99,211 @@ func (k keymap) FullHelp() [][]key.Binding {
 // New creates and returns a new Model with default settings.
 func New(prompt, value string, suggestions ...string) *Model {
 	ti := textinput.New()
-	ti.Prompt = prompt
+	ti.KeyMap = ui.DefaultTextKeyMap()
 	ti.SetValue(value)
 	if len(suggestions) > 0 {
 		ti.SetSuggestions(suggestions)
 	}
 	ti.Placeholder = ""
-	ti.PromptStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("63"))
-	ti.Cursor.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("63"))
 	ti.Focus()
 	ti.CharLimit = 100
 	ti.Width = 40
 	ti.ShowSuggestions = true
 	h := help.New()
-	km := keymap{}
+	km := keymap{KeyMap: ui.DefaultKeyMap(), suggestions: true}
 
-	return &Model{
+	m := &Model{
 		textInput:  ti,
 		help:       h,
 		keymap:     km,
+		errview:    *errorview.New(nil),
 		cancelable: true,
 		quitable:   true,
+		showHelp:   ui.HelpShown(),
+		prompt:     prompt,
+		width:      ti.Width,
+		historyPos: -1,
 
 		canceled: false,
 		quit:     false,
 	}
+	m.setStyles(DefaultStyles())
+	m.layout()
+	return m
 }
 
 // WithPrompt sets the prompt for the text input model and returns a new Model with the updated prompt.
 func (m *Model) WithPrompt(s string) *Model {
-	newModel := *m
-	newModel.textInput.Prompt = s
-	return &newModel
+	return m.With(Prompt(s))
 }
 
 // WithPlaceholder sets the placeholder for the text input model and returns a new Model with the updated placeholder.
 func (m *Model) WithPlaceholder(s string) *Model {
-	newModel := *m
-	newModel.textInput.Placeholder = s
-	return &newModel
+	return m.With(Placeholder(s))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
 }
 
 // WithPromptStyle sets the style of the prompt for the text input model and returns a new Model with the updated prompt style.
 func (m *Model) WithPromptStyle(style lipgloss.Style) *Model {
-	newModel := *m
-	newModel.textInput.PromptStyle = style
-	return &newModel
+	return m.With(PromptStyle(style))
+}
+
+// WithEchoMode sets how the entered text is shown and returns a new Model with the updated mode. In the modes other
+// than EchoNormal, the value is a secret: suggestions and the history are disabled, it cannot be copied, and it is
+// masked in echoed answers and recordings.
+func (m *Model) WithEchoMode(mode EchoMode) *Model {
+	return m.With(Echo(mode))
 }
 
 // WithCursorStyle sets the style of the cursor for the text input model and returns a new Model with the updated cursor style.
 func (m *Model) WithCursorStyle(style lipgloss.Style) *Model {
-	newModel := *m
-	newModel.textInput.Cursor.Style = style
-	return &newModel
+	return m.With(CursorStyle(style))
 }
 
 // WithCharLimit sets the maximum allowed number of input characters and returns a new Model with the updated
 // character limit.
 func (m *Model) WithCharLimit(n int) *Model {
-	newModel := *m
-	newModel.textInput.CharLimit = n
-	return &newModel
+	return m.With(CharLimit(n))
 }
 
 // WithWidth sets the width of the text input model and returns a new Model with the updated width.
 func (m *Model) WithWidth(n int) *Model {
-	newModel := *m
-	newModel.textInput.Width = n
-	return &newModel
+	return m.With(Width(n))
 }
 
 // WithSuggestion sets the autocomplete suggestions for the text input model and returns a new Model with the
 // updated suggestions.
 func (m *Model) WithSuggestion(suggestions []string) *Model {
-	newModel := *m
-	newModel.textInput.SetSuggestions(suggestions)
-	return &newModel
+	return m.With(Suggestion(suggestions))
 }
 
 // WithCancel sets the cancelable flag and returns a new Model with the updated flag.
 func (m *Model) WithCancel(cancelable bool) *Model {
-	newModel := *m
-	newModel.cancelable = cancelable
-	return &newModel
+	return m.With(Cancel(cancelable))
 }
 
 // WithQuit sets the quitable flag and returns a new Model with the updated flag.
 func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
 	newModel := *m
-	newModel.quitable = quitable
+	newModel.embedded = embedded
 	return &newModel
 }
 
+// WithShowHelp sets whether the help footer is shown, overriding the package-wide setting of ui.ShowHelp, and
+// returns a new Model with the updated flag.
+func (m *Model) WithShowHelp(show bool) *Model {
+	return m.With(ShowHelp(show))
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithValidator sets the validator the value must pass before it is accepted and returns a new Model with the updated
+// validator.
+func (m *Model) WithValidator(v ui.Validator[string]) *Model {
+	return m.With(Validator(v))
+}
+
+// WithHistory sets the history the entered values are added to and recalled from with the up and down keys, and
+// returns a new Model with the updated history.
+func (m *Model) WithHistory(h *history.Store) *Model {
+	return m.With(History(h))
+}
+
 // Value returns the current input.
 func (m *Model) Value() string {
 	return m.textInput.Value()
 }
 
+// Secret reports whether the value is a secret, i.e. whether it is masked. It implements ui.SecretModel.
+func (m *Model) Secret() bool {
+	return m.textInput.EchoMode != EchoNormal
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.prompt
+}
+
+// SetAnswer applies a preset answer, which is the value. It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	s := answer.String(v)
+	if err := m.validate(s); err != nil {
+		return err
+	}
+	m.textInput.SetValue(s)
+	m.textInput.CursorEnd()
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the entered value. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	return m.Value()
+}
+
+// Focus focuses the model, so that it handles key messages, and returns the command starting the cursor blink.
+func (m *Model) Focus() tea.Cmd {
+	return m.textInput.Focus()
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.textInput.Blur()
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.textInput.Focused()
+}
+
+// validate validates the value, if a validator is set.
+func (m *Model) validate(v string) error {
+	if m.validator == nil {
+		return nil
+	}
+	return m.validator.Validate(v)
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
 // Canceled returns the canceled flag.
 func (m *Model) Canceled() bool {
 	return m.canceled
@@ -153,42 +314,251 @@ func (m *Model) Quit() bool {
 	return m.quit
 }
 
+// Run runs the model and returns the entered value. It implements ui.Prompt[string].
+func (m *Model) Run(ctx context.Context) (string, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return "", err
+	}
+	return m.Value(), nil
+}
+
+// RunAccessible asks for a line of input instead of using the terminal UI. An empty answer keeps the current value,
+// which is not shown for secrets. As the line is echoed by the terminal, a note is shown for secrets. It implements
+// ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	def := m.textInput.Value()
+	if m.Secret() {
+		p.Println("(input is visible)")
+		def = ""
+	}
+	var s string
+	for {
+		var err error
+		s, err = p.Line(m.prompt, def)
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+		if s == "" {
+			s = m.textInput.Value()
+		}
+		if err := m.validate(s); err != nil {
+			ui.RecordValidationFailure(m.Key(), err)
+			p.Println("Error:", err)
+			continue
+		}
+		break
+	}
+	m.textInput.SetValue(s)
+	m.canceled, m.quit = false, false
+	m.remember()
+	return nil
+}
+
+// recall replaces the value by the entry of the history that is delta entries older than the current one. The value
+// entered before recalling is restored when moving past the newest entry.
+func (m *Model) recall(delta int) {
+	if m.historyPos < 0 {
+		entries, err := m.history.Entries(m.Key())
+		if err != nil {
+			m.err = err
+			return
+		}
+		m.historyEntries, m.historyDraft = entries, m.Value()
+	}
+	pos := m.historyPos + delta
+	if pos < -1 || pos >= len(m.historyEntries) {
+		return
+	}
+	m.historyPos = pos
+	v := m.historyDraft
+	if pos >= 0 {
+		v = m.historyEntries[pos]
+	}
+	m.textInput.SetValue(v)
+	m.textInput.CursorEnd()
+}
+
+// historyEnabled returns whether the history is used, which is not the case for secrets.
+func (m *Model) historyEnabled() bool {
+	return m.history != nil && !m.Secret()
+}
+
+// remember adds the accepted value to the history, if set. Failing to write the history does not fail the prompt.
+func (m *Model) remember() {
+	m.historyPos = -1
+	if m.historyEnabled() {
+		_ = m.history.Add(m.Key(), m.Value())
+	}
+}
+
 // Init initializes the Model, resets the abort flag, and returns a nil command.
 func (m *Model) Init() tea.Cmd {
 	m.abort = false
 	return nil
 }
 
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
 // Update handles user input and updates the input state by processing key messages and updating the text input model
-// accordingly.
+// accordingly. Window size messages narrow the text field if it does not fit into the terminal.
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	defer m.scroll()
 	switch msg := msg.(type) {
+	case ui.ThemeMsg:
+		m.restyle(msg.Theme)
+		return m, nil
+	case tea.WindowSizeMsg:
+		m.termWidth = msg.Width
+		m.help.Width = msg.Width
+		m.errview.Update(msg)
+		m.layout()
+		return m, nil
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "enter":
+		if !m.Focused() || m.errview.Toggle(msg, m.err) {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, m.keymap.Confirm):
+			if m.err = m.validate(m.Value()); m.err != nil {
+				ui.RecordValidationFailure(m.Key(), m.err)
+				return m, nil
+			}
 			m.canceled, m.quit = false, false
-			return m, tea.Quit
-		case "esc":
-			m.canceled, m.quit = true, false
-			return m, tea.Quit
-		case "ctrl+c":
-			m.canceled, m.quit = true, true
-			return m, tea.Quit
+			m.remember()
+			return m, m.done()
+		case key.Matches(msg, m.keymap.Copy):
+			if m.Secret() {
+				return m, nil
+			}
+			return m, clipboard.Copy(m.Value())
+		case key.Matches(msg, m.keymap.Paste):
+			return m, clipboard.Paste()
+		case m.historyEnabled() && key.Matches(msg, historyPrevKey):
+			m.recall(1)
+			return m, nil
+		case m.historyEnabled() && key.Matches(msg, historyNextKey):
+			m.recall(-1)
+			return m, nil
+		case key.Matches(msg, m.keymap.Cancel):
+			if m.cancelable {
+				m.canceled, m.quit = true, false
+				return m, m.done()
+			}
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, m.done()
+			}
+		}
+	case clipboard.CopiedMsg:
+		if m.Focused() && msg.Err != nil {
+			m.err = msg.Err
 		}
+		return m, nil
+	case clipboard.PastedMsg:
+		if !m.Focused() {
+			return m, nil
+		}
+		if msg.Err != nil {
+			m.err = msg.Err
+		} else {
+			m.insert(msg.Text)
+		}
+		return m, nil
 	}
 
-	var cmd tea.Cmd
-	m.textInput, cmd = m.textInput.Update(msg)
-	return m, cmd
+	return m, m.updateText(msg)
+}
+
+// layout splits the prompt into the header shown above the text field and the last line shown in front of it, and fits
+// the text field into the terminal, keeping a column for the cursor. If the last line takes more than half of the
+// terminal, it is moved to the header, so that the text field keeps enough room.
+func (m *Model) layout() {
+	m.header, m.textInput.Prompt = "", m.prompt
+	if i := strings.LastIndex(m.prompt, "\n"); i >= 0 {
+		m.header, m.textInput.Prompt = m.prompt[:i], m.prompt[i+1:]
+	}
+	if m.termWidth <= 0 {
+		m.textInput.Width = m.width
+		return
+	}
+	if styles.Width(m.textInput.Prompt) > m.termWidth/2 {
+		m.header = strings.TrimPrefix(m.header+"\n"+strings.TrimRight(m.textInput.Prompt, " "), "\n")
+		m.textInput.Prompt = ""
+	}
+	avail := m.termWidth - styles.Width(m.textInput.Prompt) - 1
+	if m.width > 0 {
+		avail = min(m.width, avail)
+	}
+	m.textInput.Width = max(1, avail)
+}
+
+// insert inserts the text at the cursor. Line breaks are replaced by spaces, as the input has a single line.
+func (m *Model) insert(text string) {
+	text = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(strings.TrimRight(text, "\r\n"))
+	v, pos := []rune(m.textInput.Value()), m.textInput.Position()
+	m.textInput.SetValue(string(v[:pos]) + text + string(v[pos:]))
+	m.textInput.SetCursor(pos + len([]rune(text)))
+	m.snapCursor()
 }
 
 // View renders the input widget as a string, displaying the prompt, text input, and help view for key bindings.
 func (m *Model) View() string {
-	return fmt.Sprintf(
-		"%s\n%s",
-		m.textInput.View(),
-		m.help.View(m.keymap),
-	)
+	view := m.fieldView()
+	if m.header != "" {
+		view = m.styles.Prompt.Render(styles.Wrap(m.header, m.termWidth)) + "\n" + view
+	}
+	if m.err != nil {
+		view += "\n" + m.errview.RenderWith(m.styles.Error, m.err)
+	}
+	if m.showHelp {
+		view += "\n" + m.help.View(m.keymap)
+	}
+	return view
+}
+
+// Ask asks for input using the given prompt and initial value and returns the entered value or an error. The options
+// are passed to the program running the model.
+// Use errors.Is(ui.CanceledError) or errors.Is(ui.QuitError) to determine if the input was canceled or aborting of
+// the program was requested.
+func Ask(prompt, value string, opts ...tea.ProgramOption) (string, error) {
+	return AskContext(context.Background(), prompt, value, opts...)
+}
+
+// AskContext is like Ask, but aborts the input when the context is done. ui.TimeoutError is returned if its deadline
+// expired.
+func AskContext(ctx context.Context, prompt, value string, opts ...tea.ProgramOption) (string, error) {
+	return New(prompt, value).WithProgramOptions(opts...).Run(ctx)
+}
+
+// Secret asks for a secret, e.g. a token or a password, using the given prompt and returns the entered value or an
+// error. The typed characters are masked, and the value is neither suggested, remembered nor added to a history. The
+// options are passed to the program running the model.
+func Secret(prompt string, opts ...tea.ProgramOption) (string, error) {
+	return SecretContext(context.Background(), prompt, opts...)
+}
+
+// SecretContext is like Secret, but aborts the input when the context is done. ui.TimeoutError is returned if its
+// deadline expired.
+func SecretContext(ctx context.Context, prompt string, opts ...tea.ProgramOption) (string, error) {
+	return New(prompt, "").WithEchoMode(EchoPassword).WithProgramOptions(opts...).Run(ctx)
 }
 
 // Showcase demonstrates all features of the Model component by creating an input model with autocomplete
@@ -201,16 +571,7 @@ func Showcase() {
 	fmt.Println("=== Model Showcase ===")
 
 	fmt.Println("\nDefault Style Input (Type to see suggestions, Enter to select):")
-	err := ui.Run(m)
-	switch {
-	case errors.Is(err, ui.QuitError):
-		fmt.Println("Quit")
-		os.Exit(0)
-	case errors.Is(err, ui.CanceledError):
-		fmt.Println("Canceled")
-	case err != nil:
-		fmt.Printf("Error running program: %v", err)
-	default:
+	if err := ui.Handle(ui.Run(m), ui.HandleOptions{}); err == nil {
 		fmt.Printf("Final input: %s\n", m.textInput.Value())
 	}
 }
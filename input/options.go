@@ -0,0 +1,173 @@
+package input
+
+import (
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/history"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Prompt sets the prompt for the text input model. All but the last line of a multi-line prompt are shown above the
+// text field.
+func Prompt(s string) Option {
+	return func(m *Model) {
+		m.prompt = s
+		m.layout()
+	}
+}
+
+// Placeholder sets the placeholder for the text input model.
+func Placeholder(s string) Option {
+	return func(m *Model) {
+		m.textInput.Placeholder = s
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.setStyles(styles)
+	}
+}
+
+// PromptStyle sets the style of the prompt for the text input model.
+func PromptStyle(style lipgloss.Style) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		styles := m.styles
+		styles.Prompt = style
+		m.setStyles(styles)
+	}
+}
+
+// CursorStyle sets the style of the cursor for the text input model.
+func CursorStyle(style lipgloss.Style) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		styles := m.styles
+		styles.Cursor = style
+		m.setStyles(styles)
+	}
+}
+
+// Echo sets how the entered text is shown. In the modes other than EchoNormal, the value is a secret: suggestions and
+// the history are disabled, it cannot be copied, and it is masked in echoed answers and recordings.
+func Echo(mode EchoMode) Option {
+	return func(m *Model) {
+		m.textInput.EchoMode = mode
+		m.textInput.ShowSuggestions = mode == EchoNormal
+		m.keymap.history, m.keymap.suggestions = m.historyEnabled(), !m.Secret()
+	}
+}
+
+// CharLimit sets the maximum allowed number of input characters.
+func CharLimit(n int) Option {
+	return func(m *Model) {
+		m.textInput.CharLimit = n
+	}
+}
+
+// Width sets the width of the text input model. It is reduced if the terminal is too narrow.
+func Width(n int) Option {
+	return func(m *Model) {
+		m.width = n
+		m.layout()
+	}
+}
+
+// Suggestion sets the autocomplete suggestions for the text input model.
+func Suggestion(suggestions []string) Option {
+	return func(m *Model) {
+		m.textInput.SetSuggestions(suggestions)
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// ShowHelp sets whether the help footer is shown, overriding the package-wide setting of ui.ShowHelp.
+func ShowHelp(show bool) Option {
+	return func(m *Model) {
+		m.showHelp = show
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = keymap{KeyMap: km, history: m.historyEnabled(), suggestions: !m.Secret()}
+		m.errview = *m.errview.WithKeyMap(km)
+	}
+}
+
+// TextKeyMap sets the editing keys of the text input, overriding the default text key map.
+func TextKeyMap(km textinput.KeyMap) Option {
+	return func(m *Model) {
+		m.textInput.KeyMap = km
+	}
+}
+
+// History sets the history the entered values are added to and recalled from with the up and down keys, which then
+// no longer cycle through the suggestions (ctrl+n and ctrl+p still do). Entries are namespaced by the key of the
+// prompt, see WithID.
+func History(h *history.Store) Option {
+	return func(m *Model) {
+		m.history = h
+		m.keymap.history = m.historyEnabled()
+	}
+}
+
+// Validator sets the validator the value must pass before it is accepted.
+func Validator(v ui.Validator[string]) Option {
+	return func(m *Model) {
+		m.validator = v
+	}
+}
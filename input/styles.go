@@ -0,0 +1,57 @@
+package input
+
+import (
+	"github.com/charmbracelet/bubbles/help" // Provides help view for key bindings
+	"github.com/charmbracelet/lipgloss"     // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/errorview"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Prompt      lipgloss.Style // Prompt is the style of the prompt.
+	Text        lipgloss.Style // Text is the style of the entered text.
+	Placeholder lipgloss.Style // Placeholder is the style of the placeholder.
+	Completion  lipgloss.Style // Completion is the style of the suggested completion.
+	Cursor      lipgloss.Style // Cursor is the style of the cursor.
+	Help        help.Styles    // Help holds the styles of the help view.
+	Error       lipgloss.Style // Error is the style of the error shown below the input.
+}
+
+// DefaultStyles returns the default styles, which use the current theme of the ui package.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the styles of the model in the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	s := t.PromptStyles()
+	return Styles{
+		Prompt:      s.Prompt,
+		Text:        lipgloss.NewStyle(),
+		Placeholder: lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+		Completion:  lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+		Cursor:      s.Cursor,
+		Help:        s.Help,
+		Error:       t.ErrorStyle(),
+	}
+}
+
+// setStyles sets the styles of the model and applies them to the text input and help models.
+func (m *Model) setStyles(styles Styles) {
+	m.styles = styles
+	m.textInput.PromptStyle = styles.Prompt
+	m.textInput.TextStyle = styles.Text
+	m.textInput.PlaceholderStyle = styles.Placeholder
+	m.textInput.CompletionStyle = styles.Completion
+	m.textInput.Cursor.Style = styles.Cursor
+	m.help.Styles = styles.Help
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.setStyles(ThemedStyles(t))
+	}
+	m.errview = *m.errview.WithStyles(errorview.ThemedStyles(t))
+}
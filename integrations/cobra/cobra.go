@@ -0,0 +1,235 @@
+// Package cobra asks interactively for the required flags of a cobra command that were not given on the command
+// line, using the go-ui component matching the type of each flag:
+//
+//	cmd.PreRunE = uicobra.PreRunE()
+//	uicobra.AddNoInputFlag(rootCmd)
+//
+// Bools are asked with a confirm prompt, flags with a fixed set of values with a pick and secrets with a password
+// prompt; all other flags are entered as text and checked against the type of the flag.
+package cobra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/checkboxgroup"
+	"github.com/nmeilick/go-ui/confirm"
+	"github.com/nmeilick/go-ui/input"
+	"github.com/nmeilick/go-ui/password"
+	"github.com/nmeilick/go-ui/pick"
+	"github.com/spf13/cobra" // Command-line framework
+	"github.com/spf13/pflag" // Command-line flags used by cobra
+)
+
+// NoInputFlag is the name of the flag added by AddNoInputFlag.
+const NoInputFlag = "no-input"
+
+// secretAnnotation marks flags whose values must not be echoed.
+const secretAnnotation = "go-ui_secret"
+
+// Option configures PromptMissing.
+type Option func(*options)
+
+// options holds the settings of PromptMissing.
+type options struct {
+	enums          map[string][]string // enums holds the values of flags with a fixed set of values.
+	secrets        map[string]bool     // secrets holds the names of flags whose values must not be echoed.
+	noInput        bool                // noInput disables prompting.
+	programOptions []tea.ProgramOption // programOptions are passed to the programs running the prompts.
+}
+
+// Enum sets the values the flag with the given name accepts, so that one of them is picked. By default, the values
+// returned by the completion function of the flag are used, if it has one.
+func Enum(flag string, values ...string) Option {
+	return func(o *options) {
+		o.enums[flag] = values
+	}
+}
+
+// Secret marks the flags with the given names as secrets, which are asked for without echoing them. See also
+// MarkFlagSecret.
+func Secret(flags ...string) Option {
+	return func(o *options) {
+		for _, f := range flags {
+			o.secrets[f] = true
+		}
+	}
+}
+
+// NoInput disables prompting if set to true, like the flag added by AddNoInputFlag.
+func NoInput(noInput bool) Option {
+	return func(o *options) {
+		o.noInput = noInput
+	}
+}
+
+// ProgramOptions passes the given options to the programs running the prompts.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(o *options) {
+		o.programOptions = append(o.programOptions, opts...)
+	}
+}
+
+// MarkFlagSecret marks the flag with the given name of the command as a secret, which is asked for without echoing
+// it.
+func MarkFlagSecret(cmd *cobra.Command, name string) error {
+	return cmd.Flags().SetAnnotation(name, secretAnnotation, []string{"true"})
+}
+
+// AddNoInputFlag adds the persistent flag --no-input to the command, which disables prompting, e.g. in scripts.
+// Missing required flags are then reported by cobra as usual.
+func AddNoInputFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().Bool(NoInputFlag, false, "do not prompt for missing flags")
+}
+
+// PreRunE returns a function for the PreRunE or PersistentPreRunE field of a command calling PromptMissing. Cobra
+// validates required flags after running it.
+func PreRunE(opts ...Option) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		return PromptMissing(cmd, opts...)
+	}
+}
+
+// PromptMissing asks for the required flags of the command that were not given on the command line and sets them.
+// Nothing is asked if --no-input is set. If the user cancels a prompt, ui.CanceledError or ui.QuitError is
+// returned.
+func PromptMissing(cmd *cobra.Command, opts ...Option) error {
+	o := &options{enums: make(map[string][]string), secrets: make(map[string]bool)}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if noInput, err := cmd.Flags().GetBool(NoInputFlag); (err == nil && noInput) || o.noInput {
+		return nil
+	}
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var missing []*pflag.Flag
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if _, required := f.Annotations[cobra.BashCompOneRequiredFlag]; required && !f.Changed {
+			missing = append(missing, f)
+		}
+	})
+	for _, f := range missing {
+		value, err := o.ask(ctx, cmd, f)
+		if err != nil {
+			return err
+		}
+		if err := cmd.Flags().Set(f.Name, value); err != nil {
+			return fmt.Errorf("--%s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// ask asks for the value of the flag and returns it in the form accepted by the flag.
+func (o *options) ask(ctx context.Context, cmd *cobra.Command, f *pflag.Flag) (string, error) {
+	label := f.Name
+	if f.Usage != "" {
+		label = fmt.Sprintf("%s (--%s)", f.Usage, f.Name)
+	}
+	typ := f.Value.Type()
+	values := o.values(cmd, f)
+	_, secret := f.Annotations[secretAnnotation]
+	secret = secret || o.secrets[f.Name]
+
+	switch {
+	case typ == "bool":
+		def, _ := strconv.ParseBool(f.DefValue)
+		v, err := confirm.New(label, confirm.Default(def), confirm.ProgramOptions(o.programOptions...)).Run(ctx)
+		return strconv.FormatBool(v), err
+	case len(values) > 0 && isList(typ):
+		items := checkboxgroup.Strings(values...)
+		m := checkboxgroup.New(label, items, checkboxgroup.Min(1), checkboxgroup.ProgramOptions(o.programOptions...))
+		if _, err := m.Run(ctx); err != nil {
+			return "", err
+		}
+		return strings.Join(checkboxgroup.Values[string](m), ","), nil
+	case len(values) > 0:
+		m := pick.New(values, pick.Label(label), pick.ProgramOptions(o.programOptions...))
+		_ = m.SetAnswer(f.DefValue)
+		i, err := m.Run(ctx)
+		if err != nil {
+			return "", err
+		}
+		return values[i], nil
+	case secret:
+		pw, err := password.New(label, password.ShowStrength(false), password.MinLength(1),
+			password.ProgramOptions(o.programOptions...)).Run(ctx)
+		return string(pw), err
+	}
+
+	def := f.DefValue
+	if isList(typ) {
+		def = strings.Trim(def, "[]")
+	}
+	m := input.New(label+": ", def).
+		WithProgramOptions(o.programOptions...).
+		WithValidator(ui.ValidatorFunc[string](func(s string) error {
+			return validate(typ, s)
+		}))
+	return m.Run(ctx)
+}
+
+// values returns the values the flag accepts, either set by Enum or returned by its completion function, or nil if
+// they are unknown.
+func (o *options) values(cmd *cobra.Command, f *pflag.Flag) []string {
+	if values, ok := o.enums[f.Name]; ok {
+		return values
+	}
+	complete, ok := cmd.GetFlagCompletionFunc(f.Name)
+	if !ok {
+		return nil
+	}
+	completions, directive := complete(cmd, nil, "")
+	if directive&cobra.ShellCompDirectiveError != 0 {
+		return nil
+	}
+	var values []string
+	for _, c := range completions {
+		// Completions can carry a description separated by a tab.
+		if v, _, _ := strings.Cut(c, "\t"); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// isList reports whether flags of the given type hold a list of values.
+func isList(typ string) bool {
+	return strings.HasSuffix(typ, "Slice") || strings.HasSuffix(typ, "Array")
+}
+
+// validate checks that s can be parsed as a value of the flag type, so that errors are shown while the user can
+// still correct them.
+func validate(typ string, s string) error {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return errors.New("a value is required")
+	}
+	var err error
+	switch typ {
+	case "int", "int8", "int16", "int32", "int64", "count":
+		_, err = strconv.ParseInt(s, 0, 64)
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		_, err = strconv.ParseUint(s, 0, 64)
+	case "float32", "float64":
+		_, err = strconv.ParseFloat(s, 64)
+	case "duration":
+		_, err = time.ParseDuration(s)
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s: %s", typ, s)
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+// Package urfave asks interactively for the required flags of a urfave/cli application that were not given on the
+// command line, like package cobra does for cobra commands:
+//
+//	app := &cli.App{Flags: []cli.Flag{&cli.StringFlag{Name: "env", Required: true}}}
+//	urfave.AddNoInputFlag(app)
+//	urfave.Setup(app, urfave.Enum("env", "staging", "production"))
+//
+// Bools are asked with a confirm prompt, flags with a list of values with a pick (or checkboxes for slices) and
+// secrets with a password prompt; all other flags are entered as text and checked against the type of the flag.
+package urfave
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/checkboxgroup"
+	"github.com/nmeilick/go-ui/confirm"
+	"github.com/nmeilick/go-ui/input"
+	"github.com/nmeilick/go-ui/password"
+	"github.com/nmeilick/go-ui/pick"
+	"github.com/urfave/cli/v2" // Command-line framework
+)
+
+// NoInputFlag is the name of the flag added by AddNoInputFlag.
+const NoInputFlag = "no-input"
+
+// Option configures Setup and PromptFlags.
+type Option func(*options)
+
+// options holds the settings of Setup and PromptFlags.
+type options struct {
+	enums          map[string][]string // enums holds the values of flags with a fixed set of values.
+	secrets        map[string]bool     // secrets holds the names of flags whose values must not be echoed.
+	noInput        bool                // noInput disables prompting.
+	programOptions []tea.ProgramOption // programOptions are passed to the programs running the prompts.
+}
+
+// newOptions returns the settings configured by the given options.
+func newOptions(opts []Option) *options {
+	o := &options{enums: make(map[string][]string), secrets: make(map[string]bool)}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Enum sets the values the flag with the given name accepts, so that one of them is picked, or, for slice flags,
+// any number of them is checked.
+func Enum(flag string, values ...string) Option {
+	return func(o *options) {
+		o.enums[flag] = values
+	}
+}
+
+// Secret marks the flags with the given names as secrets, which are asked for without echoing them.
+func Secret(flags ...string) Option {
+	return func(o *options) {
+		for _, f := range flags {
+			o.secrets[f] = true
+		}
+	}
+}
+
+// NoInput disables prompting if set to true, like the flag added by AddNoInputFlag.
+func NoInput(noInput bool) Option {
+	return func(o *options) {
+		o.noInput = noInput
+	}
+}
+
+// ProgramOptions passes the given options to the programs running the prompts.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(o *options) {
+		o.programOptions = append(o.programOptions, opts...)
+	}
+}
+
+// AddNoInputFlag adds the flag --no-input to the application, which disables prompting, e.g. in scripts. Missing
+// required flags are then reported as usual.
+func AddNoInputFlag(app *cli.App) {
+	app.Flags = append(app.Flags, &cli.BoolFlag{Name: NoInputFlag, Usage: "do not prompt for missing flags"})
+}
+
+// Setup prepares the application and all its commands for prompting. It must be called once the flags and commands
+// are defined and before the application is run.
+//
+// The parser of urfave/cli rejects missing required flags before any hook runs, so Setup marks them as optional and
+// installs Before hooks that ask for the missing ones and then report those still missing, e.g. with --no-input,
+// like the parser would. Existing Before hooks run afterwards.
+func Setup(app *cli.App, opts ...Option) {
+	o := newOptions(opts)
+	app.Before = o.before(app.Flags, app.Before)
+	for _, cmd := range app.Commands {
+		o.setup(cmd)
+	}
+}
+
+// SetupCommand prepares the command and its subcommands for prompting, like Setup.
+func SetupCommand(cmd *cli.Command, opts ...Option) {
+	newOptions(opts).setup(cmd)
+}
+
+// setup prepares the command and its subcommands for prompting.
+func (o *options) setup(cmd *cli.Command) {
+	cmd.Before = o.before(cmd.Flags, cmd.Before)
+	for _, sub := range cmd.Subcommands {
+		o.setup(sub)
+	}
+}
+
+// before returns a Before hook asking for the required flags that were not given and then calling next, if set.
+func (o *options) before(flags []cli.Flag, next cli.BeforeFunc) cli.BeforeFunc {
+	var required []cli.Flag
+	for _, f := range flags {
+		if rf, ok := f.(cli.RequiredFlag); ok && rf.IsRequired() && setRequired(f, false) {
+			required = append(required, f)
+		}
+	}
+	return func(cCtx *cli.Context) error {
+		if err := o.prompt(cCtx, required); err != nil {
+			return err
+		}
+		var missing []string
+		for _, f := range required {
+			if !isSet(cCtx, f) {
+				missing = append(missing, f.Names()[0])
+			}
+		}
+		switch len(missing) {
+		case 0:
+		case 1:
+			return fmt.Errorf("Required flag %q not set", missing[0])
+		default:
+			return fmt.Errorf("Required flags %q not set", strings.Join(missing, ", "))
+		}
+		if next != nil {
+			return next(cCtx)
+		}
+		return nil
+	}
+}
+
+// setRequired sets the Required field of the flag and reports whether the flag has one. All flag types of urfave/cli
+// have it.
+func setRequired(f cli.Flag, required bool) bool {
+	v := reflect.ValueOf(f)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	field := v.Elem().FieldByName("Required")
+	if !field.IsValid() || field.Kind() != reflect.Bool || !field.CanSet() {
+		return false
+	}
+	field.SetBool(required)
+	return true
+}
+
+// isSet reports whether the flag was set on the command line or from the environment.
+func isSet(cCtx *cli.Context, f cli.Flag) bool {
+	for _, name := range f.Names() {
+		if cCtx.IsSet(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// PromptFlags asks for the given flags that were not set and sets them in the context, e.g. in an Action asking for
+// optional flags. Nothing is asked if --no-input is set. If the user cancels a prompt, ui.CanceledError or
+// ui.QuitError is returned.
+func PromptFlags(cCtx *cli.Context, flags []cli.Flag, opts ...Option) error {
+	return newOptions(opts).prompt(cCtx, flags)
+}
+
+// prompt asks for the given flags that were not set and sets them in the context.
+func (o *options) prompt(cCtx *cli.Context, flags []cli.Flag) error {
+	if o.noInput || cCtx.Bool(NoInputFlag) {
+		return nil
+	}
+	ctx := cCtx.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for _, f := range flags {
+		if isSet(cCtx, f) {
+			continue
+		}
+		name := f.Names()[0]
+		value, err := o.ask(ctx, f)
+		if err != nil {
+			return err
+		}
+		if err := cCtx.Set(name, value); err != nil {
+			return fmt.Errorf("--%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// ask asks for the value of the flag and returns it in the form accepted by the flag.
+func (o *options) ask(ctx context.Context, f cli.Flag) (string, error) {
+	name := f.Names()[0]
+	label, def := name, ""
+	if df, ok := f.(cli.DocGenerationFlag); ok {
+		if usage := df.GetUsage(); usage != "" {
+			label = fmt.Sprintf("%s (--%s)", usage, name)
+		}
+		def = df.GetValue()
+	}
+	values := o.enums[name]
+
+	switch f := f.(type) {
+	case *cli.BoolFlag:
+		v, err := confirm.New(label, confirm.Default(f.Value), confirm.ProgramOptions(o.programOptions...)).Run(ctx)
+		return strconv.FormatBool(v), err
+	case *cli.StringSliceFlag:
+		var defaults []string
+		if f.Value != nil {
+			defaults = f.Value.Value()
+		}
+		if len(values) == 0 {
+			return o.text(ctx, label, strings.Join(defaults, ","), nil)
+		}
+		m := checkboxgroup.New(label, checkboxgroup.Strings(values...), checkboxgroup.Min(1),
+			checkboxgroup.ProgramOptions(o.programOptions...))
+		_ = m.SetAnswer(defaults)
+		if _, err := m.Run(ctx); err != nil {
+			return "", err
+		}
+		return strings.Join(checkboxgroup.Values[string](m), ","), nil
+	case *cli.IntFlag, *cli.Int64Flag:
+		return o.text(ctx, label, def, func(s string) error {
+			_, err := strconv.ParseInt(s, 0, 64)
+			return err
+		})
+	case *cli.UintFlag, *cli.Uint64Flag:
+		return o.text(ctx, label, def, func(s string) error {
+			_, err := strconv.ParseUint(s, 0, 64)
+			return err
+		})
+	case *cli.Float64Flag:
+		return o.text(ctx, label, def, func(s string) error {
+			_, err := strconv.ParseFloat(s, 64)
+			return err
+		})
+	case *cli.DurationFlag:
+		return o.text(ctx, label, def, func(s string) error {
+			_, err := time.ParseDuration(s)
+			return err
+		})
+	}
+
+	switch {
+	case len(values) > 0:
+		m := pick.New(values, pick.Label(label), pick.ProgramOptions(o.programOptions...))
+		_ = m.SetAnswer(def)
+		i, err := m.Run(ctx)
+		if err != nil {
+			return "", err
+		}
+		return values[i], nil
+	case o.secrets[name]:
+		pw, err := password.New(label, password.ShowStrength(false), password.MinLength(1),
+			password.ProgramOptions(o.programOptions...)).Run(ctx)
+		return string(pw), err
+	}
+	return o.text(ctx, label, def, nil)
+}
+
+// text asks for the value of a flag as text, which must not be empty and must be accepted by parse, if set.
+func (o *options) text(ctx context.Context, label, def string, parse func(s string) error) (string, error) {
+	m := input.New(label+": ", def).
+		WithProgramOptions(o.programOptions...).
+		WithValidator(ui.ValidatorFunc[string](func(s string) error {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				return errors.New("a value is required")
+			}
+			if parse != nil && parse(s) != nil {
+				return fmt.Errorf("invalid value: %s", s)
+			}
+			return nil
+		}))
+	v, err := m.Run(ctx)
+	return strings.TrimSpace(v), err
+}
@@ -0,0 +1,111 @@
+package wish
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/muesli/termenv" // Terminal capability detection
+)
+
+// colorWriter reduces the colors in the SGR escape sequences written to it to those supported by the color profile
+// of a session, e.g. true colors to the 256-color palette, and removes all styling for terminals without colors. The
+// prompts are rendered by the default renderer of lipgloss, which is shared by all sessions, so each session converts
+// the output to its own profile instead of changing the profile of the renderer.
+type colorWriter struct {
+	w       io.Writer       // w receives the converted output.
+	profile termenv.Profile // profile is the color profile of the session.
+	pending []byte          // pending holds an incomplete escape sequence at the end of the last write.
+}
+
+// newColorWriter returns a writer converting the output written to w to the given color profile.
+func newColorWriter(w io.Writer, profile termenv.Profile) *colorWriter {
+	return &colorWriter{w: w, profile: profile}
+}
+
+// Write implements io.Writer. An escape sequence split across writes is converted once it is complete.
+func (c *colorWriter) Write(p []byte) (int, error) {
+	data := append(c.pending, p...)
+	c.pending = nil
+	var out bytes.Buffer
+	for len(data) > 0 {
+		i := bytes.Index(data, []byte("\x1b["))
+		if i < 0 {
+			if data[len(data)-1] == '\x1b' {
+				out.Write(data[:len(data)-1])
+				c.pending = append(c.pending, '\x1b')
+			} else {
+				out.Write(data)
+			}
+			break
+		}
+		out.Write(data[:i])
+		data = data[i:]
+		end := bytes.IndexFunc(data[2:], func(r rune) bool { return r >= 0x40 && r <= 0x7e })
+		if end < 0 {
+			c.pending = append(c.pending, data...)
+			break
+		}
+		seq := data[:end+3]
+		data = data[end+3:]
+		if seq[len(seq)-1] != 'm' {
+			out.Write(seq)
+			continue
+		}
+		if params := c.convert(string(seq[2 : len(seq)-1])); params != "" {
+			fmt.Fprintf(&out, "\x1b[%sm", params)
+		}
+	}
+	if _, err := c.w.Write(out.Bytes()); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// convert returns the parameters of an SGR sequence with its colors converted to the profile, or an empty string if
+// nothing is left of it.
+func (c *colorWriter) convert(params string) string {
+	if c.profile == termenv.Ascii {
+		return ""
+	}
+	if params == "" {
+		return "0"
+	}
+	fields := strings.Split(params, ";")
+	var out []string
+	for i := 0; i < len(fields); i++ {
+		n, err := strconv.Atoi(fields[i])
+		if err != nil {
+			out = append(out, fields[i])
+			continue
+		}
+		var color termenv.Color
+		bg := n >= 40 && n < 50 || n >= 100 && n < 110
+		switch {
+		case (n == 38 || n == 48) && i+2 < len(fields) && fields[i+1] == "5":
+			idx, _ := strconv.Atoi(fields[i+2])
+			color = termenv.ANSI256Color(idx)
+			i += 2
+		case (n == 38 || n == 48) && i+4 < len(fields) && fields[i+1] == "2":
+			var rgb [3]int
+			for j := range rgb {
+				rgb[j], _ = strconv.Atoi(fields[i+2+j])
+			}
+			color = termenv.RGBColor(fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2]))
+			i += 4
+		case n >= 30 && n <= 37 || n >= 40 && n <= 47:
+			color = termenv.ANSIColor(n % 10)
+		case n >= 90 && n <= 97 || n >= 100 && n <= 107:
+			color = termenv.ANSIColor(n%10 + 8)
+		default:
+			out = append(out, fields[i])
+			continue
+		}
+		if s := c.profile.Convert(color).Sequence(bg || n == 48); s != "" {
+			out = append(out, s)
+		}
+	}
+	return strings.Join(out, ";")
+}
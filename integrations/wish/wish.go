@@ -0,0 +1,205 @@
+// Package wish runs go-ui prompts in the sessions of an SSH server built with charmbracelet/ssh or wish, so admin
+// tools can be used remotely:
+//
+//	srv, err := wish.NewServer(
+//		wish.WithAddress(":2222"),
+//		wish.WithMiddleware(uiwish.Middleware(func(s ssh.Session, opts ...tea.ProgramOption) error {
+//			ok, err := confirm.New("Restart the service?", confirm.ProgramOptions(opts...)).Run(s.Context())
+//			...
+//		})),
+//	)
+//
+// Each session reads from and writes to its own channel and sends its own terminal size to the prompts. Sessions
+// without a pseudo terminal, e.g. of "ssh host command", use the accessible mode unless RequirePty is set.
+//
+// The prompts are styled by the default renderer of lipgloss, which is shared by all sessions; the colors of each
+// session are reduced to those its terminal supports. As the renderer detects its color profile from the server's
+// own output, servers running without a terminal, e.g. as a service, choose the richest profile once at startup:
+//
+//	lipgloss.SetColorProfile(termenv.TrueColor)
+package wish
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/ssh"           // SSH server used by wish
+	"github.com/muesli/termenv"              // Terminal capability detection
+	"github.com/nmeilick/go-ui"
+)
+
+// ErrNoPty is returned for sessions without a pseudo terminal if RequirePty is set.
+var ErrNoPty = errors.New("a pseudo terminal is required, connect using ssh -t")
+
+// Handler runs the prompts of an SSH session, passing opts to each of them.
+type Handler func(s ssh.Session, opts ...tea.ProgramOption) error
+
+// Option configures Middleware and SessionOptions.
+type Option func(*options)
+
+// options holds the settings of Middleware and SessionOptions.
+type options struct {
+	profile        *termenv.Profile    // profile is the color profile used instead of detecting it, if set.
+	requirePty     bool                // requirePty rejects sessions without a pseudo terminal.
+	programOptions []tea.ProgramOption // programOptions are passed to the programs running the prompts.
+}
+
+// newOptions returns the settings configured by the given options.
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// ColorProfile sets the color profile used for all sessions instead of detecting it from the TERM, COLORTERM and
+// NO_COLOR variables sent by the client.
+func ColorProfile(p termenv.Profile) Option {
+	return func(o *options) {
+		o.profile = &p
+	}
+}
+
+// RequirePty rejects sessions without a pseudo terminal with ErrNoPty instead of running their prompts in the
+// accessible mode.
+func RequirePty(require bool) Option {
+	return func(o *options) {
+		o.requirePty = require
+	}
+}
+
+// ProgramOptions passes the given options to the programs running the prompts.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(o *options) {
+		o.programOptions = append(o.programOptions, opts...)
+	}
+}
+
+// Middleware returns an SSH middleware running the handler for each session and then calling the next handler, if
+// any. It can be passed to wish.WithMiddleware as is. If the handler fails, the error is written to the session's
+// stderr and the session exits with status 1, or 130 if the user canceled a prompt.
+func Middleware(h Handler, opts ...Option) func(next ssh.Handler) ssh.Handler {
+	o := newOptions(opts)
+	return func(next ssh.Handler) ssh.Handler {
+		return func(s ssh.Session) {
+			popts, err := o.session(s)
+			if err == nil {
+				err = h(s, popts...)
+			}
+			if err != nil {
+				fmt.Fprintf(s.Stderr(), "Error: %v\n", err)
+				_ = s.Exit(exitCode(err))
+				return
+			}
+			if next != nil {
+				next(s)
+			}
+		}
+	}
+}
+
+// ModelMiddleware returns an SSH middleware running the model returned by fn for each session like Middleware.
+func ModelMiddleware(fn func(s ssh.Session) tea.Model, opts ...Option) func(next ssh.Handler) ssh.Handler {
+	return Middleware(func(s ssh.Session, popts ...tea.ProgramOption) error {
+		return ui.RunContext(s.Context(), fn(s), popts...)
+	}, opts...)
+}
+
+// SessionOptions returns the program options running prompts in the session, for servers not using Middleware. It
+// must be called only once per session, as it takes over the window size changes of the session.
+func SessionOptions(s ssh.Session, opts ...Option) ([]tea.ProgramOption, error) {
+	return newOptions(opts).session(s)
+}
+
+// session returns the program options running prompts in the session.
+func (o *options) session(s ssh.Session) ([]tea.ProgramOption, error) {
+	pty, winch, ok := s.Pty()
+	if !ok {
+		if o.requirePty {
+			return nil, ErrNoPty
+		}
+		popts := []tea.ProgramOption{ui.WithInput(s), ui.WithOutput(s), ui.WithAccessible(true)}
+		return append(popts, o.programOptions...), nil
+	}
+
+	w := watchWindow(pty.Window, winch)
+	popts := []tea.ProgramOption{
+		ui.WithInput(s),
+		ui.WithOutput(newColorWriter(s, o.colorProfile(s, pty))),
+		ui.WithWindowSize(w.size, w.resized),
+	}
+	return append(popts, o.programOptions...), nil
+}
+
+// colorProfile returns the color profile of the session's terminal.
+func (o *options) colorProfile(s ssh.Session, pty ssh.Pty) termenv.Profile {
+	if o.profile != nil {
+		return *o.profile
+	}
+	env := sessionEnv(append(s.Environ(), "TERM="+pty.Term))
+	return termenv.NewOutput(s, termenv.WithEnvironment(env), termenv.WithTTY(true), termenv.WithUnsafe()).
+		EnvColorProfile()
+}
+
+// sessionEnv provides the environment of a session to termenv.
+type sessionEnv []string
+
+// Environ implements termenv.Environ.
+func (e sessionEnv) Environ() []string {
+	return e
+}
+
+// Getenv implements termenv.Environ. Later entries take precedence, like the TERM of the pseudo terminal.
+func (e sessionEnv) Getenv(key string) string {
+	for i := len(e) - 1; i >= 0; i-- {
+		if k, v, _ := strings.Cut(e[i], "="); k == key {
+			return v
+		}
+	}
+	return ""
+}
+
+// window tracks the size of a session's terminal.
+type window struct {
+	mu      sync.Mutex    // mu guards the size.
+	width   int           // width is the number of columns.
+	height  int           // height is the number of rows.
+	resized chan struct{} // resized signals size changes.
+}
+
+// watchWindow returns a window of the given initial size that is updated from winch until it is closed with the
+// session.
+func watchWindow(initial ssh.Window, winch <-chan ssh.Window) *window {
+	w := &window{width: initial.Width, height: initial.Height, resized: make(chan struct{}, 1)}
+	go func() {
+		for win := range winch {
+			w.mu.Lock()
+			w.width, w.height = win.Width, win.Height
+			w.mu.Unlock()
+			select {
+			case w.resized <- struct{}{}:
+			default:
+			}
+		}
+	}()
+	return w
+}
+
+// size returns the current size of the window.
+func (w *window) size() (width, height int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.width, w.height
+}
+
+// exitCode returns the exit status of a session whose handler failed with err.
+func exitCode(err error) int {
+	if errors.Is(err, ui.CanceledError) || errors.Is(err, ui.QuitError) {
+		return 130
+	}
+	return 1
+}
@@ -0,0 +1,41 @@
+// Package answer converts preset answers to the values expected by the components.
+package answer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Index returns the index of the item selected by v, which is either the index itself or the item as a string.
+func Index(v any, items []string) (int, error) {
+	idx := -1
+	switch v := v.(type) {
+	case int:
+		idx = v
+	case int64:
+		idx = int(v)
+	case float64:
+		if v == float64(int(v)) {
+			idx = int(v)
+		}
+	case string:
+		for i, item := range items {
+			if strings.EqualFold(item, v) {
+				idx = i
+				break
+			}
+		}
+	}
+	if idx < 0 || idx >= len(items) {
+		return -1, fmt.Errorf("invalid answer: %v", v)
+	}
+	return idx, nil
+}
+
+// String returns v as a string.
+func String(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprint(v)
+}
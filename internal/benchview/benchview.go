@@ -0,0 +1,57 @@
+// Package benchview holds the helpers of the View benchmarks of the components, which measure how long rendering
+// takes at realistic sizes: go test -bench View ./...
+package benchview
+
+import (
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/muesli/termenv"              // Terminal capability detection
+)
+
+var (
+	down = tea.KeyMsg{Type: tea.KeyDown}
+	size = tea.WindowSizeMsg{Width: 120, Height: 40}
+)
+
+// Items returns n item names of a realistic length.
+func Items(n int) []string {
+	s := make([]string, n)
+	for i := range s {
+		s[i] = fmt.Sprintf("item %04d: some description of the item", i)
+	}
+	return s
+}
+
+// Run measures rendering the view of the models returned by newModel: repeatedly without changes in between, like the
+// frames of a program showing static content, and once after each cursor movement. Colors are rendered like in a
+// modern terminal, which is the most expensive case.
+func Run(b *testing.B, newModel func() tea.Model) {
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	b.Run("static", func(b *testing.B) {
+		m := prepare(newModel())
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = m.View()
+		}
+	})
+	b.Run("moving", func(b *testing.B) {
+		m := prepare(newModel())
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			m, _ = m.Update(down)
+			_ = m.View()
+		}
+	})
+}
+
+// prepare sends the window size to the model and renders it once.
+func prepare(m tea.Model) tea.Model {
+	m, _ = m.Update(size)
+	_ = m.View()
+	return m
+}
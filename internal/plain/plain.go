@@ -0,0 +1,125 @@
+// Package plain implements the simple line-based prompts used by the accessible mode.
+package plain
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Prompter asks questions line by line.
+type Prompter struct {
+	in  io.Reader // in is the reader answers are read from.
+	out io.Writer // out is the writer questions are written to.
+}
+
+// New returns a new Prompter reading from in and writing to out.
+func New(in io.Reader, out io.Writer) *Prompter {
+	return &Prompter{in: in, out: out}
+}
+
+// readLine reads a single line without reading ahead, so the remaining input is left for subsequent prompts.
+func (p *Prompter) readLine() (string, error) {
+	var b strings.Builder
+	buf := make([]byte, 1)
+	for {
+		n, err := p.in.Read(buf)
+		if n > 0 {
+			if buf[0] == '\n' {
+				return strings.TrimSuffix(b.String(), "\r"), nil
+			}
+			b.WriteByte(buf[0])
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) && b.Len() > 0 {
+				return b.String(), nil
+			}
+			return "", err
+		}
+	}
+}
+
+// Println writes a line to the output.
+func (p *Prompter) Println(a ...any) {
+	fmt.Fprintln(p.out, a...)
+}
+
+// Line asks for a single line of text. An empty answer selects the default value.
+func (p *Prompter) Line(prompt, def string) (string, error) {
+	if def != "" {
+		fmt.Fprintf(p.out, "%s[%s] ", prompt, def)
+	} else {
+		fmt.Fprint(p.out, prompt)
+	}
+	s, err := p.readLine()
+	if err != nil {
+		return "", err
+	}
+	if s == "" {
+		return def, nil
+	}
+	return s, nil
+}
+
+// Lines asks for multiple lines of text, terminated by an empty line.
+func (p *Prompter) Lines(prompt string) (string, error) {
+	if prompt != "" {
+		fmt.Fprintln(p.out, prompt)
+	}
+	fmt.Fprintln(p.out, "(Finish with an empty line)")
+
+	var lines []string
+	for {
+		s, err := p.readLine()
+		if err != nil {
+			if errors.Is(err, io.EOF) && len(lines) > 0 {
+				break
+			}
+			return "", err
+		}
+		if s == "" {
+			break
+		}
+		lines = append(lines, s)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// Choice asks to choose one of the given items by number or name and returns its index. An empty answer selects the
+// item at index def, if valid.
+func (p *Prompter) Choice(label string, items []string, def int) (int, error) {
+	if label != "" {
+		fmt.Fprintln(p.out, label)
+	}
+	for i, item := range items {
+		fmt.Fprintf(p.out, "%3d) %s\n", i+1, item)
+	}
+
+	for {
+		prompt := fmt.Sprintf("Choose 1-%d: ", len(items))
+		if def >= 0 && def < len(items) {
+			prompt = fmt.Sprintf("Choose 1-%d [%d]: ", len(items), def+1)
+		}
+		fmt.Fprint(p.out, prompt)
+		s, err := p.readLine()
+		if err != nil {
+			return -1, err
+		}
+
+		s = strings.TrimSpace(s)
+		if s == "" && def >= 0 && def < len(items) {
+			return def, nil
+		}
+		if n, err := strconv.Atoi(s); err == nil && n >= 1 && n <= len(items) {
+			return n - 1, nil
+		}
+		for i, item := range items {
+			if strings.EqualFold(item, s) {
+				return i, nil
+			}
+		}
+		fmt.Fprintln(p.out, "Invalid choice.")
+	}
+}
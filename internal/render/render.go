@@ -0,0 +1,65 @@
+// Package render caches the styled fragments of views, so that frames showing mostly unchanged content, like long
+// lists, do not style every line again.
+package render
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+)
+
+// maxEntries bounds the size of a Cache, which is cleared when it is exceeded, e.g. when the items change often.
+const maxEntries = 4096
+
+// Fingerprint returns a short rendering of the styles, which changes whenever their output changes, e.g. after the
+// color profile or the theme changed. It is used to invalidate caches.
+func Fingerprint(styles ...lipgloss.Style) string {
+	var b strings.Builder
+	for _, s := range styles {
+		b.WriteString(s.Render("."))
+		b.WriteByte(0)
+	}
+	return b.String()
+}
+
+// Cache holds rendered fragments by key. The key must include everything affecting the rendering besides the
+// version passed to Get, like the text and the state of an item. Models should allocate their cache in New and can
+// share it with their copies, as differently styled copies use different versions. It is safe for concurrent use, so
+// that copies can be rendered by different programs, e.g. one per SSH session.
+type Cache[K comparable] struct {
+	mu      sync.Mutex   // mu guards version and entries.
+	version string       // version is the version the entries were rendered for.
+	entries map[K]string // entries are the rendered fragments.
+}
+
+// New returns an empty cache.
+func New[K comparable]() *Cache[K] {
+	return &Cache[K]{}
+}
+
+// Get returns the fragment for the key, calling render if it is not cached yet. The cache is cleared if the version
+// changed, which is usually the Fingerprint of the styles and the formats used to render the fragments. A nil cache
+// renders every time.
+func (c *Cache[K]) Get(version string, key K, render func() string) string {
+	if c == nil {
+		return render()
+	}
+	c.mu.Lock()
+	if c.entries == nil || c.version != version || len(c.entries) >= maxEntries {
+		c.version = version
+		c.entries = make(map[K]string)
+	}
+	s, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok {
+		return s
+	}
+	s = render()
+	c.mu.Lock()
+	if c.version == version {
+		c.entries[key] = s
+	}
+	c.mu.Unlock()
+	return s
+}
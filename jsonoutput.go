@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// SecretModel is implemented by prompts whose answers must not be revealed, like passwords. Their answers are
+// omitted from the JSON output.
+type SecretModel interface {
+	Secret() bool
+}
+
+// AnswerRecord is the record written by SetJSONOutput for each finished prompt.
+type AnswerRecord struct {
+	Label    string  `json:"label"`            // Label identifies the prompt, see AnswerableModel.
+	Value    any     `json:"value"`            // Value is the answer, or null if there is none or it is secret.
+	Canceled bool    `json:"canceled"`         // Canceled indicates that the prompt was canceled or quit.
+	Duration float64 `json:"duration"`         // Duration is the time the prompt was active in seconds.
+	Preset   bool    `json:"preset,omitempty"` // Preset indicates that the answer was preset.
+	Secret   bool    `json:"secret,omitempty"` // Secret indicates that the answer was omitted.
+	Error    string  `json:"error,omitempty"`  // Error is the error the prompt failed with.
+}
+
+var jsonOutput struct {
+	sync.Mutex
+	w io.Writer
+}
+
+// SetJSONOutput writes an AnswerRecord as a line of JSON to w whenever a prompt is answered, canceled or fails, so
+// wrapper scripts can capture the choices made without parsing the screen output. Prompts are models implementing
+// AnswerableModel. Passing nil disables the output.
+//
+//	{"label":"Environment","value":"staging","canceled":false,"duration":1.52}
+func SetJSONOutput(w io.Writer) {
+	jsonOutput.Lock()
+	defer jsonOutput.Unlock()
+	jsonOutput.w = w
+}
+
+// writeJSON writes the record of the event to the JSON output, if any. Events of shown prompts are skipped.
+func writeJSON(e Event) {
+	jsonOutput.Lock()
+	defer jsonOutput.Unlock()
+	if jsonOutput.w == nil || e.Type == EventShown {
+		return
+	}
+	r := AnswerRecord{
+		Label:    e.Key,
+		Canceled: e.Type == EventCanceled || e.Type == EventQuit,
+		Duration: e.Duration.Seconds(),
+		Preset:   e.Preset,
+		Secret:   e.Secret,
+	}
+	if e.Type == EventAnswered && !e.Secret {
+		r.Value = e.Answer
+	}
+	if e.Type == EventFailed && e.Err != nil {
+		r.Error = e.Err.Error()
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		// Answers are strings, numbers, bools or slices of them; anything else is reported as an error.
+		r.Value, r.Error = nil, err.Error()
+		data, _ = json.Marshal(r)
+	}
+	_, _ = jsonOutput.w.Write(append(data, '\n'))
+}
@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"sync"
+
+	"github.com/charmbracelet/bubbles/key"       // Manages key bindings
+	"github.com/charmbracelet/bubbles/textinput" // Text input whose editing keys are shared by all components
+	tea "github.com/charmbracelet/bubbletea"     // Framework for building terminal applications
+)
+
+// KeyMap defines the key bindings for the semantics shared by all components. Components inherit the default key map
+// set by SetDefaultKeyMap unless it is overridden using their WithKeyMap method. Disabling a binding disables the
+// corresponding action.
+type KeyMap struct {
+	Confirm key.Binding // Confirm accepts the current input or selection.
+	Cancel  key.Binding // Cancel cancels the current prompt.
+	Quit    key.Binding // Quit requests to quit the program.
+	Prev    key.Binding // Prev moves to the previous item.
+	Next    key.Binding // Next moves to the next item.
+	Copy    key.Binding // Copy copies the current value or item to the clipboard.
+	Paste   key.Binding // Paste inserts the text in the clipboard.
+	Forget  key.Binding // Forget clears the remembered answer of a prompt, see WithRemember.
+	Back    key.Binding // Back returns to the previous prompt of a sequence or the previous step of a wizard.
+	Details key.Binding // Details expands or collapses the details of an error, see the errorview package.
+}
+
+// KeyModel is implemented by models binding keys that are also bound by the hosts running them, like the Back binding
+// (shift+tab) of Sequence and the wizard. A host passes a key on to the model instead of handling it if UsesKey
+// reports true, e.g. shift+tab moving to the previous field of a form unless the first field is focused.
+type KeyModel interface {
+	UsesKey(msg tea.KeyMsg) bool
+}
+
+// usesKey reports whether the model handles the key itself, see KeyModel.
+func usesKey(m tea.Model, msg tea.KeyMsg) bool {
+	km, ok := m.(KeyModel)
+	return ok && km.UsesKey(msg)
+}
+
+// ShortHelp returns the bindings shown in the short help view.
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Prev, k.Next, k.Confirm, k.Cancel}
+}
+
+// FullHelp returns the bindings shown in the full help view.
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Prev, k.Next}, {k.Confirm, k.Cancel, k.Quit}, {k.Copy, k.Paste}}
+}
+
+// keyDefaults holds the default key bindings of all components.
+type keyDefaults struct {
+	sync.RWMutex
+	profile KeyProfile       // profile is the profile the bindings were taken from.
+	km      KeyMap           // km is the key map shared by all components.
+	text    textinput.KeyMap // text holds the editing keys of text inputs.
+}
+
+var defaultKeyMap = newKeyDefaults(envKeyProfile())
+
+// newKeyDefaults returns the default key bindings of the profile.
+func newKeyDefaults(p KeyProfile) *keyDefaults {
+	return &keyDefaults{profile: p, km: p.KeyMap(), text: p.TextKeyMap()}
+}
+
+// DefaultKeyMap returns the default key map used by all components.
+func DefaultKeyMap() KeyMap {
+	defaultKeyMap.RLock()
+	defer defaultKeyMap.RUnlock()
+	return defaultKeyMap.km
+}
+
+// SetDefaultKeyMap sets the default key map used by all components created afterwards.
+func SetDefaultKeyMap(km KeyMap) {
+	defaultKeyMap.Lock()
+	defer defaultKeyMap.Unlock()
+	defaultKeyMap.km = km
+}
+
+// DefaultTextKeyMap returns the default editing keys of all components with text inputs.
+func DefaultTextKeyMap() textinput.KeyMap {
+	defaultKeyMap.RLock()
+	defer defaultKeyMap.RUnlock()
+	return defaultKeyMap.text
+}
+
+// SetDefaultTextKeyMap sets the default editing keys of all components with text inputs created afterwards.
+func SetDefaultTextKeyMap(km textinput.KeyMap) {
+	defaultKeyMap.Lock()
+	defer defaultKeyMap.Unlock()
+	defaultKeyMap.text = km
+}
@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// keypadTimeout is how long an alt+O is held back, waiting for the rest of a keypad sequence.
+const keypadTimeout = 20 * time.Millisecond
+
+// keypad maps the final characters of the sequences sent by the numeric keypad in application mode, ESC O <char>, to
+// the keys they stand for. Bubble Tea does not know these sequences and reports alt+O followed by the character.
+var keypad = map[rune]tea.KeyMsg{
+	'M': {Type: tea.KeyEnter},
+	'j': {Type: tea.KeyRunes, Runes: []rune{'*'}},
+	'k': {Type: tea.KeyRunes, Runes: []rune{'+'}},
+	'l': {Type: tea.KeyRunes, Runes: []rune{','}},
+	'm': {Type: tea.KeyRunes, Runes: []rune{'-'}},
+	'n': {Type: tea.KeyRunes, Runes: []rune{'.'}},
+	'o': {Type: tea.KeyRunes, Runes: []rune{'/'}},
+	'p': {Type: tea.KeyRunes, Runes: []rune{'0'}},
+	'q': {Type: tea.KeyRunes, Runes: []rune{'1'}},
+	'r': {Type: tea.KeyRunes, Runes: []rune{'2'}},
+	's': {Type: tea.KeyRunes, Runes: []rune{'3'}},
+	't': {Type: tea.KeyRunes, Runes: []rune{'4'}},
+	'u': {Type: tea.KeyRunes, Runes: []rune{'5'}},
+	'v': {Type: tea.KeyRunes, Runes: []rune{'6'}},
+	'w': {Type: tea.KeyRunes, Runes: []rune{'7'}},
+	'x': {Type: tea.KeyRunes, Runes: []rune{'8'}},
+	'y': {Type: tea.KeyRunes, Runes: []rune{'9'}},
+	'X': {Type: tea.KeyRunes, Runes: []rune{'='}},
+}
+
+// keypadTimeoutMsg releases a held back alt+O that was not followed by the rest of a keypad sequence.
+type keypadTimeoutMsg struct {
+	seq int // seq is the number of the held back key.
+}
+
+// keyNormalizer translates the key quirks of terminals and platforms into the keys of the standard bindings, so
+// components behave the same everywhere: keypad sequences, e.g. of the numpad enter key, and on Windows AltGr
+// combinations and ctrl+space.
+type keyNormalizer struct {
+	held *tea.KeyMsg // held is an alt+O that may start a keypad sequence.
+	seq  int         // seq is the number of keys held back so far.
+}
+
+// update normalizes key messages. It returns the messages to pass to the model instead of msg, and a command
+// releasing a held back key.
+func (n *keyNormalizer) update(msg tea.Msg) ([]tea.Msg, tea.Cmd) {
+	switch msg := msg.(type) {
+	case keypadTimeoutMsg:
+		if n.held == nil || msg.seq != n.seq {
+			return nil, nil
+		}
+		held := *n.held
+		n.held = nil
+		return []tea.Msg{held}, nil
+	case tea.KeyMsg:
+		msg = platformKey(msg)
+		if n.held != nil {
+			held := *n.held
+			n.held = nil
+			if len(msg.Runes) == 1 && msg.Type == tea.KeyRunes && !msg.Alt {
+				if k, ok := keypad[msg.Runes[0]]; ok {
+					return []tea.Msg{k}, nil
+				}
+			}
+			msgs, cmd := n.update(msg)
+			return append([]tea.Msg{held}, msgs...), cmd
+		}
+		if msg.Type == tea.KeyRunes && msg.Alt && !msg.Paste && len(msg.Runes) == 1 && msg.Runes[0] == 'O' {
+			n.held = &msg
+			n.seq++
+			seq := n.seq
+			return nil, tea.Tick(keypadTimeout, func(time.Time) tea.Msg { return keypadTimeoutMsg{seq: seq} })
+		}
+		return []tea.Msg{msg}, nil
+	}
+	return []tea.Msg{msg}, nil
+}
@@ -0,0 +1,113 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"       // Manages key bindings
+	"github.com/charmbracelet/bubbles/textinput" // Text input whose editing keys are part of a profile
+)
+
+// KeyProfile is a set of key bindings applied to all components, so that navigation, word movement and canceling
+// work the same in every prompt.
+type KeyProfile int
+
+const (
+	DefaultProfile KeyProfile = iota // DefaultProfile uses the arrow keys, j/k for prev/next and readline editing keys.
+	EmacsProfile                     // EmacsProfile adds ctrl+p/ctrl+n, ctrl+g to cancel and alt+w/ctrl+y to copy/paste.
+	VimProfile                       // VimProfile uses k/j for prev/next, ctrl+p/ctrl+n and Vim insert mode editing.
+)
+
+// String returns the name of the profile.
+func (p KeyProfile) String() string {
+	switch p {
+	case DefaultProfile:
+		return "default"
+	case EmacsProfile:
+		return "emacs"
+	case VimProfile:
+		return "vim"
+	}
+	return "unknown"
+}
+
+// ParseKeyProfile returns the profile with the given name, ignoring case.
+func ParseKeyProfile(name string) (KeyProfile, error) {
+	for _, p := range []KeyProfile{DefaultProfile, EmacsProfile, VimProfile} {
+		if strings.EqualFold(name, p.String()) {
+			return p, nil
+		}
+	}
+	return DefaultProfile, fmt.Errorf("unknown key profile %q", name)
+}
+
+// KeyMap returns the key map shared by all components in the profile.
+func (p KeyProfile) KeyMap() KeyMap {
+	km := KeyMap{
+		Confirm: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "confirm")),
+		Cancel:  key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+		Quit:    key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "quit")),
+		Prev:    key.NewBinding(key.WithKeys("up", "j", "left"), key.WithHelp("↑/j", "prev")),
+		Next:    key.NewBinding(key.WithKeys("down", "k", "right"), key.WithHelp("↓/k", "next")),
+		Copy:    key.NewBinding(key.WithKeys("alt+c"), key.WithHelp("alt+c", "copy")),
+		Paste:   key.NewBinding(key.WithKeys("ctrl+v"), key.WithHelp("ctrl+v", "paste")),
+		Forget:  key.NewBinding(key.WithKeys("ctrl+x"), key.WithHelp("ctrl+x", "forget")),
+		Back:    key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+		Details: key.NewBinding(key.WithKeys("ctrl+o"), key.WithHelp("ctrl+o", "details")),
+	}
+	switch p {
+	case EmacsProfile:
+		km.Cancel = key.NewBinding(key.WithKeys("esc", "ctrl+g"), key.WithHelp("esc/ctrl+g", "cancel"))
+		km.Prev = key.NewBinding(key.WithKeys("up", "ctrl+p", "left"), key.WithHelp("↑/ctrl+p", "prev"))
+		km.Next = key.NewBinding(key.WithKeys("down", "ctrl+n", "right"), key.WithHelp("↓/ctrl+n", "next"))
+		km.Copy = key.NewBinding(key.WithKeys("alt+w"), key.WithHelp("alt+w", "copy"))
+		km.Paste = key.NewBinding(key.WithKeys("ctrl+y"), key.WithHelp("ctrl+y", "yank"))
+	case VimProfile:
+		km.Prev = key.NewBinding(key.WithKeys("up", "k", "ctrl+p", "left"), key.WithHelp("↑/k", "prev"))
+		km.Next = key.NewBinding(key.WithKeys("down", "j", "ctrl+n", "right"), key.WithHelp("↓/j", "next"))
+		km.Copy = key.NewBinding(key.WithKeys("alt+y"), key.WithHelp("alt+y", "yank"))
+	}
+	return km
+}
+
+// TextKeyMap returns the editing keys of text inputs in the profile.
+func (p KeyProfile) TextKeyMap() textinput.KeyMap {
+	km := textinput.DefaultKeyMap
+	if p == VimProfile {
+		km.WordForward = key.NewBinding(key.WithKeys("shift+right", "ctrl+right", "alt+right"))
+		km.WordBackward = key.NewBinding(key.WithKeys("shift+left", "ctrl+left", "alt+left"))
+		km.CharacterForward = key.NewBinding(key.WithKeys("right"))
+		km.CharacterBackward = key.NewBinding(key.WithKeys("left"))
+		km.DeleteWordForward = key.NewBinding(key.WithKeys("alt+delete"))
+		km.DeleteAfterCursor = key.NewBinding(key.WithDisabled())
+		km.DeleteCharacterForward = key.NewBinding(key.WithKeys("delete"))
+		km.LineStart = key.NewBinding(key.WithKeys("home"))
+		km.LineEnd = key.NewBinding(key.WithKeys("end"))
+	}
+	return km
+}
+
+// SetKeyProfile sets the default key map and text editing keys of all components created afterwards to those of the
+// profile. Components can still override them, e.g. using their WithKeyMap method. The profile is initially taken
+// from the environment variable UI_KEY_PROFILE, so users can pick their preferred bindings.
+func SetKeyProfile(p KeyProfile) {
+	defaultKeyMap.Lock()
+	defer defaultKeyMap.Unlock()
+	defaultKeyMap.profile = p
+	defaultKeyMap.km = p.KeyMap()
+	defaultKeyMap.text = p.TextKeyMap()
+}
+
+// CurrentKeyProfile returns the profile set by SetKeyProfile.
+func CurrentKeyProfile() KeyProfile {
+	defaultKeyMap.RLock()
+	defer defaultKeyMap.RUnlock()
+	return defaultKeyMap.profile
+}
+
+// envKeyProfile returns the profile named by UI_KEY_PROFILE, or DefaultProfile if it is unset or invalid.
+func envKeyProfile() KeyProfile {
+	p, _ := ParseKeyProfile(os.Getenv("UI_KEY_PROFILE"))
+	return p
+}
@@ -0,0 +1,16 @@
+//go:build !windows
+
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// interruptIsKey determines if an interrupt signal is delivered to the model as ctrl+c. Terminals in raw mode report
+// ctrl+c as a key, so an interrupt comes from another process.
+const interruptIsKey = false
+
+// platformKey translates platform-specific key quirks. Unix terminals need no translation.
+func platformKey(k tea.KeyMsg) tea.KeyMsg {
+	return k
+}
@@ -0,0 +1,32 @@
+//go:build windows
+
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// interruptIsKey determines if an interrupt signal is delivered to the model as ctrl+c. The Windows console reports
+// ctrl+break as an interrupt even in raw mode, while ctrl+c is read as a key.
+const interruptIsKey = true
+
+// platformKey translates the quirks of the Windows console input into the keys reported by Unix terminals. AltGr is
+// reported as ctrl+alt, so the characters it produces arrive with the alt modifier, and '@' even as ctrl+@. Alt
+// combinations of the standard bindings use letters, so alt is dropped for any other character. ctrl+space and ctrl+2
+// arrive as a NUL character instead of ctrl+@.
+func platformKey(k tea.KeyMsg) tea.KeyMsg {
+	switch {
+	case k.Type == tea.KeyCtrlAt && k.Alt:
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'@'}}
+	case k.Type == tea.KeyRunes && len(k.Runes) == 1 && k.Runes[0] == 0:
+		return tea.KeyMsg{Type: tea.KeyCtrlAt, Alt: k.Alt}
+	case k.Type == tea.KeyRunes && k.Alt && !k.Paste && len(k.Runes) == 1 && !asciiLetter(k.Runes[0]):
+		k.Alt = false
+	}
+	return k
+}
+
+// asciiLetter reports whether r is an ASCII letter.
+func asciiLetter(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+}
@@ -0,0 +1,58 @@
+//go:build windows
+
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+func TestPlatformKey(t *testing.T) {
+	tests := []struct {
+		name string
+		in   tea.KeyMsg
+		want string
+	}{
+		{"altgr at", tea.KeyMsg{Type: tea.KeyCtrlAt, Alt: true}, "@"},
+		{"altgr brace", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'{'}, Alt: true}, "{"},
+		{"altgr euro", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'€'}, Alt: true}, "€"},
+		{"alt letter", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}, Alt: true}, "alt+b"},
+		{"ctrl space", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{0}}, "ctrl+@"},
+		{"alt ctrl space", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{0}, Alt: true}, "alt+ctrl+@"},
+		{"ctrl at", tea.KeyMsg{Type: tea.KeyCtrlAt}, "ctrl+@"},
+		{"pasted", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'{'}, Alt: true, Paste: true}, "alt+[{]"},
+		{"enter", tea.KeyMsg{Type: tea.KeyEnter}, "enter"},
+	}
+	for _, tt := range tests {
+		if got := platformKey(tt.in).String(); got != tt.want {
+			t.Errorf("%s: platformKey(%q) = %q, want %q", tt.name, tt.in.String(), got, tt.want)
+		}
+	}
+}
+
+func TestKeyNormalizerNumpadEnter(t *testing.T) {
+	var n keyNormalizer
+	msgs, cmd := n.update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'O'}, Alt: true})
+	if len(msgs) != 0 || cmd == nil {
+		t.Fatalf("alt+O: got %v, want it held back", msgs)
+	}
+	msgs, _ = n.update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'M'}})
+	if len(msgs) != 1 || msgs[0].(tea.KeyMsg).Type != tea.KeyEnter {
+		t.Fatalf("alt+O M: got %v, want enter", msgs)
+	}
+}
+
+func TestKeyNormalizerAltGr(t *testing.T) {
+	var n keyNormalizer
+	msgs, cmd := n.update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'\\'}, Alt: true})
+	if cmd != nil || len(msgs) != 1 || msgs[0].(tea.KeyMsg).String() != "\\" {
+		t.Fatalf("altgr+\\: got %v, want \\", msgs)
+	}
+}
+
+func TestInterruptIsKey(t *testing.T) {
+	if !interruptIsKey {
+		t.Error("ctrl+break must be delivered to the model as ctrl+c first")
+	}
+}
@@ -0,0 +1,579 @@
+package layout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/gauge"
+	"github.com/nmeilick/go-ui/input"
+	"github.com/nmeilick/go-ui/pick"
+)
+
+var (
+	nextKey  = key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "next pane"))
+	prevKey  = key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "previous pane"))
+	leftKey  = key.NewBinding(key.WithKeys("ctrl+left"), key.WithHelp("ctrl+←", "pane left"))
+	rightKey = key.NewBinding(key.WithKeys("ctrl+right"), key.WithHelp("ctrl+→", "pane right"))
+	upKey    = key.NewBinding(key.WithKeys("ctrl+up"), key.WithHelp("ctrl+↑", "pane above"))
+	downKey  = key.NewBinding(key.WithKeys("ctrl+down"), key.WithHelp("ctrl+↓", "pane below"))
+)
+
+// Direction is the direction in which a layout arranges its children.
+type Direction int
+
+const (
+	Horizontal Direction = iota // Horizontal arranges the children side by side, as columns of a row.
+	Vertical                    // Vertical arranges the children one below the other, as rows of a column.
+)
+
+// String returns the name of the direction.
+func (d Direction) String() string {
+	switch d {
+	case Horizontal:
+		return "horizontal"
+	case Vertical:
+		return "vertical"
+	}
+	return fmt.Sprintf("Direction(%d)", int(d))
+}
+
+// focuser is implemented by models that can be focused, which includes all interactive components.
+type focuser interface {
+	Focus() tea.Cmd
+	Blur()
+}
+
+// Child is a model hosted by a layout, together with its size along the direction of the layout.
+type Child struct {
+	Model   tea.Model // Model is the hosted model, which may be another layout.
+	Size    int       // Size is the fixed size in cells: columns in a row, lines in a column; 0 for a flexible size.
+	Weight  int       // Weight is the share of the space left by fixed children for a flexible child; 0 counts as 1.
+	NoFocus bool      // NoFocus excludes the model from focus routing, e.g. for display-only components.
+}
+
+// Fixed returns a child with the given fixed size: columns in a row, lines in a column.
+func Fixed(size int, m tea.Model) Child {
+	return Child{Model: m, Size: max(size, 1)}
+}
+
+// Flex returns a child sharing the space left by fixed children with other flexible children in proportion to the
+// given weight.
+func Flex(weight int, m tea.Model) Child {
+	return Child{Model: m, Weight: max(weight, 1)}
+}
+
+// Static returns a copy of the child that is excluded from focus routing, e.g. for display-only components.
+func (c Child) Static() Child {
+	c.NoFocus = true
+	return c
+}
+
+// weight returns the weight of a flexible child.
+func (c Child) weight() int {
+	return max(c.Weight, 1)
+}
+
+// Model represents a layout arranging several models in a row or a column, e.g. to build a full-screen application
+// out of components. Layouts can be nested: a row of columns, a column of rows and so on. Window size messages are
+// sliced, so that each child receives the size of its area. The outermost layout routes the focus between the
+// interactive children, the leaves of the tree of layouts, with tab and shift+tab or spatially with ctrl+arrows, and
+// passes key messages only to the focused child. All other messages are passed to all children.
+//
+// Children should be embedded components, so that they do not quit the program when they are finished.
+type Model struct {
+	dir            Direction           // dir is the direction in which the children are arranged.
+	children       []Child             // children holds the hosted models.
+	focus          int                 // focus is the index of the child holding the focus, -1 if none.
+	nested         bool                // nested determines if the layout is hosted by another layout.
+	gap            int                 // gap is the number of cells between the children.
+	border         bool                // border determines if each child is surrounded by a border.
+	width          int                 // width is the width of the layout, updated from window size messages.
+	height         int                 // height is the height of the layout, updated from window size messages.
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+
+	canceled bool // canceled indicates whether the layout was canceled
+	quit     bool // quit indicates whether the layout was quit
+}
+
+// New creates and returns a new Model arranging the given children in the given direction, configured by the given
+// options. Layouts among the children become nested layouts, leaving the focus routing to this one.
+func New(dir Direction, children []Child, opts ...Option) *Model {
+	m := &Model{
+		dir:      dir,
+		children: children,
+		focus:    -1,
+		quitable: true,
+		keymap:   ui.DefaultKeyMap(),
+		styles:   DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, c := range children {
+		if l, ok := c.Model.(*Model); ok {
+			l.nested = true
+		}
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Row returns a new Model arranging the given children side by side.
+func Row(children ...Child) *Model {
+	return New(Horizontal, children)
+}
+
+// Column returns a new Model arranging the given children one below the other.
+func Column(children ...Child) *Model {
+	return New(Vertical, children)
+}
+
+// WithGap sets the number of cells between the children and returns a new Model with the updated gap.
+func (m *Model) WithGap(gap int) *Model {
+	return m.With(Gap(gap))
+}
+
+// WithBorder sets whether each child is surrounded by a border, highlighted for the focused child, and returns a new
+// Model with the updated flag.
+func (m *Model) WithBorder(border bool) *Model {
+	return m.With(Border(border))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Direction returns the direction in which the children are arranged.
+func (m *Model) Direction() Direction {
+	return m.dir
+}
+
+// Children returns the hosted models in their current state.
+func (m *Model) Children() []tea.Model {
+	models := make([]tea.Model, len(m.children))
+	for i, c := range m.children {
+		models[i] = c.Model
+	}
+	return models
+}
+
+// Child returns the hosted model with the given index, or nil if the index is out of range.
+func (m *Model) Child(i int) tea.Model {
+	if i < 0 || i >= len(m.children) {
+		return nil
+	}
+	return m.children[i].Model
+}
+
+// childLeaves returns the paths of the focusable leaves within the given child, relative to the child. A child that
+// is not a layout is a leaf itself, with an empty path.
+func childLeaves(c Child) [][]int {
+	if c.NoFocus {
+		return nil
+	}
+	if l, ok := c.Model.(*Model); ok {
+		return l.leaves()
+	}
+	return [][]int{{}}
+}
+
+// leaves returns the paths of all focusable leaves in the order of the tree of layouts. Each path holds the indexes
+// of the children from this layout down to the leaf.
+func (m *Model) leaves() [][]int {
+	var paths [][]int
+	for i, c := range m.children {
+		for _, p := range childLeaves(c) {
+			paths = append(paths, append([]int{i}, p...))
+		}
+	}
+	return paths
+}
+
+// path returns the path of the focused leaf, or nil if no child holds the focus.
+func (m *Model) path() []int {
+	var path []int
+	for l := m; l.focus >= 0; {
+		path = append(path, l.focus)
+		sub, ok := l.children[l.focus].Model.(*Model)
+		if !ok {
+			break
+		}
+		l = sub
+	}
+	return path
+}
+
+// Focused returns the focused leaf, or nil if no child holds the focus.
+func (m *Model) Focused() tea.Model {
+	for l := m; l.focus >= 0; {
+		c := l.children[l.focus].Model
+		sub, ok := c.(*Model)
+		if !ok {
+			return c
+		}
+		l = sub
+	}
+	return nil
+}
+
+// SetFocus moves the focus to the leaf with the given path: the index of a child of this layout, followed by the
+// indexes within nested layouts. It returns the command returned by the Focus method of the leaf, e.g. to start the
+// cursor blink. Invalid paths are ignored.
+func (m *Model) SetFocus(path ...int) tea.Cmd {
+	if !slices.ContainsFunc(m.leaves(), func(p []int) bool { return slices.Equal(p, path) }) {
+		return nil
+	}
+	if f, ok := m.Focused().(focuser); ok {
+		f.Blur()
+	}
+	m.clearFocus()
+	l := m
+	for _, i := range path {
+		l.focus = i
+		sub, ok := l.children[i].Model.(*Model)
+		if !ok {
+			break
+		}
+		l = sub
+	}
+	if f, ok := m.Focused().(focuser); ok {
+		return f.Focus()
+	}
+	return nil
+}
+
+// clearFocus removes the focus from this layout and all nested layouts, so that only the layouts on the path of
+// the focused leaf have a focused child.
+func (m *Model) clearFocus() {
+	m.focus = -1
+	for _, c := range m.children {
+		if l, ok := c.Model.(*Model); ok {
+			l.clearFocus()
+		}
+	}
+}
+
+// cycle moves the focus to the next leaf in the given direction, wrapping around at the ends.
+func (m *Model) cycle(delta int) tea.Cmd {
+	leaves := m.leaves()
+	if len(leaves) == 0 {
+		return nil
+	}
+	path := m.path()
+	i := slices.IndexFunc(leaves, func(p []int) bool { return slices.Equal(p, path) })
+	if i < 0 {
+		return m.SetFocus(leaves[0]...)
+	}
+	return m.SetFocus(leaves[(i+delta+len(leaves))%len(leaves)]...)
+}
+
+// FocusNext moves the focus to the next leaf, wrapping around after the last one.
+func (m *Model) FocusNext() tea.Cmd {
+	return m.cycle(1)
+}
+
+// FocusPrev moves the focus to the previous leaf, wrapping around before the first one.
+func (m *Model) FocusPrev() tea.Cmd {
+	return m.cycle(-1)
+}
+
+// move moves the focus spatially: to the neighboring child in the given direction and delta within the innermost
+// layout on the focus path arranged in that direction that has one.
+func (m *Model) move(dir Direction, delta int) tea.Cmd {
+	path := m.path()
+	if len(path) == 0 || len(m.leaves()) == 0 {
+		return nil
+	}
+	layouts := []*Model{m}
+	for _, i := range path[:len(path)-1] {
+		sub, ok := layouts[len(layouts)-1].children[i].Model.(*Model)
+		if !ok {
+			break
+		}
+		layouts = append(layouts, sub)
+	}
+
+	for depth := len(layouts) - 1; depth >= 0; depth-- {
+		l := layouts[depth]
+		if l.dir != dir {
+			continue
+		}
+		for j := path[depth] + delta; j >= 0 && j < len(l.children); j += delta {
+			if leaves := childLeaves(l.children[j]); len(leaves) > 0 {
+				return m.SetFocus(append(append(slices.Clip(path[:depth]), j), leaves[0]...)...)
+			}
+		}
+	}
+	return nil
+}
+
+// Canceled returns the canceled flag, which is set instead of the quit flag by the quit key binding if the quit
+// policy is ui.CancelPrompt.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes all children and focuses the first focusable leaf.
+func (m *Model) Init() tea.Cmd {
+	var cmds []tea.Cmd
+	for _, c := range m.children {
+		cmds = append(cmds, c.Model.Init())
+	}
+	if m.nested {
+		return tea.Batch(cmds...)
+	}
+
+	// Components start focused, so all leaves but the first one are blurred.
+	leaves := m.leaves()
+	for _, p := range leaves[min(1, len(leaves)):] {
+		if f, ok := m.leaf(p).(focuser); ok {
+			f.Blur()
+		}
+	}
+	if len(leaves) > 0 {
+		cmds = append(cmds, m.SetFocus(leaves[0]...))
+	}
+	return tea.Batch(cmds...)
+}
+
+// leaf returns the leaf with the given path.
+func (m *Model) leaf(path []int) tea.Model {
+	l := m
+	for _, i := range path {
+		c := l.children[i].Model
+		sub, ok := c.(*Model)
+		if !ok {
+			return c
+		}
+		l = sub
+	}
+	return nil
+}
+
+// sizes distributes the given number of cells along the direction of the layout: fixed children get their size as
+// far as it is available, the rest is shared among the flexible children in proportion to their weights.
+func (m *Model) sizes(total int) []int {
+	sizes := make([]int, len(m.children))
+	free := total - m.gap*max(0, len(m.children)-1)
+	weights := 0
+	for i, c := range m.children {
+		if c.Size > 0 {
+			sizes[i] = min(c.Size, max(0, free))
+			free -= sizes[i]
+		} else {
+			weights += c.weight()
+		}
+	}
+	if weights == 0 || free <= 0 {
+		return sizes
+	}
+
+	rest := free
+	for i, c := range m.children {
+		if c.Size <= 0 {
+			sizes[i] = free * c.weight() / weights
+			rest -= sizes[i]
+		}
+	}
+	// Hand out the cells lost by rounding down one by one, starting with the first flexible child.
+	for i := 0; rest > 0; i = (i + 1) % len(m.children) {
+		if m.children[i].Size <= 0 {
+			sizes[i]++
+			rest--
+		}
+	}
+	return sizes
+}
+
+// areas returns the width and height of the area of each child, excluding its border.
+func (m *Model) areas() [][2]int {
+	frame := 0
+	if m.border {
+		frame = 2
+	}
+	areas := make([][2]int, len(m.children))
+	if m.dir == Horizontal {
+		for i, w := range m.sizes(m.width) {
+			areas[i] = [2]int{max(0, w-frame), max(0, m.height-frame)}
+		}
+	} else {
+		for i, h := range m.sizes(m.height) {
+			areas[i] = [2]int{max(0, m.width-frame), max(0, h-frame)}
+		}
+	}
+	return areas
+}
+
+// resize passes the size of its area to each child.
+func (m *Model) resize() tea.Cmd {
+	var cmds []tea.Cmd
+	for i, area := range m.areas() {
+		cmds = append(cmds, m.updateChild(i, tea.WindowSizeMsg{Width: area[0], Height: area[1]}))
+	}
+	return tea.Batch(cmds...)
+}
+
+// updateChild passes the given message to the child with the given index.
+func (m *Model) updateChild(i int, msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	m.children[i].Model, cmd = m.children[i].Model.Update(msg)
+	return cmd
+}
+
+// Update slices window size messages among the children, routes the focus and passes key messages to the focused
+// child and all other messages to all children.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+	}
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, m.resize()
+	case tea.KeyMsg:
+		if !m.nested {
+			switch {
+			case key.Matches(msg, m.keymap.Quit):
+				if m.quitable {
+					m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+					return m, tea.Quit
+				}
+				return m, nil
+			case key.Matches(msg, nextKey):
+				return m, m.FocusNext()
+			case key.Matches(msg, prevKey):
+				return m, m.FocusPrev()
+			case key.Matches(msg, leftKey):
+				return m, m.move(Horizontal, -1)
+			case key.Matches(msg, rightKey):
+				return m, m.move(Horizontal, 1)
+			case key.Matches(msg, upKey):
+				return m, m.move(Vertical, -1)
+			case key.Matches(msg, downKey):
+				return m, m.move(Vertical, 1)
+			}
+		}
+		if m.focus < 0 {
+			return m, nil
+		}
+		return m, m.updateChild(m.focus, msg)
+	}
+
+	var cmds []tea.Cmd
+	for i := range m.children {
+		cmds = append(cmds, m.updateChild(i, msg))
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// box renders the view of the child with the given index, cut and padded to the given area if its size is known,
+// and surrounded by a border if enabled.
+func (m *Model) box(i int, area [2]int) string {
+	view := m.children[i].Model.View()
+	if m.width > 0 && m.height > 0 {
+		view = lipgloss.NewStyle().MaxWidth(area[0]).MaxHeight(area[1]).Render(view)
+		view = lipgloss.NewStyle().Width(area[0]).Height(area[1]).Render(view)
+	}
+	if !m.border {
+		return view
+	}
+	style := m.styles.Border
+	if m.focus == i {
+		style = m.styles.FocusedBorder
+	}
+	return style.Border(ui.Glyphs().Border).Render(view)
+}
+
+// View renders the children in their areas, arranged in the direction of the layout.
+func (m *Model) View() string {
+	areas := m.areas()
+	var parts []string
+	for i := range m.children {
+		if i > 0 && m.gap > 0 {
+			if m.dir == Horizontal {
+				parts = append(parts, strings.Repeat(" ", m.gap))
+			} else {
+				parts = append(parts, strings.Repeat("\n", m.gap-1))
+			}
+		}
+		parts = append(parts, m.box(i, areas[i]))
+	}
+	if m.dir == Horizontal {
+		return lipgloss.JoinHorizontal(lipgloss.Top, parts...)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, parts...)
+}
+
+// Run runs the layout until the user quits it or a child quits the program. Quitting the layout with the quit key
+// binding is reported as ui.QuitError, or as ui.CanceledError depending on the quit policy.
+func (m *Model) Run(ctx context.Context) error {
+	return ui.RunContext(ctx, m, m.programOptions...)
+}
+
+// Showcase demonstrates the Model component with a list, a form column and a gauge in the alternate screen.
+func Showcase() {
+	fmt.Println("=== Layout Showcase ===")
+
+	services := pick.New([]string{"api", "web", "worker", "scheduler"}).WithLabel("Service").WithEmbedded(true)
+	replicas := input.New("Replicas: ", "3").WithEmbedded(true)
+	note := input.New("Note: ", "").WithEmbedded(true)
+	load := gauge.New("Load").WithValue(64).WithWidth(20)
+
+	m := Row(
+		Flex(1, services),
+		Flex(2, Column(
+			Fixed(3, replicas),
+			Fixed(3, note),
+			Flex(1, load).Static(),
+		)),
+	).WithBorder(true).WithProgramOptions(tea.WithAltScreen())
+
+	// The layout has no answer of its own; it is left with ctrl+c, after which the children are read.
+	fmt.Println("tab and ctrl+arrows move the focus, ctrl+c finishes")
+	if err := m.Run(context.Background()); err != nil && !errors.Is(err, ui.QuitError) {
+		_ = ui.Handle(err, ui.HandleOptions{})
+		return
+	}
+	fmt.Printf("Service: %s, replicas: %s, note: %q\n", services.SelectedItem(), replicas.Value(), note.Value())
+}
@@ -0,0 +1,32 @@
+package layout
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model. The borders use the border of ui.Glyphs.
+type Styles struct {
+	Border        lipgloss.Style // Border is the style of the borders of children without focus.
+	FocusedBorder lipgloss.Style // FocusedBorder is the style of the border of the child holding the focus.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Border:        lipgloss.NewStyle().BorderForeground(lipgloss.Color("240")),
+		FocusedBorder: lipgloss.NewStyle().BorderForeground(t.Accent),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
@@ -0,0 +1,105 @@
+package ui
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"runtime"
+	"sync/atomic"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+const (
+	legacyAuto int32 = iota
+	legacyOff
+	legacyOn
+)
+
+var legacyMode atomic.Int32
+
+// SetLegacyConsole enables or disables the compatibility mode for legacy Windows consoles (conhost, as used by the
+// default cmd.exe), overriding the detection. In this mode, components use ASCIIGlyphs, colors are limited to the 16
+// ANSI colors and home/end key sequences not recognized otherwise are translated.
+func SetLegacyConsole(enabled bool) {
+	if enabled {
+		legacyMode.Store(legacyOn)
+	} else {
+		legacyMode.Store(legacyOff)
+	}
+}
+
+// LegacyConsole returns whether the compatibility mode for legacy Windows consoles is enabled. Unless set using
+// SetLegacyConsole, the mode is enabled if the environment variable UI_LEGACY_CONSOLE is set to a true value or if
+// running on Windows outside of a terminal known to support virtual terminal sequences.
+func LegacyConsole() bool {
+	switch legacyMode.Load() {
+	case legacyOn:
+		return true
+	case legacyOff:
+		return false
+	}
+	return DetectCapabilities().Legacy
+}
+
+// detectLegacyConsole reports whether the program appears to run in a legacy Windows console.
+func detectLegacyConsole() bool {
+	if envBool("UI_LEGACY_CONSOLE") {
+		return true
+	}
+	if runtime.GOOS != "windows" {
+		return false
+	}
+	for _, name := range []string{"WT_SESSION", "TERM_PROGRAM", "ANSICON", "TERM"} {
+		if os.Getenv(name) != "" {
+			return false
+		}
+	}
+	return os.Getenv("ConEmuANSI") != "ON"
+}
+
+// legacyOptions returns the program options applying the compatibility mode for legacy consoles, if enabled.
+func legacyOptions(s *settings) []tea.ProgramOption {
+	if !LegacyConsole() {
+		return nil
+	}
+	// Lower profiles support more colors.
+	if lipgloss.ColorProfile() < termenv.ANSI {
+		lipgloss.SetColorProfile(termenv.ANSI)
+	}
+	// Console input is read as key events by bubbletea and needs no translation.
+	if f, ok := s.input.(*os.File); ok && (f.Fd() == os.Stdin.Fd() || f.Name() == ttyPath) {
+		return nil
+	}
+	return []tea.ProgramOption{tea.WithInput(&legacyReader{r: s.input})}
+}
+
+// legacyKeys maps key sequences sent by legacy terminals to ones recognized by bubbletea. Both have the same length,
+// so that they can be replaced in place.
+var legacyKeys = [][2][]byte{
+	{[]byte("\x1bOH"), []byte("\x1b[H")}, // home
+	{[]byte("\x1bOF"), []byte("\x1b[F")}, // end
+}
+
+// legacyReader translates the key sequences of legacy terminals.
+type legacyReader struct {
+	r io.Reader
+}
+
+// Read implements io.Reader.
+func (l *legacyReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	for _, k := range legacyKeys {
+		for buf := p[:n]; ; {
+			i := bytes.Index(buf, k[0])
+			if i < 0 {
+				break
+			}
+			copy(buf[i:], k[1])
+			buf = buf[i+len(k[1]):]
+		}
+	}
+	return n, err
+}
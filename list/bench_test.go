@@ -0,0 +1,18 @@
+package list
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui/internal/benchview"
+)
+
+func BenchmarkView1000(b *testing.B) {
+	benchview.Run(b, func() tea.Model {
+		var items []*Item
+		for _, s := range benchview.Items(1000) {
+			items = append(items, NewItem(s, "description"))
+		}
+		return New(items...)
+	})
+}
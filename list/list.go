@@ -1,18 +1,24 @@
 package list
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"os"
+	"io"
+	"strings"
 
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
 	"github.com/charmbracelet/bubbles/list"  // Provides list model
 	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
 	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
 	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/clipboard"
+	"github.com/nmeilick/go-ui/errorview"
+	"github.com/nmeilick/go-ui/internal/answer"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/styles"
 )
 
-var docStyle = lipgloss.NewStyle().Margin(1, 2)
-
 // Item represents an item in the list.
 type Item struct {
 	title string // title is the title of the list item.
@@ -36,12 +42,27 @@ func NewItem(title, desc string) *Item {
 	return &Item{title: title, desc: desc}
 }
 
+var _ ui.Prompt[*Item] = (*Model)(nil)
+
 // Model represents the list model.
 type Model struct {
-	List        list.Model // List is the list model.
-	selectedIdx int        // Selected is the index of the currently selected list item.
-	cancelable  bool       // cancelable determines if selection can be canceled with escape key
-	quitable    bool       // quitable determines if execution can be quit via ctrl+c
+	List           list.Model          // List is the list model.
+	selectedIdx    int                 // Selected is the index of the currently selected list item.
+	cancelable     bool                // cancelable determines if selection can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	id             string              // id identifies the prompt, e.g. for preset answers
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                // focused determines if the model handles key messages
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	zones          ui.Zones            // zones marks the items, so they can be clicked.
+	err            error               // err is shown below the model, e.g. why the previous answer was rejected
+	errview        errorview.Model     // errview renders the error.
+	width          int                 // width is the width available for the list, 0 if unknown.
+	height         int                 // height is the height available for the list.
+	wrapTitle      bool                // wrapTitle is set if the title is wrapped above the list instead of shown by it
 
 	canceled bool // canceled indicates whether the selection was canceled
 	quit     bool // quit indicates whether the selection was quit
@@ -54,52 +75,156 @@ func New(items ...*Item) *Model {
 		listItems = append(listItems, i)
 	}
 	l := list.New(listItems, list.NewDefaultDelegate(), 0, 0)
-	return &Model{
+	km := ui.DefaultKeyMap()
+	l.KeyMap.CursorUp, l.KeyMap.CursorDown = km.Prev, km.Next
+	// Canceling and quitting is handled by the model, the list would quit without setting any flag.
+	l.KeyMap.Quit.SetEnabled(false)
+	l.KeyMap.ForceQuit.SetEnabled(false)
+	l.SetShowHelp(ui.HelpShown())
+	m := &Model{
 		List:       l,
 		cancelable: true,
 		quitable:   true,
+		focused:    true,
+		keymap:     km,
+		errview:    *errorview.New(nil),
+		zones:      ui.NewZones(),
 	}
+	m.setStyles(DefaultStyles())
+	return m
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
 }
 
 // WithItems sets the list items and returns a new Model with the updated items.
 func (m *Model) WithItems(items ...list.Item) *Model {
-	newModel := *m
-	newModel.List.SetItems(items)
-	return &newModel
+	return m.With(ListItems(items...))
 }
 
 // WithSelectedIndex sets the index of the initially selected item and returns a new Model with the updated selected index.
 func (m *Model) WithSelectedIndex(i int) *Model {
-	if i < 0 {
-		i = 0
-	} else if i > len(m.List.Items())-1 {
-		i = len(m.List.Items()) - 1
-	}
-	newModel := *m
-	newModel.List.Select(i)
-	return &newModel
+	return m.With(SelectedIndex(i))
 }
 
 // WithCancel sets the cancelable flag and returns a new Model with the updated flag.
 func (m *Model) WithCancel(cancelable bool) *Model {
-	newModel := *m
-	newModel.cancelable = cancelable
-	return &newModel
+	return m.With(Cancel(cancelable))
 }
 
 // WithQuit sets the quitable flag and returns a new Model with the updated flag.
 func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
 	newModel := *m
-	newModel.quitable = quitable
+	newModel.embedded = embedded
 	return &newModel
 }
 
+// WithShowHelp sets whether the help footer is shown, overriding the package-wide setting of ui.ShowHelp, and
+// returns a new Model with the updated flag.
+func (m *Model) WithShowHelp(show bool) *Model {
+	return m.With(ShowHelp(show))
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
 // WithTitle sets the list title and returns a new Model with the updated flag.
 func (m *Model) WithTitle(title string) *Model {
-	newModel := *m
-	newModel.List.Title = title
-	newModel.List.SetShowTitle(title != "")
-	return &newModel
+	return m.With(Title(title))
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.List.Title
+}
+
+// SetAnswer applies a preset answer, which is the index or the title of the item. It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	var titles []string
+	for _, item := range m.List.Items() {
+		titles = append(titles, item.FilterValue())
+	}
+	idx, err := answer.Index(v, titles)
+	if err != nil {
+		return err
+	}
+	m.List.Select(idx)
+	m.selectedIdx = idx
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the title of the selected item. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	if item := m.SelectedItem(); item != nil {
+		return item.Title()
+	}
+	return nil
+}
+
+// Choices returns the titles of the items. It implements ui.ChoiceModel.
+func (m *Model) Choices() []string {
+	var titles []string
+	for _, item := range m.List.Items() {
+		if item, ok := item.(*Item); ok {
+			titles = append(titles, item.Title())
+		}
+	}
+	return titles
+}
+
+// Focus focuses the model, so that it handles key messages. It returns no command and exists for compatibility with
+// other focusable models.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
 }
 
 // Canceled returns the canceled flag.
@@ -117,34 +242,89 @@ func (m *Model) Init() tea.Cmd {
 	return nil
 }
 
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
 // Update handles user input and updates the list state by processing key messages and updating the selected item accordingly.
+// With mouse support, clicking an item selects it, clicking the selected item chooses it, and the wheel moves the
+// selection.
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case ui.ThemeMsg:
+		m.restyle(msg.Theme)
+		return m, nil
+	case ui.MouseMsg:
+		if !m.Focused() || m.List.FilterState() == list.Filtering {
+			return m, nil
+		}
+		switch d := msg.Wheel(); {
+		case d < 0:
+			m.List.CursorUp()
+		case d > 0:
+			m.List.CursorDown()
+		}
+		if i, ok := m.zones.Item(msg); ok && msg.Clicked() {
+			if i == m.List.Index() {
+				m.canceled, m.quit = false, false
+				m.selectedIdx = i
+				return m, m.done()
+			}
+			m.List.Select(i)
+		}
+		return m, nil
 	case tea.KeyMsg:
+		if !m.Focused() || m.errview.Toggle(msg, m.err) {
+			return m, nil
+		}
 		if m.List.FilterState() == list.Filtering {
 			break
 		}
-		switch msg.String() {
-		case "enter":
+		switch {
+		case key.Matches(msg, m.keymap.Confirm):
 			m.canceled, m.quit = false, false
 			m.selectedIdx = m.List.Index()
-			return m, tea.Quit
-		case "esc":
+			return m, m.done()
+		case key.Matches(msg, m.keymap.Cancel):
 			if m.cancelable {
 				m.selectedIdx = -1
 				m.canceled, m.quit = true, false
-				return m, tea.Quit
+				return m, m.done()
 			}
-		case "ctrl+c":
+		case key.Matches(msg, m.keymap.Quit):
 			if m.quitable {
 				m.selectedIdx = -1
-				m.canceled, m.quit = true, true
-				return m, tea.Quit
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, m.done()
 			}
+		case key.Matches(msg, m.keymap.Copy):
+			if item := m.SelectedItem(); item != nil {
+				return m, clipboard.Copy(item.Title())
+			}
+		}
+	case clipboard.CopiedMsg:
+		if !m.Focused() {
+			return m, nil
+		}
+		if msg.Err != nil {
+			return m, m.List.NewStatusMessage(fmt.Sprintf("Copying failed: %v", msg.Err))
 		}
+		return m, m.List.NewStatusMessage(fmt.Sprintf("Copied %q", msg.Text))
 	case tea.WindowSizeMsg:
-		h, v := docStyle.GetFrameSize()
-		m.List.SetSize(msg.Width-h, msg.Height-v)
+		h, v := m.styles.Document.GetFrameSize()
+		m.width, m.height = msg.Width-h, msg.Height-v
+		m.errview.Update(tea.WindowSizeMsg{Width: m.width, Height: m.height})
+		m.resize()
 	}
 
 	var cmd tea.Cmd
@@ -160,9 +340,93 @@ func (m *Model) SelectedItem() *Item {
 	return nil
 }
 
+// Run runs the model and returns the selected item. It implements ui.Prompt[*Item].
+func (m *Model) Run(ctx context.Context) (*Item, error) {
+	if err := ui.RunContext(ctx, m, append([]tea.ProgramOption{tea.WithAltScreen()}, m.programOptions...)...); err != nil {
+		return nil, err
+	}
+	return m.SelectedItem(), nil
+}
+
+// RunAccessible asks to select an item from a numbered list instead of using the terminal UI. It implements
+// ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	var choices []string
+	for _, item := range m.List.Items() {
+		choice := item.FilterValue()
+		if i, ok := item.(list.DefaultItem); ok && i.Description() != "" {
+			choice = fmt.Sprintf("%s - %s", i.Title(), i.Description())
+		}
+		choices = append(choices, choice)
+	}
+
+	var label string
+	if m.List.ShowTitle() || m.wrapTitle {
+		label = m.List.Title
+	}
+	idx, err := plain.New(in, out).Choice(label, choices, m.List.Index())
+	switch {
+	case errors.Is(err, io.EOF):
+		m.selectedIdx = -1
+		m.canceled, m.quit = true, false
+		return nil
+	case err != nil:
+		return err
+	}
+	m.List.Select(idx)
+	m.selectedIdx = idx
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// resize fits the list into the available size. A title that is too wide for a single line or spans multiple lines
+// is wrapped and shown above the list, which only shows single-line titles.
+func (m *Model) resize() {
+	if m.width <= 0 {
+		return
+	}
+	shown := m.List.Title != "" && (m.List.ShowTitle() || m.wrapTitle)
+	m.wrapTitle = shown && (strings.Contains(m.List.Title, "\n") ||
+		lipgloss.Width(m.styles.List.TitleBar.Render(m.styles.List.Title.Render(m.List.Title))) > m.width)
+	m.List.SetShowTitle(shown && !m.wrapTitle)
+	height := m.height
+	if m.wrapTitle {
+		height -= lipgloss.Height(m.titleView())
+	}
+	m.List.SetSize(m.width, max(1, height))
+}
+
+// titleView renders the title wrapped at the available width, styled like the title of the list.
+func (m *Model) titleView() string {
+	title := m.styles.List.Title
+	width := styles.InnerWidth(title, styles.InnerWidth(m.styles.List.TitleBar, m.width))
+	return m.styles.List.TitleBar.Render(title.Render(styles.Wrap(m.List.Title, width)))
+}
+
 // View renders the list as a string, displaying the list items with their respective styles.
 func (m Model) View() string {
-	return docStyle.Render(m.List.View())
+	view := m.List.View()
+	if m.wrapTitle {
+		view = m.titleView() + "\n" + view
+	}
+	if m.err != nil {
+		view += "\n" + m.errview.RenderWith(m.styles.Error, m.err)
+	}
+	return m.styles.Document.Render(view)
+}
+
+// Choose asks to choose one of the given items and returns it or an error. The options are passed to the program
+// running the model.
+// Use errors.Is(ui.CanceledError) or errors.Is(ui.QuitError) to determine if the selection was canceled or aborting of
+// the program was requested.
+func Choose(title string, items []*Item, opts ...tea.ProgramOption) (*Item, error) {
+	return ChooseContext(context.Background(), title, items, opts...)
+}
+
+// ChooseContext is like Choose, but aborts the selection when the context is done. ui.TimeoutError is returned if its
+// deadline expired.
+func ChooseContext(ctx context.Context, title string, items []*Item, opts ...tea.ProgramOption) (*Item, error) {
+	return New(items...).WithTitle(title).WithProgramOptions(opts...).Run(ctx)
 }
 
 // Showcase demonstrates all features of the Model component by creating a list model with some items and running an interactive example in the terminal.
@@ -178,16 +442,7 @@ func Showcase() {
 	fmt.Println("=== List Showcase ===")
 
 	fmt.Println("\nDefault List (Use arrow keys to navigate, Enter to select):")
-	err := ui.Run(m, tea.WithAltScreen())
-	switch {
-	case errors.Is(err, ui.QuitError):
-		fmt.Println("Quit")
-		os.Exit(0)
-	case errors.Is(err, ui.CanceledError):
-		fmt.Println("Canceled")
-	case err != nil:
-		fmt.Printf("Error running program: %v", err)
-	default:
+	if err := ui.Handle(ui.Run(m, tea.WithAltScreen()), ui.HandleOptions{}); err == nil {
 		fmt.Printf("Selected item: %s\n", m.SelectedItem().Title())
 	}
 }
@@ -0,0 +1,109 @@
+package list
+
+import (
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.setStyles(styles)
+	}
+}
+
+// ListItems sets the list items.
+func ListItems(items ...list.Item) Option {
+	return func(m *Model) {
+		m.List.SetItems(items)
+	}
+}
+
+// SelectedIndex sets the index of the initially selected item.
+func SelectedIndex(i int) Option {
+	return func(m *Model) {
+		if i < 0 {
+			i = 0
+		} else if i > len(m.List.Items())-1 {
+			i = len(m.List.Items()) - 1
+		}
+		m.List.Select(i)
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// ShowHelp sets whether the help footer is shown, overriding the package-wide setting of ui.ShowHelp.
+func ShowHelp(show bool) Option {
+	return func(m *Model) {
+		m.List.SetShowHelp(show)
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+		m.errview = *m.errview.WithKeyMap(km)
+		m.List.KeyMap.CursorUp, m.List.KeyMap.CursorDown = km.Prev, km.Next
+	}
+}
+
+// Title sets the list title.
+func Title(title string) Option {
+	return func(m *Model) {
+		m.List.Title = title
+		m.List.SetShowTitle(title != "")
+		m.wrapTitle = false
+		m.resize()
+	}
+}
@@ -0,0 +1,80 @@
+package list
+
+import (
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help" // Provides help view for key bindings
+	"github.com/charmbracelet/bubbles/list" // Provides list model
+	"github.com/charmbracelet/lipgloss"     // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/errorview"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Document lipgloss.Style         // Document is the style of the whole model, e.g. its margin.
+	List     list.Styles            // List holds the styles of the list, like the title and the status bar.
+	Item     list.DefaultItemStyles // Item holds the styles of the items.
+	Help     help.Styles            // Help holds the styles of the help view.
+	Error    lipgloss.Style         // Error is the style of the error shown below the list.
+}
+
+// DefaultStyles returns the default styles of the list, with errors styled like ui.RenderError.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the styles of the model in the given theme: those of DefaultStyles with errors in the color of
+// the theme, and, if the theme sets its styles, the title, items, filter prompt, cursor and help styled by them.
+func ThemedStyles(t ui.Theme) Styles {
+	styles := Styles{
+		Document: lipgloss.NewStyle().Margin(1, 2),
+		List:     list.DefaultStyles(),
+		Item:     list.NewDefaultItemStyles(),
+		Help:     help.New().Styles,
+		Error:    t.ErrorStyle(),
+	}
+	if s := t.Styles; s != nil {
+		styles.List.Title = s.Label
+		styles.List.FilterPrompt = s.Prompt
+		styles.List.FilterCursor = s.Cursor
+		styles.Item.SelectedTitle = s.Selected
+		styles.Item.NormalTitle = s.Normal
+		styles.Help = s.Help
+	}
+	return styles
+}
+
+// setStyles sets the styles of the model and applies them to the list.
+func (m *Model) setStyles(styles Styles) {
+	m.styles = styles
+	m.List.Styles = styles.List
+	m.List.FilterInput.PromptStyle = styles.List.FilterPrompt
+	m.List.FilterInput.Cursor.Style = styles.List.FilterCursor
+	m.List.Help.Styles = styles.Help
+	d := list.NewDefaultDelegate()
+	d.Styles = styles.Item
+	m.List.SetDelegate(zoneDelegate{DefaultDelegate: d, zones: m.zones})
+}
+
+// zoneDelegate renders the items like the default delegate and marks them, so they can be clicked.
+type zoneDelegate struct {
+	list.DefaultDelegate
+	zones ui.Zones // zones marks the items.
+}
+
+// Render renders the item with the given index of the visible items.
+func (d zoneDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	var b strings.Builder
+	d.DefaultDelegate.Render(&b, m, index, item)
+	_, _ = io.WriteString(w, d.zones.MarkItem(index, b.String()))
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.setStyles(ThemedStyles(t))
+	}
+	m.errview = *m.errview.WithStyles(errorview.ThemedStyles(t))
+}
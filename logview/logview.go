@@ -0,0 +1,673 @@
+package logview
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/pager"
+)
+
+var (
+	pauseKey  = key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "pause"))
+	followKey = key.NewBinding(key.WithKeys("F"), key.WithHelp("F", "follow"))
+	levelKey  = key.NewBinding(key.WithKeys("L"), key.WithHelp("L", "minimum level"))
+	filterKey = key.NewBinding(key.WithKeys("&"), key.WithHelp("&", "filter"))
+)
+
+// maxBatch is the maximum number of lines added at once.
+const maxBatch = 1000
+
+// Level is the severity of a log line.
+type Level int
+
+const (
+	Unknown Level = iota // Unknown is the level of lines without recognizable level.
+	Debug                // Debug is the level of debug and trace messages.
+	Info                 // Info is the level of informational messages.
+	Warn                 // Warn is the level of warnings.
+	Error                // Error is the level of errors, including fatal errors and panics.
+)
+
+// String returns the name of the level.
+func (l Level) String() string {
+	switch l {
+	case Debug:
+		return "debug"
+	case Info:
+		return "info"
+	case Warn:
+		return "warn"
+	case Error:
+		return "error"
+	}
+	return "unknown"
+}
+
+// levelPattern matches the usual level names, e.g. "ERROR", "level=warn" or "[DBG]".
+var levelPattern = regexp.MustCompile(
+	`(?i)\b(trace|debug|dbg|info|inf|warn|warning|wrn|error|err|fatal|panic|crit|critical)\b`)
+
+// DetectLevel returns the level of a log line, determined by the first level name in it, e.g. "ERROR",
+// "level=warn" or "[DBG]".
+func DetectLevel(line string) Level {
+	switch strings.ToLower(levelPattern.FindString(line)) {
+	case "trace", "debug", "dbg":
+		return Debug
+	case "info", "inf":
+		return Info
+	case "warn", "warning", "wrn":
+		return Warn
+	case "error", "err", "fatal", "panic", "crit", "critical":
+		return Error
+	}
+	return Unknown
+}
+
+// Model represents an interactive log viewer, the equivalent of tail -f piped to grep. Lines are read from a reader
+// or received from a channel and shown in a pager, colored by level. The view follows new lines unless it is paused
+// or scrolled up, and the lines can be filtered by level and text, and searched.
+type Model struct {
+	title          string                  // title is shown in the header line.
+	reader         io.Reader               // reader is the reader the lines are read from, if set.
+	channel        <-chan string           // channel is the channel the lines are received from, if set.
+	lines          []string                // lines holds the received lines, up to maxLines.
+	levels         []Level                 // levels holds the level of each line.
+	maxLines       int                     // maxLines is the maximum number of lines kept.
+	levelFunc      func(line string) Level // levelFunc detects the level of a line.
+	minLevel       Level                   // minLevel is the minimum level of the shown lines.
+	filter         string                  // filter is the text the shown lines must contain.
+	filterPattern  *regexp.Regexp          // filterPattern matches the filter, nil if there is none.
+	filtering      bool                    // filtering determines if a filter is being entered.
+	query          string                  // query is the filter being entered.
+	follow         bool                    // follow determines if the view follows new lines.
+	paused         bool                    // paused determines if new lines are held back.
+	pending        int                     // pending is the number of lines received while paused.
+	ended          bool                    // ended determines if the input ended.
+	wrap           bool                    // wrap determines if long lines are wrapped.
+	height         int                     // height is the number of visible lines, 0 to fill the window.
+	view           *pager.Model            // view is the pager showing the lines, created on first use.
+	feed           <-chan string           // feed delivers the lines while running.
+	readErr        chan error              // readErr receives the error of the reader once the feed is closed.
+	stop           chan struct{}           // stop is closed to stop reading when the model finished.
+	quitable       bool                    // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption     // programOptions are passed to the program running the model
+	embedded       bool                    // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                    // focused determines if the model handles key messages
+	keymap         ui.KeyMap               // keymap holds the key bindings of the model.
+	styles         Styles                  // styles holds the styles of the model.
+	customStyles   bool                    // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error                   // err is shown below the lines, e.g. a read error
+
+	canceled bool // canceled indicates whether the viewer was canceled
+	quit     bool // quit indicates whether the viewer was quit
+}
+
+// New creates and returns a new Model with the given title, configured by the given options. Use the Reader or
+// Channel option to set the source of the lines.
+func New(title string, opts ...Option) *Model {
+	m := &Model{
+		title:     title,
+		maxLines:  10000,
+		levelFunc: DetectLevel,
+		follow:    true,
+		quitable:  true,
+		focused:   true,
+		keymap:    ui.DefaultKeyMap(),
+		styles:    DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithTitle sets the title of the Model and returns a new Model with the updated title.
+func (m *Model) WithTitle(title string) *Model {
+	return m.With(Title(title))
+}
+
+// WithReader sets the reader the lines are read from and returns a new Model with the updated source.
+func (m *Model) WithReader(r io.Reader) *Model {
+	return m.With(Reader(r))
+}
+
+// WithChannel sets the channel the lines are received from and returns a new Model with the updated source.
+func (m *Model) WithChannel(ch <-chan string) *Model {
+	return m.With(Channel(ch))
+}
+
+// WithFollow sets whether the view follows new lines and returns a new Model with the updated flag.
+func (m *Model) WithFollow(follow bool) *Model {
+	return m.With(Follow(follow))
+}
+
+// WithMinLevel sets the minimum level of the shown lines and returns a new Model with the updated level.
+func (m *Model) WithMinLevel(level Level) *Model {
+	return m.With(MinLevel(level))
+}
+
+// WithFilter sets the text the shown lines must contain and returns a new Model with the updated filter.
+func (m *Model) WithFilter(text string) *Model {
+	return m.With(Filter(text))
+}
+
+// WithLevelFunc sets the function detecting the level of a line and returns a new Model with the updated function.
+func (m *Model) WithLevelFunc(fn func(line string) Level) *Model {
+	return m.With(LevelFunc(fn))
+}
+
+// WithMaxLines sets the maximum number of lines kept and returns a new Model with the updated limit.
+func (m *Model) WithMaxLines(n int) *Model {
+	return m.With(MaxLines(n))
+}
+
+// WithWrap sets whether long lines are wrapped and returns a new Model with the updated flag.
+func (m *Model) WithWrap(wrap bool) *Model {
+	return m.With(Wrap(wrap))
+}
+
+// WithHeight sets the number of visible lines, 0 to fill the window, and returns a new Model with the updated
+// height.
+func (m *Model) WithHeight(height int) *Model {
+	return m.With(Height(height))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Lines returns all received lines that were kept, regardless of the filters.
+func (m *Model) Lines() []string {
+	return m.lines
+}
+
+// setFilter sets the text the shown lines must contain, compiling the pattern matching it.
+func (m *Model) setFilter(text string) {
+	m.filter, m.filterPattern = text, nil
+	if text == "" {
+		return
+	}
+	expr := regexp.QuoteMeta(text)
+	if strings.IndexFunc(text, unicode.IsUpper) < 0 {
+		expr = "(?i)" + expr
+	}
+	m.filterPattern = regexp.MustCompile(expr)
+}
+
+// pager returns the pager showing the lines, creating it on first use.
+func (m *Model) pager() *pager.Model {
+	if m.view == nil {
+		m.view = pager.New("", "", pager.Embedded(), pager.Wrap(m.wrap), pager.Height(m.height),
+			pager.Quit(m.quitable), pager.KeyMap(m.keymap), pager.Styled(m.styles.Pager), pager.LineStyle(m.lineStyle))
+		m.refresh()
+	}
+	return m.view
+}
+
+// lineStyle returns the style of a line according to its level.
+func (m *Model) lineStyle(line string) lipgloss.Style {
+	switch m.levelFunc(line) {
+	case Debug:
+		return m.styles.DebugLine
+	case Warn:
+		return m.styles.WarnLine
+	case Error:
+		return m.styles.ErrorLine
+	}
+	return m.styles.InfoLine
+}
+
+// shown returns whether the line with the given index passes the filters.
+func (m *Model) shown(i int) bool {
+	if m.minLevel != Unknown && m.levels[i] < m.minLevel {
+		return false
+	}
+	return m.filterPattern == nil || m.filterPattern.MatchString(m.lines[i])
+}
+
+// refresh shows the lines passing the filters, e.g. after the filters changed.
+func (m *Model) refresh() {
+	var lines []string
+	for i := range m.lines {
+		if m.shown(i) {
+			lines = append(lines, m.lines[i])
+		}
+	}
+	m.view.SetLines(lines)
+	m.pending = 0
+	if m.follow {
+		m.view.GotoBottom()
+	}
+}
+
+// Append adds lines as if they were read from the source. It must not be called concurrently with the program
+// running the model; use the Channel option to add lines from other goroutines.
+func (m *Model) Append(lines ...string) {
+	p := m.pager()
+	start, trimmed := m.add(lines)
+	if trimmed {
+		if !m.paused {
+			m.refresh()
+		}
+		return
+	}
+	if m.paused {
+		m.pending += len(lines)
+		return
+	}
+	var shown []string
+	for i := start; i < len(m.lines); i++ {
+		if m.shown(i) {
+			shown = append(shown, m.lines[i])
+		}
+	}
+	p.AppendLines(shown...)
+	if m.follow {
+		p.GotoBottom()
+	}
+}
+
+// add stores the lines with their levels and returns the index of the first added line. Old lines are discarded in
+// chunks once there are more than maxLines, which is reported, as the shown lines have to be rebuilt then.
+func (m *Model) add(lines []string) (start int, trimmed bool) {
+	start = len(m.lines)
+	for _, line := range lines {
+		level := m.levelFunc(line)
+		if level == Unknown && len(m.levels) > 0 {
+			// Lines without level, e.g. stack traces, belong to the line before.
+			level = m.levels[len(m.levels)-1]
+		}
+		m.lines = append(m.lines, line)
+		m.levels = append(m.levels, level)
+	}
+	if m.maxLines > 0 && len(m.lines) > m.maxLines+m.maxLines/10 {
+		drop := len(m.lines) - m.maxLines
+		m.lines = append([]string(nil), m.lines[drop:]...)
+		m.levels = append([]Level(nil), m.levels[drop:]...)
+		return max(0, start-drop), true
+	}
+	return start, false
+}
+
+// SetPaused sets whether new lines are held back. Unpausing shows the lines received in the meantime.
+func (m *Model) SetPaused(paused bool) {
+	if m.paused == paused {
+		return
+	}
+	m.paused = paused
+	if !paused {
+		m.pager()
+		m.refresh()
+	}
+}
+
+// Paused returns whether new lines are held back.
+func (m *Model) Paused() bool {
+	return m.paused
+}
+
+// Following returns whether the view follows new lines.
+func (m *Model) Following() bool {
+	return m.follow
+}
+
+// Focus focuses the model, so that it handles key messages. It returns no command and exists for compatibility with
+// other focusable models.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the lines. It implements ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag. A log viewer cannot be canceled, it is only closed.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// linesMsg delivers lines from the source.
+type linesMsg struct {
+	model *Model
+	lines []string
+	eof   bool
+}
+
+// start starts reading lines from the source, if it was not started yet.
+func (m *Model) start() {
+	if m.feed != nil {
+		return
+	}
+	m.stop = make(chan struct{})
+	switch {
+	case m.reader != nil:
+		feed := make(chan string, maxBatch)
+		m.feed, m.readErr = feed, make(chan error, 1)
+		go func(r io.Reader, stop <-chan struct{}, errc chan<- error) {
+			defer close(feed)
+			sc := bufio.NewScanner(r)
+			sc.Buffer(make([]byte, 64*1024), 1024*1024)
+			for sc.Scan() {
+				select {
+				case feed <- sc.Text():
+				case <-stop:
+					errc <- nil
+					return
+				}
+			}
+			errc <- sc.Err()
+		}(m.reader, m.stop, m.readErr)
+	case m.channel != nil:
+		m.feed = m.channel
+	}
+}
+
+// wait returns the command waiting for the next lines from the source.
+func (m *Model) wait() tea.Cmd {
+	feed := m.feed
+	if feed == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		line, ok := <-feed
+		if !ok {
+			return linesMsg{model: m, eof: true}
+		}
+		lines := []string{line}
+		for len(lines) < maxBatch {
+			select {
+			case line, ok := <-feed:
+				if !ok {
+					return linesMsg{model: m, lines: lines, eof: true}
+				}
+				lines = append(lines, line)
+			default:
+				return linesMsg{model: m, lines: lines}
+			}
+		}
+		return linesMsg{model: m, lines: lines}
+	}
+}
+
+// Init starts reading lines from the source and returns the command waiting for them.
+func (m *Model) Init() tea.Cmd {
+	m.pager()
+	m.start()
+	return m.wait()
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode. Reading
+// from the source is stopped.
+func (m *Model) done() tea.Cmd {
+	if m.stop != nil {
+		close(m.stop)
+		m.stop = nil
+	}
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// updateFilter handles key messages while a filter is entered.
+func (m *Model) updateFilter(msg tea.KeyMsg) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.filtering = false
+		m.setFilter(m.query)
+		m.refresh()
+	case tea.KeyEsc:
+		m.filtering = false
+	case tea.KeyBackspace:
+		if m.query == "" {
+			m.filtering = false
+		} else {
+			r := []rune(m.query)
+			m.query = string(r[:len(r)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.query += string(msg.Runes)
+	}
+}
+
+// Update adds the lines from the source, handles the keys for pausing, following and filtering, and passes all other
+// messages to the pager. Scrolling to the end of the lines follows new lines, scrolling up stops following.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+	}
+	p := m.pager()
+	switch msg := msg.(type) {
+	case linesMsg:
+		if msg.model != m {
+			return m, nil
+		}
+		m.Append(msg.lines...)
+		if !msg.eof {
+			return m, m.wait()
+		}
+		m.ended = true
+		if m.readErr != nil {
+			if err := <-m.readErr; err != nil {
+				m.err = err
+			}
+		}
+		return m, nil
+	case pager.DoneMsg:
+		if msg.Model != p {
+			return m, nil
+		}
+		m.canceled, m.quit = p.Canceled(), p.Quit()
+		return m, m.done()
+	case tea.WindowSizeMsg:
+		msg.Height-- // header line
+		if m.err != nil {
+			msg.Height--
+		}
+		p.Update(msg)
+		return m, nil
+	case tea.KeyMsg:
+		if !m.focused {
+			return m, nil
+		}
+		if m.filtering {
+			if msg.Type == tea.KeyCtrlC {
+				break
+			}
+			m.updateFilter(msg)
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, pauseKey):
+			m.SetPaused(!m.paused)
+			return m, nil
+		case key.Matches(msg, followKey):
+			m.follow = !m.follow
+			if m.follow {
+				m.SetPaused(false)
+				p.GotoBottom()
+			}
+			return m, nil
+		case key.Matches(msg, levelKey):
+			m.minLevel = (m.minLevel + 1) % (Error + 1)
+			m.refresh()
+			return m, nil
+		case key.Matches(msg, filterKey):
+			m.filtering, m.query = true, m.filter
+			return m, nil
+		case key.Matches(msg, m.keymap.Cancel) && m.filter != "":
+			m.setFilter("")
+			m.refresh()
+			return m, nil
+		}
+		_, cmd := p.Update(msg)
+		m.follow = p.AtBottom()
+		return m, cmd
+	}
+	_, cmd := p.Update(msg)
+	return m, cmd
+}
+
+// header returns the header line: the title, the state and the active filters, or the filter prompt.
+func (m *Model) header() string {
+	var parts []string
+	if m.title != "" {
+		parts = append(parts, m.styles.Title.Render(m.title))
+	}
+	if m.filtering {
+		return strings.Join(append(parts, m.styles.Prompt.Render("filter: ")+m.query+m.styles.Prompt.Render("█")), "  ")
+	}
+
+	var state []string
+	switch {
+	case m.paused:
+		state = append(state, fmt.Sprintf("paused (%d new)", m.pending))
+	case m.ended:
+		state = append(state, "ended")
+	case m.follow:
+		state = append(state, "following")
+	}
+	if m.minLevel != Unknown {
+		state = append(state, "level "+m.minLevel.String()+"+")
+	}
+	if m.filter != "" {
+		state = append(state, fmt.Sprintf("filter %q", m.filter))
+	}
+	state = append(state, fmt.Sprintf("%d lines", len(m.lines)), "p pause · F follow · L level · & filter")
+	return strings.Join(append(parts, m.styles.Status.Render(strings.Join(state, " · "))), "  ")
+}
+
+// View renders the header line and the pager showing the lines.
+func (m *Model) View() string {
+	s := m.header() + "\n" + m.pager().View()
+	if m.err != nil {
+		s += "\n" + ui.RenderErrorWith(m.styles.Error, m.err)
+	}
+	return s
+}
+
+// Run shows the lines until the user closes the viewer. It returns ui.QuitError if quitting was requested.
+func (m *Model) Run(ctx context.Context) error {
+	defer func() {
+		if m.stop != nil {
+			close(m.stop)
+			m.stop = nil
+		}
+	}()
+	return ui.RunContext(ctx, m, m.programOptions...)
+}
+
+// RunAccessible writes the lines passing the filters to out as they arrive, until the input ends. It implements
+// ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	m.start()
+	if m.feed == nil {
+		return nil
+	}
+	for line := range m.feed {
+		m.add([]string{line})
+		if i := len(m.lines) - 1; m.shown(i) {
+			if _, err := fmt.Fprintln(out, line); err != nil {
+				return err
+			}
+		}
+	}
+	m.ended = true
+	m.canceled, m.quit = false, false
+	if m.readErr != nil {
+		return <-m.readErr
+	}
+	return nil
+}
+
+// Showcase demonstrates the Model component with simulated log output received from a channel.
+func Showcase() {
+	fmt.Println("=== Log Viewer Showcase ===")
+
+	ch := make(chan string)
+	go func() {
+		defer close(ch)
+		levels := []string{"DEBUG", "INFO", "INFO", "INFO", "WARN", "ERROR"}
+		for i := 1; i <= 300; i++ {
+			level := levels[i%len(levels)]
+			ch <- fmt.Sprintf("%s %-5s request %d handled by worker %d", time.Now().Format("15:04:05.000"), level, i,
+				i%4)
+			if level == "ERROR" {
+				ch <- "    at handler.go:42"
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+	}()
+
+	m := New("Simulated log").WithChannel(ch).WithHeight(15)
+	if err := m.Run(context.Background()); err != nil {
+		ui.Handle(err, ui.HandleOptions{})
+	}
+}
@@ -0,0 +1,130 @@
+package logview
+
+import (
+	"io"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Title sets the title shown in the header line.
+func Title(title string) Option {
+	return func(m *Model) {
+		m.title = title
+	}
+}
+
+// Reader sets the reader the lines are read from, e.g. a file or the output of a command. Reading stops at the end
+// of the input; the lines remain visible.
+func Reader(r io.Reader) Option {
+	return func(m *Model) {
+		m.reader = r
+	}
+}
+
+// Channel sets the channel the lines are received from. Receiving stops when the channel is closed.
+func Channel(ch <-chan string) Option {
+	return func(m *Model) {
+		m.channel = ch
+	}
+}
+
+// Follow sets whether the view follows new lines, like tail -f. It is enabled by default.
+func Follow(follow bool) Option {
+	return func(m *Model) {
+		m.follow = follow
+	}
+}
+
+// MinLevel sets the minimum level of the shown lines. Lines without level are shown if the line before them is.
+func MinLevel(level Level) Option {
+	return func(m *Model) {
+		m.minLevel = level
+	}
+}
+
+// Filter sets the text the shown lines must contain. It is matched ignoring case unless it contains upper-case
+// letters.
+func Filter(text string) Option {
+	return func(m *Model) {
+		m.setFilter(text)
+	}
+}
+
+// LevelFunc sets the function detecting the level of a line, overriding DetectLevel.
+func LevelFunc(fn func(line string) Level) Option {
+	return func(m *Model) {
+		m.levelFunc = fn
+	}
+}
+
+// MaxLines sets the maximum number of lines kept; older lines are discarded. The default is 10000.
+func MaxLines(n int) Option {
+	return func(m *Model) {
+		m.maxLines = n
+	}
+}
+
+// Wrap sets whether long lines are wrapped instead of scrolled horizontally.
+func Wrap(wrap bool) Option {
+	return func(m *Model) {
+		m.wrap = wrap
+	}
+}
+
+// Height sets the number of visible lines. If it is 0, which is the default, the view fills the terminal window.
+func Height(height int) Option {
+	return func(m *Model) {
+		m.height = height
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
@@ -0,0 +1,51 @@
+package logview
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/pager"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Title     lipgloss.Style // Title is the style of the title in the header line.
+	Status    lipgloss.Style // Status is the style of the state shown in the header line.
+	Prompt    lipgloss.Style // Prompt is the style of the filter prompt.
+	DebugLine lipgloss.Style // DebugLine is the style of lines with level debug.
+	InfoLine  lipgloss.Style // InfoLine is the style of lines with level info and lines without level.
+	WarnLine  lipgloss.Style // WarnLine is the style of lines with level warn.
+	ErrorLine lipgloss.Style // ErrorLine is the style of lines with level error.
+	Error     lipgloss.Style // Error is the style of the error shown below the lines, e.g. a read error.
+	Pager     pager.Styles   // Pager holds the styles of the pager showing the lines.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Title:     lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Status:    lipgloss.NewStyle().Faint(true),
+		Prompt:    lipgloss.NewStyle().Foreground(t.Accent),
+		DebugLine: lipgloss.NewStyle().Faint(true),
+		InfoLine:  lipgloss.NewStyle().Foreground(t.Text),
+		WarnLine:  lipgloss.NewStyle().Foreground(t.Label),
+		ErrorLine: lipgloss.NewStyle().Foreground(t.Failure).Bold(true),
+		Error:     t.ErrorStyle(),
+		Pager:     pager.ThemedStyles(t),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly, including those of the
+// pager if it was created already.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+		if m.view != nil {
+			pager.Styled(m.styles.Pager)(m.view)
+		}
+	}
+}
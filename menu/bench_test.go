@@ -0,0 +1,18 @@
+package menu
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui/internal/benchview"
+)
+
+func BenchmarkView100(b *testing.B) {
+	benchview.Run(b, func() tea.Model {
+		var items []*Item
+		for _, s := range benchview.Items(100) {
+			items = append(items, &Item{Label: s})
+		}
+		return New("Menu", items)
+	})
+}
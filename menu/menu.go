@@ -0,0 +1,668 @@
+package menu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/internal/render"
+	"github.com/nmeilick/go-ui/styles"
+)
+
+var _ ui.Prompt[*Item] = (*Model)(nil)
+
+var (
+	openKey = key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→", "open submenu"))
+	backKey = key.NewBinding(key.WithKeys("left", "h", "backspace"), key.WithHelp("←", "back"))
+	homeKey = key.NewBinding(key.WithKeys("home", "g"), key.WithHelp("home", "first"))
+	endKey  = key.NewBinding(key.WithKeys("end", "G"), key.WithHelp("end", "last"))
+)
+
+// ErrExit can be returned by an action to end Loop without error.
+var ErrExit = errors.New("menu: exit")
+
+// Item is an entry of a menu. An item with sub items opens a submenu when selected.
+type Item struct {
+	Label    string                          // Label is the text of the item.
+	Key      string                          // Key is an accelerator selecting the item, e.g. "n" or "ctrl+o".
+	Disabled bool                            // Disabled prevents selecting the item.
+	Action   func(ctx context.Context) error // Action is called by Loop when the item was selected.
+	Items    []*Item                         // Items are the entries of the submenu opened by the item.
+
+	separator bool // separator determines if the item is a separator line.
+}
+
+// Separator returns an item drawn as a separator line. It cannot be selected.
+func Separator() *Item {
+	return &Item{separator: true}
+}
+
+// Submenu returns an item with the given label opening a submenu with the given items.
+func Submenu(label string, items ...*Item) *Item {
+	return &Item{Label: label, Items: items}
+}
+
+// IsSeparator returns whether the item is a separator line.
+func (i *Item) IsSeparator() bool {
+	return i.separator
+}
+
+// selectable returns whether the item can be selected.
+func (i *Item) selectable() bool {
+	return !i.separator && !i.Disabled
+}
+
+// Model represents a menu with nested submenus, e.g. as the root navigation of a tool. Items are selected using the
+// arrow keys and enter or their accelerator keys; right opens a submenu and left or esc returns to the parent menu.
+type Model struct {
+	title          string                 // title is shown above the items.
+	items          []*Item                // items are the entries of the top-level menu.
+	stack          []int                  // stack holds the index of the opened item on each level above the current one.
+	cursor         int                    // cursor is the index of the item under the cursor on the current level.
+	selected       *Item                  // selected is the selected item, nil if none was selected yet.
+	cancelable     bool                   // cancelable determines if selection can be canceled with escape key
+	quitable       bool                   // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption    // programOptions are passed to the program running the model
+	id             string                 // id identifies the prompt, e.g. for preset answers
+	embedded       bool                   // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                   // focused determines if the model handles key messages
+	keymap         ui.KeyMap              // keymap holds the key bindings of the model.
+	cache          *render.Cache[itemKey] // cache holds the rendered items.
+	styles         Styles                 // styles holds the styles of the model.
+	customStyles   bool                   // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error                  // err is shown below the model, e.g. the error returned by the last action
+
+	canceled bool // canceled indicates whether the selection was canceled
+	quit     bool // quit indicates whether the selection was quit
+}
+
+// New creates and returns a new Model with the given title and items, configured by the given options.
+func New(title string, items []*Item, opts ...Option) *Model {
+	m := &Model{
+		title:      title,
+		items:      items,
+		cancelable: true,
+		quitable:   true,
+		focused:    true,
+		keymap:     ui.DefaultKeyMap(),
+		cache:      render.New[itemKey](),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	m.cursor = m.nextSelectable(-1, 1)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithTitle sets the title of the Model and returns a new Model with the updated title.
+func (m *Model) WithTitle(title string) *Model {
+	return m.With(Title(title))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the title is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// level returns the items of the current level.
+func (m *Model) level() []*Item {
+	items := m.items
+	for _, i := range m.stack {
+		items = items[i].Items
+	}
+	return items
+}
+
+// path returns the labels of the opened submenus.
+func (m *Model) path() []string {
+	var labels []string
+	items := m.items
+	for _, i := range m.stack {
+		labels = append(labels, items[i].Label)
+		items = items[i].Items
+	}
+	return labels
+}
+
+// nextSelectable returns the index of the next selectable item of the current level after i in the given direction,
+// or i if there is none.
+func (m *Model) nextSelectable(i, dir int) int {
+	items := m.level()
+	for j := i + dir; j >= 0 && j < len(items); j += dir {
+		if items[j].selectable() {
+			return j
+		}
+	}
+	return i
+}
+
+// Selected returns the selected item, or nil if none was selected.
+func (m *Model) Selected() *Item {
+	return m.selected
+}
+
+// Current returns the item under the cursor, or nil if the current level has no selectable items.
+func (m *Model) Current() *Item {
+	items := m.level()
+	if m.cursor < 0 || m.cursor >= len(items) || !items[m.cursor].selectable() {
+		return nil
+	}
+	return items[m.cursor]
+}
+
+// open opens the submenu of the item under the cursor.
+func (m *Model) open() {
+	if item := m.Current(); item != nil && len(item.Items) > 0 {
+		m.stack = append(m.stack, m.cursor)
+		m.cursor = m.nextSelectable(-1, 1)
+	}
+}
+
+// back returns to the parent menu and reports whether there was one.
+func (m *Model) back() bool {
+	if len(m.stack) == 0 {
+		return false
+	}
+	m.cursor = m.stack[len(m.stack)-1]
+	m.stack = m.stack[:len(m.stack)-1]
+	return true
+}
+
+// activate selects the item with the given index of the current level, opening its submenu if it has one. It
+// returns the command finishing the model if a leaf item was selected.
+func (m *Model) activate(i int) tea.Cmd {
+	m.cursor = i
+	item := m.level()[i]
+	if len(item.Items) > 0 {
+		m.open()
+		return nil
+	}
+	m.selected = item
+	m.err = nil
+	m.canceled, m.quit = false, false
+	return m.done()
+}
+
+// accelerator returns the index of the selectable item of the current level with the given accelerator key, or -1.
+func (m *Model) accelerator(k string) int {
+	for i, item := range m.level() {
+		if item.Key != "" && item.Key == k && item.selectable() {
+			return i
+		}
+	}
+	return -1
+}
+
+// find returns the stack and index of the item with the given label path, or an error if there is none.
+func (m *Model) find(labels []string) ([]int, int, error) {
+	var stack []int
+	items := m.items
+	for depth, label := range labels {
+		idx := -1
+		for i, item := range items {
+			if !item.separator && strings.EqualFold(item.Label, strings.TrimSpace(label)) {
+				idx = i
+				break
+			}
+		}
+		switch {
+		case idx < 0:
+			return nil, -1, fmt.Errorf("unknown item: %s", strings.Join(labels[:depth+1], " > "))
+		case items[idx].Disabled:
+			return nil, -1, fmt.Errorf("disabled item: %s", strings.Join(labels[:depth+1], " > "))
+		case depth == len(labels)-1:
+			if len(items[idx].Items) > 0 {
+				return nil, -1, fmt.Errorf("not an action: %s", strings.Join(labels, " > "))
+			}
+			return stack, idx, nil
+		}
+		stack = append(stack, idx)
+		items = items[idx].Items
+	}
+	return nil, -1, errors.New("empty answer")
+}
+
+// Key returns the ID of the prompt, or its title if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.title
+}
+
+// SetAnswer applies a preset answer, which is the label path of an item as a []string or as a string separated by
+// ">", e.g. "Settings > Theme". It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	var labels []string
+	switch v := v.(type) {
+	case []string:
+		labels = v
+	case string:
+		labels = strings.Split(v, ">")
+	default:
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+	stack, idx, err := m.find(labels)
+	if err != nil {
+		return err
+	}
+	m.stack, m.cursor = stack, idx
+	m.selected = m.level()[idx]
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the label path of the selected item, or nil if none was selected. It implements
+// ui.AnswerableModel.
+func (m *Model) Answer() any {
+	if m.selected == nil {
+		return nil
+	}
+	return append(m.path(), m.selected.Label)
+}
+
+// Choices returns the labels of the top-level items. It implements ui.ChoiceModel.
+func (m *Model) Choices() []string {
+	var labels []string
+	for _, item := range m.items {
+		if !item.separator {
+			labels = append(labels, item.Label)
+		}
+	}
+	return labels
+}
+
+// Focus focuses the model, so that it handles key messages. It returns no command and exists for compatibility with
+// other focusable models.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. the error returned by the last action. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns a nil command.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// Update handles key messages, moving the cursor, opening and closing submenus and selecting items. Accelerator keys
+// of the items on the current level take precedence over the navigation keys.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !m.focused {
+		return m, nil
+	}
+
+	if i := m.accelerator(keyMsg.String()); i >= 0 {
+		return m, m.activate(i)
+	}
+
+	switch {
+	case key.Matches(keyMsg, openKey):
+		m.open()
+	case key.Matches(keyMsg, backKey):
+		m.back()
+	case key.Matches(keyMsg, homeKey):
+		m.cursor = m.nextSelectable(-1, 1)
+	case key.Matches(keyMsg, endKey):
+		m.cursor = m.nextSelectable(len(m.level()), -1)
+	case key.Matches(keyMsg, m.keymap.Prev):
+		m.cursor = m.nextSelectable(m.cursor, -1)
+	case key.Matches(keyMsg, m.keymap.Next):
+		m.cursor = m.nextSelectable(m.cursor, 1)
+	case key.Matches(keyMsg, m.keymap.Confirm):
+		if m.Current() != nil {
+			return m, m.activate(m.cursor)
+		}
+	case key.Matches(keyMsg, m.keymap.Cancel):
+		if !m.back() && m.cancelable {
+			m.canceled, m.quit = true, false
+			return m, m.done()
+		}
+	case key.Matches(keyMsg, m.keymap.Quit):
+		if m.quitable {
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m, m.done()
+		}
+	}
+	return m, nil
+}
+
+// View renders the title with the path of the opened submenus and the items of the current level. Accelerator keys
+// are shown right of the labels and items opening a submenu are marked.
+func (m *Model) View() string {
+	var b strings.Builder
+	g := ui.Glyphs()
+
+	var header []string
+	if m.title != "" {
+		header = append(header, m.styles.Title.Render(m.title))
+	}
+	for _, label := range m.path() {
+		header = append(header, m.styles.Path.Render(label))
+	}
+	if len(header) > 0 {
+		fmt.Fprintf(&b, "%s\n", strings.Join(header, " "+g.Submenu+" "))
+	}
+
+	items := m.level()
+	labelWidth, keyWidth := 0, 0
+	for _, item := range items {
+		labelWidth = max(labelWidth, lipgloss.Width(item.Label))
+		keyWidth = max(keyWidth, lipgloss.Width(item.Key))
+	}
+
+	version := render.Fingerprint(m.styles.Separator, m.styles.Item, m.styles.Disabled, m.styles.Cursor,
+		m.styles.SelectedItem, m.styles.Key, m.styles.Submenu) + g.Separator + "\x00" + g.SelectedLeft + "\x00" +
+		g.Submenu
+	for i, item := range items {
+		key := itemKey{label: item.Label, key: item.Key, separator: item.separator, submenu: len(item.Items) > 0,
+			disabled: item.Disabled, cursor: i == m.cursor && m.focused, labelWidth: labelWidth, keyWidth: keyWidth}
+		b.WriteString(m.cache.Get(version, key, func() string {
+			return m.renderItem(key, g)
+		}))
+		b.WriteByte('\n')
+	}
+
+	if m.err != nil {
+		fmt.Fprintf(&b, "%s\n", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// itemKey identifies a rendered item in the cache by its content, state and the column widths.
+type itemKey struct {
+	label      string // label is the label of the item.
+	key        string // key is the accelerator of the item.
+	separator  bool   // separator determines if the item is a separator line.
+	submenu    bool   // submenu determines if the item opens a submenu.
+	disabled   bool   // disabled determines if the item is disabled.
+	cursor     bool   // cursor determines if the cursor is on the item.
+	labelWidth int    // labelWidth is the width of the widest label.
+	keyWidth   int    // keyWidth is the width of the widest accelerator, 0 if none has one.
+}
+
+// renderItem renders an item of the current level.
+func (m *Model) renderItem(key itemKey, g ui.GlyphSet) string {
+	width := key.labelWidth + 2 + key.keyWidth
+	if key.keyWidth == 0 {
+		width = key.labelWidth + 2
+	}
+	if key.separator {
+		return "  " + m.styles.Separator.Render(strings.Repeat(g.Separator, width))
+	}
+	cursor, style := "  ", m.styles.Item
+	switch {
+	case key.disabled:
+		style = m.styles.Disabled
+	case key.cursor:
+		cursor, style = m.styles.Cursor.Render(g.SelectedLeft)+" ", m.styles.SelectedItem
+	}
+
+	line := style.Render(key.label) + strings.Repeat(" ", key.labelWidth-lipgloss.Width(key.label))
+	if key.keyWidth > 0 {
+		line += "  " + m.styles.Key.Render(styles.Pad(key.key, key.keyWidth, lipgloss.Right))
+	}
+	if key.submenu {
+		line += " " + m.styles.Submenu.Render(g.Submenu)
+	}
+	return cursor + strings.TrimRight(line, " ")
+}
+
+// Run runs the model and returns the selected item. The action of the item is not called, see Loop. It implements
+// ui.Prompt[*Item].
+func (m *Model) Run(ctx context.Context) (*Item, error) {
+	m.selected = nil
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return nil, err
+	}
+	return m.selected, nil
+}
+
+// Loop runs the menu as the root navigation of a tool: the action of the selected item is called after the menu was
+// closed, so that it can run prompts of its own, and the menu is shown again afterwards at the same position. An
+// error returned by an action is shown below the menu, except for ui.CanceledError. Loop returns nil when the
+// top-level menu is canceled or an action returns ErrExit, ui.QuitError if quitting was requested, and the error of
+// the program if it failed.
+func (m *Model) Loop(ctx context.Context) error {
+	for {
+		item, err := m.Run(ctx)
+		switch {
+		case errors.Is(err, ui.CanceledError):
+			return nil
+		case err != nil:
+			return err
+		}
+		if item.Action == nil {
+			continue
+		}
+		err = item.Action(ctx)
+		switch {
+		case errors.Is(err, ErrExit):
+			return nil
+		case errors.Is(err, ui.QuitError):
+			return err
+		case errors.Is(err, ui.CanceledError):
+			err = nil
+		}
+		m.err = err
+	}
+}
+
+// RunAccessible asks for the number, accelerator key or label of an item on each level instead of using the
+// terminal UI. An empty answer returns to the parent menu. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	m.selected = nil
+	m.stack = nil
+	m.cursor = m.nextSelectable(-1, 1)
+	for {
+		header := m.path()
+		if m.title != "" {
+			header = append([]string{m.title}, header...)
+		}
+		if len(header) > 0 {
+			p.Println(strings.Join(header, " > "))
+		}
+
+		var numbered []int
+		for i, item := range m.level() {
+			if item.separator {
+				continue
+			}
+			numbered = append(numbered, i)
+			suffix := ""
+			switch {
+			case item.Disabled:
+				suffix = " (disabled)"
+			case len(item.Items) > 0:
+				suffix = " >"
+			}
+			if item.Key != "" {
+				suffix += fmt.Sprintf(" [%s]", item.Key)
+			}
+			p.Println(fmt.Sprintf("%3d) %s%s", len(numbered), item.Label, suffix))
+		}
+
+		prompt := fmt.Sprintf("Choose 1-%d: ", len(numbered))
+		if len(m.stack) > 0 {
+			prompt = fmt.Sprintf("Choose 1-%d (empty to go back): ", len(numbered))
+		}
+		s, err := p.Line(prompt, "")
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+		s = strings.TrimSpace(s)
+		if s == "" {
+			m.back()
+			continue
+		}
+
+		idx := m.accelerator(s)
+		if n, err := strconv.Atoi(s); err == nil && n >= 1 && n <= len(numbered) {
+			idx = numbered[n-1]
+		}
+		for _, i := range numbered {
+			if idx < 0 && strings.EqualFold(m.level()[i].Label, s) {
+				idx = i
+			}
+		}
+		switch {
+		case idx < 0:
+			p.Println(fmt.Sprintf("Error: unknown item: %s", s))
+			continue
+		case m.level()[idx].Disabled:
+			p.Println(fmt.Sprintf("Error: disabled item: %s", m.level()[idx].Label))
+			continue
+		}
+		m.cursor = idx
+		if item := m.level()[idx]; len(item.Items) > 0 {
+			m.open()
+			continue
+		}
+		m.selected = m.level()[idx]
+		m.canceled, m.quit = false, false
+		return nil
+	}
+}
+
+// Showcase demonstrates the Model component as the root navigation of a small tool.
+func Showcase() {
+	fmt.Println("=== Menu Showcase ===")
+
+	theme := "dark"
+	setTheme := func(name string) func(context.Context) error {
+		return func(context.Context) error {
+			theme = name
+			fmt.Printf("Theme set to %s\n", name)
+			return nil
+		}
+	}
+
+	m := New("Main menu", []*Item{
+		{Label: "New file", Key: "n", Action: func(context.Context) error {
+			fmt.Println("Creating a new file")
+			return nil
+		}},
+		{Label: "Open recent", Key: "o", Action: func(context.Context) error {
+			return errors.New("no recent files")
+		}},
+		{Label: "Print", Disabled: true},
+		Separator(),
+		Submenu("Settings",
+			Submenu("Theme",
+				&Item{Label: "Dark", Action: setTheme("dark")},
+				&Item{Label: "Light", Action: setTheme("light")},
+			),
+			&Item{Label: "Show theme", Action: func(context.Context) error {
+				fmt.Printf("Current theme: %s\n", theme)
+				return nil
+			}},
+		),
+		Separator(),
+		{Label: "Quit", Key: "q", Action: func(context.Context) error { return ErrExit }},
+	})
+	if err := m.Loop(context.Background()); err != nil {
+		ui.Handle(err, ui.HandleOptions{})
+	}
+}
@@ -0,0 +1,48 @@
+package menu
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Title        lipgloss.Style // Title is the style of the title of the menu.
+	Path         lipgloss.Style // Path is the style of the titles of the opened submenus.
+	Item         lipgloss.Style // Item is the style of the unselected items.
+	SelectedItem lipgloss.Style // SelectedItem is the style of the item under the cursor.
+	Disabled     lipgloss.Style // Disabled is the style of the disabled items.
+	Cursor       lipgloss.Style // Cursor is the style of the cursor glyph.
+	Key          lipgloss.Style // Key is the style of the accelerator keys.
+	Separator    lipgloss.Style // Separator is the style of the separator lines.
+	Submenu      lipgloss.Style // Submenu is the style of the glyph marking items that open a submenu.
+	Error        lipgloss.Style // Error is the style of the error shown below the items.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Title:        lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Path:         lipgloss.NewStyle().Foreground(t.Accent),
+		Item:         lipgloss.NewStyle().Foreground(t.Text),
+		SelectedItem: lipgloss.NewStyle().Foreground(t.Success),
+		Disabled:     lipgloss.NewStyle().Faint(true),
+		Cursor:       lipgloss.NewStyle().Foreground(t.Accent),
+		Key:          lipgloss.NewStyle().Foreground(t.Accent).Faint(true),
+		Separator:    lipgloss.NewStyle().Faint(true),
+		Submenu:      lipgloss.NewStyle().Foreground(t.Accent),
+		Error:        t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
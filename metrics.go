@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"sync"
+	"time"
+)
+
+// Outcome is the way a prompt was finished, see Metrics.
+type Outcome int
+
+const (
+	OutcomeAnswered Outcome = iota // OutcomeAnswered means the user answered the prompt.
+	OutcomeCanceled                // OutcomeCanceled means the user canceled the prompt.
+	OutcomeQuit                    // OutcomeQuit means the user requested to quit the program.
+	OutcomeFailed                  // OutcomeFailed means running the prompt failed.
+)
+
+// String returns the name of the outcome.
+func (o Outcome) String() string {
+	switch o {
+	case OutcomeAnswered:
+		return "answered"
+	case OutcomeCanceled:
+		return "canceled"
+	case OutcomeQuit:
+		return "quit"
+	case OutcomeFailed:
+		return "failed"
+	}
+	return "unknown"
+}
+
+// Metrics receives measurements of the interaction with prompts, e.g. to feed a telemetry system and find the prompts
+// users struggle with or cancel most. Prompts are identified by their key, see AnswerableModel; prompts resolved by
+// preset answers are not reported. The methods are called synchronously while the prompts run, so they should return
+// quickly.
+type Metrics interface {
+	// PromptShown is called when a prompt is shown to the user.
+	PromptShown(key string)
+	// PromptCompleted is called when a shown prompt finished, with the time it was shown.
+	PromptCompleted(key string, duration time.Duration, outcome Outcome)
+	// ValidationFailed is called when the user tried to submit a value that was rejected by a validator.
+	ValidationFailed(key string, err error)
+}
+
+var metrics struct {
+	sync.RWMutex
+	m Metrics
+}
+
+// SetMetrics sets the receiver of the metrics of all prompts. Passing nil disables the metrics.
+func SetMetrics(m Metrics) {
+	metrics.Lock()
+	defer metrics.Unlock()
+	metrics.m = m
+}
+
+// currentMetrics returns the receiver of the metrics, if any.
+func currentMetrics() Metrics {
+	metrics.RLock()
+	defer metrics.RUnlock()
+	return metrics.m
+}
+
+// RecordValidationFailure reports to the metrics that the user submitted a value for the prompt with the given key
+// that was rejected. Components call it when their validator fails; custom components can do the same.
+func RecordValidationFailure(key string, err error) {
+	if m := currentMetrics(); m != nil {
+		m.ValidationFailed(key, err)
+	}
+}
+
+// measure passes the event to the metrics, if any.
+func measure(e Event) {
+	m := currentMetrics()
+	if m == nil || e.Preset {
+		return
+	}
+	switch e.Type {
+	case EventShown:
+		m.PromptShown(e.Key)
+	case EventAnswered:
+		m.PromptCompleted(e.Key, e.Duration, OutcomeAnswered)
+	case EventCanceled:
+		m.PromptCompleted(e.Key, e.Duration, OutcomeCanceled)
+	case EventQuit:
+		m.PromptCompleted(e.Key, e.Duration, OutcomeQuit)
+	case EventFailed:
+		m.PromptCompleted(e.Key, e.Duration, OutcomeFailed)
+	}
+}
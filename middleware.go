@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// Handler runs a model and returns its error, see Run.
+type Handler func(m tea.Model) error
+
+// Middleware wraps the handler running a model. It can act before and after calling the next handler, e.g. for
+// metrics, tracing or logging, or answer the prompt itself without calling it at all, e.g. for caching answers using
+// AnswerableModel.
+type Middleware func(next Handler) Handler
+
+var middlewares struct {
+	sync.RWMutex
+	list []Middleware
+}
+
+// Use adds middleware wrapping every model run by Run, RunContext and Session.Run, which includes all components and
+// helpers. Steps of a Sequence are run through the middleware in accessible mode only, as they share a single program
+// otherwise. The middleware added first is the outermost.
+func Use(mw ...Middleware) {
+	middlewares.Lock()
+	defer middlewares.Unlock()
+	middlewares.list = append(middlewares.list, mw...)
+}
+
+// withMiddleware returns the handler wrapped by all middleware.
+func withMiddleware(h Handler) Handler {
+	middlewares.RLock()
+	defer middlewares.RUnlock()
+	for i := len(middlewares.list) - 1; i >= 0; i-- {
+		h = middlewares.list[i](h)
+	}
+	return h
+}
@@ -0,0 +1,531 @@
+package modal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"       // Manages key bindings
+	"github.com/charmbracelet/bubbles/textinput" // Provides text input model
+	tea "github.com/charmbracelet/bubbletea"     // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"          // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/answer"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/styles"
+)
+
+var _ ui.Prompt[int] = (*Model)(nil)
+
+var (
+	prevButtonKey = key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "previous button"))
+	nextButtonKey = key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "next button"))
+)
+
+// escapeSequence matches ANSI escape sequences, which are removed from the dimmed background.
+var escapeSequence = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]`)
+
+// Model represents a modal dialog: a box with a title, a message, an optional input field and a row of buttons. It is
+// shown centered on top of a dimmed background view and keeps the focus until a button is pressed.
+type Model struct {
+	title          string              // title is shown at the top of the box.
+	message        string              // message is shown below the title.
+	buttons        []string            // buttons holds the labels of the buttons.
+	button         int                 // button is the index of the focused button.
+	pressed        int                 // pressed is the index of the pressed button, -1 if none.
+	withInput      bool                // withInput determines if the input field is shown.
+	valueInput     textinput.Model     // valueInput reads the value of the input field.
+	boxWidth       int                 // boxWidth is the width of the box.
+	background     func() string       // background returns the view shown behind the box, nil for none.
+	width          int                 // width is the window width, updated from window size messages.
+	height         int                 // height is the window height, updated from window size messages.
+	cancelable     bool                // cancelable determines if the dialog can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	id             string              // id identifies the prompt, e.g. for preset answers
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                // focused determines if the model handles key messages
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error               // err is shown in the box, e.g. why the previous answer was rejected
+
+	canceled bool // canceled indicates whether the dialog was canceled
+	quit     bool // quit indicates whether the dialog was quit
+}
+
+// New creates and returns a new Model with the given title and message and a single OK button, configured by the
+// given options.
+func New(title, message string, opts ...Option) *Model {
+	ti := textinput.New()
+	ti.KeyMap = ui.DefaultTextKeyMap()
+	ti.Prompt = ""
+
+	m := &Model{
+		title:      title,
+		message:    message,
+		buttons:    []string{"OK"},
+		pressed:    -1,
+		valueInput: ti,
+		boxWidth:   50,
+		cancelable: true,
+		quitable:   true,
+		focused:    true,
+		keymap:     ui.DefaultKeyMap(),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.valueInput.Width = max(1, m.boxWidth-8)
+	if m.withInput {
+		m.valueInput.Focus()
+	}
+	return m
+}
+
+// WithTitle sets the title of the Model and returns a new Model with the updated title.
+func (m *Model) WithTitle(title string) *Model {
+	return m.With(Title(title))
+}
+
+// WithMessage sets the message of the Model and returns a new Model with the updated message.
+func (m *Model) WithMessage(message string) *Model {
+	return m.With(Message(message))
+}
+
+// WithButtons sets the labels of the buttons and returns a new Model with the updated buttons.
+func (m *Model) WithButtons(labels ...string) *Model {
+	return m.With(Buttons(labels...))
+}
+
+// WithDefaultButton sets the index of the button focused initially and returns a new Model with the updated button.
+func (m *Model) WithDefaultButton(i int) *Model {
+	return m.With(DefaultButton(i))
+}
+
+// WithInput shows an input field with the given initial value and returns a new Model with the updated field.
+func (m *Model) WithInput(value string) *Model {
+	return m.With(Input(value))
+}
+
+// WithWidth sets the width of the box and returns a new Model with the updated width.
+func (m *Model) WithWidth(width int) *Model {
+	return m.With(Width(width))
+}
+
+// WithBackground sets the function returning the view shown dimmed behind the box and returns a new Model with the
+// updated function.
+func (m *Model) WithBackground(fn func() string) *Model {
+	return m.With(Background(fn))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the title is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Pressed returns the index of the pressed button, or -1 if the dialog was canceled or is still shown.
+func (m *Model) Pressed() int {
+	return m.pressed
+}
+
+// Button returns the label of the pressed button, or an empty string if no button was pressed.
+func (m *Model) Button() string {
+	if m.pressed < 0 || m.pressed >= len(m.buttons) {
+		return ""
+	}
+	return m.buttons[m.pressed]
+}
+
+// Value returns the value of the input field.
+func (m *Model) Value() string {
+	return m.valueInput.Value()
+}
+
+// Key returns the ID of the prompt, or its title if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.title
+}
+
+// SetAnswer applies a preset answer. Without input field, it is the index or the label of the pressed button. With
+// input field, it is the value of the field, and the default button is pressed. It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	if m.withInput {
+		m.valueInput.SetValue(answer.String(v))
+		m.pressed = m.button
+	} else {
+		i, err := answer.Index(v, m.buttons)
+		if err != nil {
+			return err
+		}
+		m.button, m.pressed = i, i
+	}
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the value of the input field if it is shown, otherwise the label of the pressed button. It
+// implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	if m.withInput {
+		return m.Value()
+	}
+	return m.Button()
+}
+
+// Choices returns the labels of the buttons. It implements ui.ChoiceModel.
+func (m *Model) Choices() []string {
+	return append([]string(nil), m.buttons...)
+}
+
+// Focus focuses the model, so that it handles key messages. It returns the command starting the cursor blink if the
+// input field is shown.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	if m.withInput {
+		return m.valueInput.Focus()
+	}
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+	m.valueInput.Blur()
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown in the box, e.g. why the previous answer was rejected. It implements ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns the command starting the cursor blink if the input field is shown.
+func (m *Model) Init() tea.Cmd {
+	if m.withInput {
+		return textinput.Blink
+	}
+	return nil
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// UsesKey reports whether the dialog handles the key itself, like shift+tab moving to the previous button. It
+// implements ui.KeyModel.
+func (m *Model) UsesKey(msg tea.KeyMsg) bool {
+	return key.Matches(msg, nextButtonKey, prevButtonKey)
+}
+
+// Update handles window size messages and key messages, moving the focus between the buttons, pressing them and
+// editing the input field. Without input field, the left and right keys move between the buttons as well.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+	case tea.KeyMsg:
+		if !m.focused {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, nextButtonKey) || (!m.withInput && key.Matches(msg, m.keymap.Next)):
+			m.button = (m.button + 1) % len(m.buttons)
+			return m, nil
+		case key.Matches(msg, prevButtonKey) || (!m.withInput && key.Matches(msg, m.keymap.Prev)):
+			m.button = (m.button + len(m.buttons) - 1) % len(m.buttons)
+			return m, nil
+		case key.Matches(msg, m.keymap.Confirm):
+			m.pressed, m.err = m.button, nil
+			m.canceled, m.quit = false, false
+			return m, m.done()
+		case key.Matches(msg, m.keymap.Cancel):
+			if m.cancelable {
+				m.pressed = -1
+				m.canceled, m.quit = true, false
+				return m, m.done()
+			}
+			return m, nil
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.pressed = -1
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, m.done()
+			}
+			return m, nil
+		}
+		if !m.withInput {
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.valueInput, cmd = m.valueInput.Update(msg)
+	return m, cmd
+}
+
+// Box renders the dialog box without background.
+func (m *Model) Box() string {
+	inner := m.boxWidth - 6 // border and padding
+	var parts []string
+	if m.title != "" {
+		parts = append(parts, m.styles.Title.Width(inner).Render(m.title))
+	}
+	if m.message != "" {
+		parts = append(parts, m.styles.Message.Width(inner).Render(m.message))
+	}
+	if m.withInput {
+		parts = append(parts, m.styles.Input.Width(inner).Render(m.valueInput.View()))
+	}
+	if m.err != nil {
+		parts = append(parts, ui.RenderErrorWith(m.styles.Error.Width(inner), m.err))
+	}
+
+	buttons := make([]string, len(m.buttons))
+	for i, label := range m.buttons {
+		if i == m.button {
+			buttons[i] = m.styles.ActiveButton.Render(label)
+		} else {
+			buttons[i] = m.styles.Button.Render(label)
+		}
+	}
+	row := strings.Join(buttons, "  ")
+	parts = append(parts, lipgloss.PlaceHorizontal(inner, lipgloss.Right, row))
+
+	return m.styles.Box.Border(ui.Glyphs().Border).Padding(0, 2).Width(m.boxWidth - 2).
+		Render(strings.Join(parts, "\n\n"))
+}
+
+// Over renders the dialog box centered on top of the given view, which is dimmed. The view is padded to the window
+// size if it is known. Use it to show the dialog over the view of an embedding model.
+func (m *Model) Over(background string) string {
+	box := m.Box()
+	bg := strings.Split(strings.TrimSuffix(escapeSequence.ReplaceAllString(background, ""), "\n"), "\n")
+	for len(bg) < m.height {
+		bg = append(bg, "")
+	}
+	width := m.width
+	for _, line := range bg {
+		width = max(width, styles.Width(line))
+	}
+	lines := strings.Split(box, "\n")
+	boxWidth := lipgloss.Width(box)
+	width = max(width, boxWidth)
+	for len(bg) < len(lines) {
+		bg = append(bg, "")
+	}
+
+	top, left := (len(bg)-len(lines))/2, (width-boxWidth)/2
+	out := make([]string, len(bg))
+	for i, line := range bg {
+		line = styles.Pad(line, width, lipgloss.Left)
+		if i < top || i >= top+len(lines) {
+			out[i] = m.styles.Dim.Render(line)
+			continue
+		}
+		before := styles.Cut(line, left)
+		before += strings.Repeat(" ", left-styles.Width(before))
+		after := cutLeft(line, left+boxWidth)
+		out[i] = m.styles.Dim.Render(before) + lines[i-top] + m.styles.Dim.Render(after)
+	}
+	return strings.Join(out, "\n")
+}
+
+// cutLeft returns the part of the line after the given number of columns. A wide character crossing the boundary is
+// replaced by spaces.
+func cutLeft(line string, columns int) string {
+	w, rest := 0, ""
+	styles.Graphemes(line, func(offset int, _ string, gw int) bool {
+		if w >= columns {
+			rest = line[offset:]
+			return false
+		}
+		w += gw
+		return true
+	})
+	return strings.Repeat(" ", max(0, w-columns)) + rest
+}
+
+// View renders the dialog box, centered on top of the background view if one is set.
+func (m *Model) View() string {
+	if m.background == nil {
+		return m.Box()
+	}
+	return m.Over(m.background())
+}
+
+// Run runs the model and returns the index of the pressed button. It implements ui.Prompt[int].
+func (m *Model) Run(ctx context.Context) (int, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return -1, err
+	}
+	return m.Pressed(), nil
+}
+
+// RunAccessible writes the title and message and asks for the value of the input field and the button instead of
+// using the terminal UI. A single button is pressed with an empty line. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	if m.title != "" {
+		p.Println(m.title)
+	}
+	if m.message != "" {
+		p.Println(m.message)
+	}
+
+	if m.withInput {
+		s, err := p.Line("Value: ", m.Value())
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+		m.valueInput.SetValue(s)
+	}
+
+	if len(m.buttons) == 1 {
+		_, err := p.Line(fmt.Sprintf("Press enter for %s ", m.buttons[0]), "")
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+		m.pressed, m.canceled, m.quit = 0, false, false
+		return nil
+	}
+
+	i, err := p.Choice("", m.buttons, m.button)
+	switch {
+	case errors.Is(err, io.EOF):
+		m.canceled, m.quit = true, false
+		return nil
+	case err != nil:
+		return err
+	}
+	m.button, m.pressed = i, i
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Show shows a dialog with the given title, message and buttons and returns the label of the pressed button. Without
+// buttons, a single OK button is shown.
+func Show(ctx context.Context, title, message string, buttons ...string) (string, error) {
+	m := New(title, message)
+	if len(buttons) > 0 {
+		m = m.WithButtons(buttons...)
+	}
+	if _, err := m.Run(ctx); err != nil {
+		return "", err
+	}
+	return m.Button(), nil
+}
+
+// Showcase demonstrates the Model component over a background view, with custom buttons and an input field.
+func Showcase() {
+	fmt.Println("=== Modal Showcase ===")
+
+	background := func() string {
+		var b strings.Builder
+		for i := 1; i <= 20; i++ {
+			fmt.Fprintf(&b, "%3d  deploy-%02d   running   %d replicas   updated %d minutes ago\n", i, i, i%4+1, i*3)
+		}
+		return b.String()
+	}
+
+	m := New("Delete deployment?", "deploy-07 and its 3 replicas will be removed. This cannot be undone.").
+		WithButtons("Delete", "Keep").WithDefaultButton(1).WithBackground(background)
+	if _, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) != nil {
+		return
+	}
+	fmt.Printf("Pressed: %s\n", m.Button())
+
+	r := New("Rename", "Enter the new name of the deployment:").WithInput("deploy-07").
+		WithButtons("Rename", "Cancel").WithBackground(background)
+	if _, err := r.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Pressed: %s, name: %s\n", r.Button(), r.Value())
+	}
+}
@@ -0,0 +1,137 @@
+package modal
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	newModel.valueInput.Width = max(1, newModel.boxWidth-8)
+	return &newModel
+}
+
+// Title sets the title shown at the top of the box.
+func Title(title string) Option {
+	return func(m *Model) {
+		m.title = title
+	}
+}
+
+// Message sets the message shown below the title.
+func Message(message string) Option {
+	return func(m *Model) {
+		m.message = message
+	}
+}
+
+// Buttons sets the labels of the buttons, from left to right. Without labels, a single OK button is shown.
+func Buttons(labels ...string) Option {
+	return func(m *Model) {
+		if len(labels) == 0 {
+			labels = []string{"OK"}
+		}
+		m.buttons = append([]string(nil), labels...)
+		m.button = min(m.button, len(m.buttons)-1)
+	}
+}
+
+// DefaultButton sets the index of the button focused initially. Out of range indexes are ignored.
+func DefaultButton(i int) Option {
+	return func(m *Model) {
+		if i >= 0 && i < len(m.buttons) {
+			m.button = i
+		}
+	}
+}
+
+// Input shows an input field with the given initial value between the message and the buttons. While it is shown,
+// only tab and shift+tab move between the buttons.
+func Input(value string) Option {
+	return func(m *Model) {
+		m.withInput = true
+		m.valueInput.SetValue(value)
+		m.valueInput.Focus()
+	}
+}
+
+// Placeholder sets the placeholder shown in the empty input field.
+func Placeholder(placeholder string) Option {
+	return func(m *Model) {
+		m.valueInput.Placeholder = placeholder
+	}
+}
+
+// Width sets the width of the box, including its border. The default is 50.
+func Width(width int) Option {
+	return func(m *Model) {
+		m.boxWidth = max(width, 12)
+	}
+}
+
+// Background sets the function returning the view shown dimmed behind the box. It is called on every render, so it
+// may reflect changes of the underlying model.
+func Background(fn func() string) Option {
+	return func(m *Model) {
+		m.background = fn
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the title is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
@@ -0,0 +1,44 @@
+package modal
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Box          lipgloss.Style // Box is the style of the box; its border is set from the glyphs of the ui package.
+	Title        lipgloss.Style // Title is the style of the title.
+	Message      lipgloss.Style // Message is the style of the message.
+	Input        lipgloss.Style // Input is the style of the input field.
+	Button       lipgloss.Style // Button is the style of the buttons that are not focused.
+	ActiveButton lipgloss.Style // ActiveButton is the style of the focused button.
+	Dim          lipgloss.Style // Dim is the style of the background view behind the box.
+	Error        lipgloss.Style // Error is the style of the error shown in the box.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Box:          lipgloss.NewStyle().BorderForeground(t.Accent),
+		Title:        lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Message:      lipgloss.NewStyle().Foreground(t.Text),
+		Input:        lipgloss.NewStyle().Foreground(t.Text),
+		Button:       lipgloss.NewStyle().Padding(0, 1).Faint(true),
+		ActiveButton: lipgloss.NewStyle().Padding(0, 1).Foreground(t.Accent).Bold(true).Reverse(true),
+		Dim:          lipgloss.NewStyle().Faint(true),
+		Error:        t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
@@ -0,0 +1,414 @@
+package ui
+
+import (
+	"image"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/rivo/uniseg"                 // Splits text into grapheme clusters
+)
+
+// cursorQueryTimeout is how long a mouse press waits for the terminal to report the cursor position before the
+// position of the view is estimated.
+const cursorQueryTimeout = 200 * time.Millisecond
+
+// WithMouse enables or disables mouse support. When enabled, the terminal reports clicks, wheel and drag events, and
+// the model receives a MouseMsg instead of a tea.MouseMsg, which tells the zones of the view under the pointer. Zones
+// are marked by components using Zones, so they translate clicks and wheel events into selection and scrolling
+// without doing any coordinate math.
+//
+// Clicks are located by asking the terminal for the cursor position, which works in the alternate screen as well as
+// inline. If the terminal does not report it in time, the view is assumed to be at the bottom of the terminal. Mouse
+// support is disabled by default, as the terminal no longer selects text while it is enabled; most
+// terminals still select text while shift is held.
+func WithMouse(enabled bool) tea.ProgramOption {
+	return func(p *tea.Program) {
+		if enabled {
+			tea.WithMouseCellMotion()(p)
+		}
+		option(func(s *settings) {
+			s.mouse = enabled
+		})(p)
+	}
+}
+
+// MouseMsg is sent to the model instead of a tea.MouseMsg when mouse support is enabled with WithMouse.
+type MouseMsg struct {
+	tea.MouseEvent
+	zones map[string]image.Point // zones maps the IDs of the zones under the pointer to the position within them.
+}
+
+// In returns the position of the pointer relative to the top left corner of the zone with the given ID, and whether
+// the pointer is within the zone.
+func (m MouseMsg) In(id string) (x, y int, ok bool) {
+	p, ok := m.zones[id]
+	return p.X, p.Y, ok
+}
+
+// Clicked reports whether the left button was pressed.
+func (m MouseMsg) Clicked() bool {
+	return m.Action == tea.MouseActionPress && m.Button == tea.MouseButtonLeft
+}
+
+// Wheel returns -1 if the wheel was scrolled up, 1 if it was scrolled down, and 0 otherwise.
+func (m MouseMsg) Wheel() int {
+	if m.Action != tea.MouseActionPress {
+		return 0
+	}
+	switch m.Button {
+	case tea.MouseButtonWheelUp:
+		return -1
+	case tea.MouseButtonWheelDown:
+		return 1
+	}
+	return 0
+}
+
+// Mouse returns the original message, e.g. to pass it to a bubbles component.
+func (m MouseMsg) Mouse() tea.MouseMsg {
+	return tea.MouseMsg(m.MouseEvent)
+}
+
+// Zones marks regions of a component's view, so that mouse events can be mapped to them. Each component instance
+// uses its own Zones, created by NewZones, so the zones of embedded components do not collide. Marking is free while
+// no program with mouse support is running.
+type Zones struct {
+	prefix string // prefix makes the IDs of the zones unique.
+}
+
+// zonesCount is used to generate the prefixes of Zones.
+var zonesCount atomic.Int64
+
+// NewZones returns Zones with IDs that are unique within the process.
+func NewZones() Zones {
+	return Zones{prefix: "z" + strconv.FormatInt(zonesCount.Add(1), 36) + ":"}
+}
+
+// ID returns the ID of the zone with the given name, e.g. for MouseMsg.In.
+func (z Zones) ID(name string) string {
+	return z.prefix + name
+}
+
+// Mark marks the rendered string as the zone with the given name. The markers are invisible and removed before the
+// view is written to the terminal.
+func (z Zones) Mark(name, s string) string {
+	return Mark(z.ID(name), s)
+}
+
+// MarkItem marks the rendered item with the given index, e.g. a line of a list.
+func (z Zones) MarkItem(i int, s string) string {
+	return z.Mark("#"+strconv.Itoa(i), s)
+}
+
+// In reports whether the pointer is within the zone with the given name.
+func (z Zones) In(msg MouseMsg, name string) bool {
+	_, _, ok := msg.In(z.ID(name))
+	return ok
+}
+
+// Item returns the index of the item marked by MarkItem under the pointer, and whether there is one.
+func (z Zones) Item(msg MouseMsg) (int, bool) {
+	prefix := z.prefix + "#"
+	for id := range msg.zones {
+		if rest, ok := strings.CutPrefix(id, prefix); ok {
+			if i, err := strconv.Atoi(rest); err == nil {
+				return i, true
+			}
+		}
+	}
+	return -1, false
+}
+
+// mouseRuns is the number of running programs with mouse support. Zones are only marked while it is positive.
+var mouseRuns atomic.Int32
+
+// zoneRegistry maps zone IDs to the numbers used in the markers.
+var zoneRegistry = struct {
+	sync.Mutex
+	numbers map[string]int // numbers maps zone IDs to their numbers.
+	ids     []string       // ids holds the zone IDs by number.
+}{numbers: make(map[string]int)}
+
+// Mark marks the rendered string as the zone with the given ID, which must be unique within the view. Components use
+// Zones instead, which generate unique IDs.
+func Mark(id, s string) string {
+	if mouseRuns.Load() == 0 {
+		return s
+	}
+	zoneRegistry.Lock()
+	n, ok := zoneRegistry.numbers[id]
+	if !ok {
+		n = len(zoneRegistry.ids)
+		zoneRegistry.numbers[id] = n
+		zoneRegistry.ids = append(zoneRegistry.ids, id)
+	}
+	zoneRegistry.Unlock()
+	// The marker is a CSI sequence unknown to terminals, so lipgloss treats it as having no width.
+	marker := "\x1b[" + strconv.Itoa(n) + "z"
+	return marker + s + marker
+}
+
+// zoneID returns the zone ID of the marker parameters, if they are valid.
+func zoneID(params string) (string, bool) {
+	n, err := strconv.Atoi(params)
+	if err != nil || n < 0 {
+		return "", false
+	}
+	zoneRegistry.Lock()
+	defer zoneRegistry.Unlock()
+	if n >= len(zoneRegistry.ids) {
+		return "", false
+	}
+	return zoneRegistry.ids[n], true
+}
+
+// scanZones removes the zone markers from the view and returns the regions of the zones, relative to the top left
+// corner of the view.
+func scanZones(view string) (string, map[string]image.Rectangle) {
+	var b strings.Builder
+	b.Grow(len(view))
+	zones := make(map[string]image.Rectangle)
+	open := make(map[string]image.Point)
+	x, y := 0, 0
+	for i := 0; i < len(view); {
+		switch c := view[i]; {
+		case c == '\n':
+			b.WriteByte(c)
+			x, y = 0, y+1
+			i++
+		case c == '\x1b' && i+1 < len(view) && view[i+1] == '[':
+			j := i + 2
+			for j < len(view) && view[j] >= 0x30 && view[j] <= 0x3f {
+				j++
+			}
+			for j < len(view) && view[j] >= 0x20 && view[j] <= 0x2f {
+				j++
+			}
+			if j == len(view) {
+				b.WriteString(view[i:])
+				i = j
+				continue
+			}
+			if view[j] == 'z' {
+				if id, ok := zoneID(view[i+2 : j]); ok {
+					if start, ok := open[id]; ok {
+						delete(open, id)
+						zones[id] = image.Rect(start.X, start.Y, x, y+1)
+					} else {
+						open[id] = image.Pt(x, y)
+					}
+					i = j + 1
+					continue
+				}
+			}
+			b.WriteString(view[i : j+1])
+			i = j + 1
+		case c == '\x1b' && i+1 < len(view) && view[i+1] == ']':
+			// Operating system commands, e.g. hyperlinks, end with BEL or ST.
+			j := i + 2
+			for j < len(view) && view[j] != '\a' && !(view[j] == '\x1b' && j+1 < len(view) && view[j+1] == '\\') {
+				j++
+			}
+			switch {
+			case j == len(view):
+			case view[j] == '\a':
+				j++
+			default:
+				j += 2
+			}
+			b.WriteString(view[i:j])
+			i = j
+		default:
+			cluster, _, width, _ := uniseg.FirstGraphemeClusterInString(view[i:], -1)
+			b.WriteString(cluster)
+			x += width
+			i += len(cluster)
+		}
+	}
+	return b.String(), zones
+}
+
+// cursorTimeoutMsg is sent if the terminal did not report the cursor position in time.
+type cursorTimeoutMsg struct {
+	query int // query is the number of the query that timed out.
+}
+
+// mouse translates the mouse events of a program into MouseMsg.
+type mouse struct {
+	output  io.Writer                  // output is the terminal the cursor position is queried from.
+	height  int                        // height is the height of the terminal, 0 if unknown.
+	lines   int                        // lines is the number of lines of the last view.
+	origin  int                        // origin is the row of the terminal showing the first visible line of the view.
+	known   bool                       // known determines if origin was reported by the terminal or only estimated.
+	zones   map[string]image.Rectangle // zones are the zones of the last view.
+	pending []tea.MouseMsg             // pending are the presses waiting for the cursor position.
+	queries int                        // queries is the number of cursor position queries sent.
+}
+
+// newMouse returns the translation of mouse events for the given settings, or nil if mouse support is disabled.
+func newMouse(s *settings) *mouse {
+	if !s.mouse {
+		return nil
+	}
+	mouseRuns.Add(1)
+	return &mouse{output: s.output}
+}
+
+// close stops marking zones for the program. When the last program with mouse support exits, the registered zones
+// are removed, so that the registry does not grow with every program run by a long-lived process.
+func (m *mouse) close() {
+	if m == nil || mouseRuns.Add(-1) > 0 {
+		return
+	}
+	zoneRegistry.Lock()
+	defer zoneRegistry.Unlock()
+	// Another program may have started in the meantime and use the registered zones already.
+	if mouseRuns.Load() == 0 {
+		clear(zoneRegistry.numbers)
+		zoneRegistry.ids = nil
+	}
+}
+
+// view removes the zone markers from the view and remembers the zones and the size of the view.
+func (m *mouse) view(view string) string {
+	if m == nil {
+		// Markers are only rendered while a program with mouse support runs, possibly in the same process.
+		if mouseRuns.Load() > 0 {
+			view, _ = scanZones(view)
+		}
+		return view
+	}
+	view, m.zones = scanZones(view)
+	m.lines = strings.Count(view, "\n") + 1
+	// A view growing beyond the bottom of the terminal scrolls it.
+	if m.height > 0 && m.origin+m.visible() > m.height {
+		m.origin = m.height - m.visible()
+	}
+	return view
+}
+
+// visible returns the number of lines of the view shown. The renderer drops lines at the top that do not fit.
+func (m *mouse) visible() int {
+	if m.height > 0 && m.lines > m.height {
+		return m.height
+	}
+	return m.lines
+}
+
+// update handles the messages concerning the mouse. It returns the messages to pass to the model instead of msg, and
+// a command, e.g. querying the cursor position.
+func (m *mouse) update(msg tea.Msg) ([]tea.Msg, tea.Cmd) {
+	if m == nil {
+		return []tea.Msg{msg}, nil
+	}
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.height = msg.Height
+		m.known = false
+	case tea.MouseMsg:
+		if msg.Action != tea.MouseActionPress || tea.MouseEvent(msg).IsWheel() {
+			return []tea.Msg{m.translate(msg)}, nil
+		}
+		m.pending = append(m.pending, msg)
+		if len(m.pending) > 1 {
+			return nil, nil
+		}
+		m.queries++
+		query := m.queries
+		return nil, tea.Batch(
+			queryCursor(m.output),
+			tea.Tick(cursorQueryTimeout, func(time.Time) tea.Msg { return cursorTimeoutMsg{query: query} }),
+		)
+	case cursorTimeoutMsg:
+		if msg.query == m.queries {
+			return m.flush(), nil
+		}
+		return nil, nil
+	default:
+		if row, ok := cursorRow(msg); ok && len(m.pending) > 0 {
+			// The cursor is on the last visible line; rows are reported starting with 1.
+			m.origin = row - m.visible()
+			m.known = true
+			m.queries++
+			return m.flush(), nil
+		}
+	}
+	return []tea.Msg{msg}, nil
+}
+
+// queryCursor returns the command asking the terminal for the cursor position, which it reports as input. The
+// renderer leaves the cursor at the start of the last line of the view, which does not change while the model waits
+// for the press.
+func queryCursor(output io.Writer) tea.Cmd {
+	return func() tea.Msg {
+		_, _ = io.WriteString(output, "\x1b[6n")
+		return nil
+	}
+}
+
+// flush returns the pending presses, translated with the current origin.
+func (m *mouse) flush() []tea.Msg {
+	msgs := make([]tea.Msg, len(m.pending))
+	for i, msg := range m.pending {
+		msgs[i] = m.translate(msg)
+	}
+	m.pending = nil
+	return msgs
+}
+
+// translate returns the MouseMsg for the event, locating the zones under the pointer.
+func (m *mouse) translate(msg tea.MouseMsg) MouseMsg {
+	origin := m.origin
+	if !m.known && m.height > 0 {
+		// Without a report, assume the view is at the bottom of the terminal, as after scrolling.
+		origin = m.height - m.visible()
+	}
+	// Lines dropped by the renderer are above the origin.
+	y := msg.Y - origin + m.lines - m.visible()
+	mm := MouseMsg{MouseEvent: tea.MouseEvent(msg)}
+	pt := image.Pt(msg.X, y)
+	for id, r := range m.zones {
+		if pt.In(r) {
+			if mm.zones == nil {
+				mm.zones = make(map[string]image.Point)
+			}
+			mm.zones[id] = pt.Sub(r.Min)
+		}
+	}
+	return mm
+}
+
+// csiMsgType is the type of the message Bubble Tea passes unknown CSI sequences as, e.g. the report of the cursor
+// position. It is unexported, so it is identified by its package and name.
+var csiMsgType = struct{ pkg, name string }{"github.com/charmbracelet/bubbletea", "unknownCSISequenceMsg"}
+
+// cursorRow returns the row of the cursor if msg is the terminal's report of the cursor position, ESC [ row ; col R.
+// If a future Bubble Tea passes the report differently, no row is returned, and clicks are located with the estimated
+// position of the view.
+func cursorRow(msg tea.Msg) (int, bool) {
+	t := reflect.TypeOf(msg)
+	if t == nil || t.PkgPath() != csiMsgType.pkg || t.Name() != csiMsgType.name || t.Kind() != reflect.Slice ||
+		t.Elem().Kind() != reflect.Uint8 {
+		return 0, false
+	}
+	seq := string(reflect.ValueOf(msg).Bytes())
+	params, ok := strings.CutPrefix(seq, "\x1b[")
+	if !ok || !strings.HasSuffix(params, "R") {
+		return 0, false
+	}
+	row, _, ok := strings.Cut(strings.TrimSuffix(params, "R"), ";")
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(row)
+	if err != nil || n < 1 {
+		return 0, false
+	}
+	return n, true
+}
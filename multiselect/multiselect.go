@@ -0,0 +1,274 @@
+// Package multiselect lets the user select any number of a list of items, like pick does for a single one: space
+// toggles the item under the cursor, enter confirms the selection. It builds on checkboxgroup, which also supports
+// disabled items and typed values.
+package multiselect
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/checkboxgroup"
+)
+
+var _ ui.Prompt[[]int] = (*Model)(nil)
+
+// Model represents a list of items of which any number can be selected.
+type Model struct {
+	group          *checkboxgroup.Model // group shows the items as checkboxes and handles the selection.
+	items          []string             // items is the list of items to select from.
+	programOptions []tea.ProgramOption  // programOptions are passed to the program running the model
+	embedded       bool                 // embedded determines if a DoneMsg is emitted instead of quitting the program
+}
+
+// New creates and returns a new Model with the given items, none of them selected, configured by the given options.
+func New(items []string, opts ...Option) *Model {
+	m := &Model{
+		group: checkboxgroup.New("", checkboxgroup.Strings(items...), checkboxgroup.Embedded()),
+		items: items,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithLabel sets the label shown above the items and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithSelected selects the items with the given indices and returns a new Model with the updated selection.
+func (m *Model) WithSelected(indices ...int) *Model {
+	return m.With(Selected(indices...))
+}
+
+// WithMinSelections sets the minimum number of selected items and returns a new Model with the updated minimum.
+func (m *Model) WithMinSelections(n int) *Model {
+	return m.With(MinSelections(n))
+}
+
+// WithMaxSelections sets the maximum number of selected items, 0 for unlimited, and returns a new Model with the
+// updated maximum.
+func (m *Model) WithMaxSelections(n int) *Model {
+	return m.With(MaxSelections(n))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := m.With()
+	newModel.embedded = embedded
+	return newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// WithCheckedStyle sets the style of the markers of selected items and returns a new Model with the updated style.
+func (m *Model) WithCheckedStyle(style lipgloss.Style) *Model {
+	return m.With(CheckedStyle(style))
+}
+
+// WithUncheckedStyle sets the style of the markers of unselected items and returns a new Model with the updated
+// style.
+func (m *Model) WithUncheckedStyle(style lipgloss.Style) *Model {
+	return m.With(UncheckedStyle(style))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.group.Styles()
+}
+
+// SelectedIndices returns the indices of the selected items in ascending order.
+func (m *Model) SelectedIndices() []int {
+	indices := []int{}
+	for i, item := range m.group.Items() {
+		if item.Checked {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// SelectedItems returns the selected items in the order of the list.
+func (m *Model) SelectedItems() []string {
+	items := []string{}
+	for _, i := range m.SelectedIndices() {
+		items = append(items, m.items[i])
+	}
+	return items
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	return m.group.Key()
+}
+
+// SetAnswer applies a preset answer, which is a list of items as []string, []any or a comma-separated string. It
+// implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	return m.group.SetAnswer(v)
+}
+
+// Answer returns the selected items. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	return m.SelectedItems()
+}
+
+// Choices returns all items. It implements ui.ChoiceModel.
+func (m *Model) Choices() []string {
+	return m.items
+}
+
+// Focus focuses the model, so that it handles key messages.
+func (m *Model) Focus() tea.Cmd {
+	return m.group.Focus()
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.group.Blur()
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.group.Focused()
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.group.SetError(err)
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.group.Canceled()
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.group.Quit()
+}
+
+// Init initializes the model.
+func (m *Model) Init() tea.Cmd {
+	return m.group.Init()
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// Update handles key messages: the arrow keys move the cursor, space toggles the item under it, a and n select all
+// or none of the items, and enter confirms the selection if it satisfies the minimum and maximum.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(checkboxgroup.DoneMsg); ok && msg.Model == m.group {
+		if m.embedded {
+			return m, func() tea.Msg { return DoneMsg{Model: m} }
+		}
+		return m, tea.Quit
+	}
+	_, cmd := m.group.Update(msg)
+	return m, cmd
+}
+
+// View renders the label, the items with their markers and, if constrained, the number of selected items.
+func (m *Model) View() string {
+	return m.group.View()
+}
+
+// Run runs the model and returns the indices of the selected items. It implements ui.Prompt[[]int].
+func (m *Model) Run(ctx context.Context) ([]int, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return nil, err
+	}
+	return m.SelectedIndices(), nil
+}
+
+// RunAccessible lists the items and asks for the numbers of the items to select instead of using the terminal UI. It
+// implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	return m.group.RunAccessible(in, out)
+}
+
+// ValuePrompt returns a prompt that runs the model and returns the selected items instead of their indices.
+func (m *Model) ValuePrompt() ui.Prompt[[]string] {
+	return ui.PromptFunc[[]string](func(ctx context.Context) ([]string, error) {
+		if _, err := m.Run(ctx); err != nil {
+			return nil, err
+		}
+		return m.SelectedItems(), nil
+	})
+}
+
+// Select asks to select any number of the items and returns the indices of the selected ones or an error.
+// Use errors.Is(ui.CanceledError) or errors.Is(ui.QuitError) to determine if the selection was canceled or aborting of
+// the program was requested.
+func Select(label string, items ...string) ([]int, error) {
+	return SelectWithOptions(nil, label, items...)
+}
+
+// SelectWithOptions is like Select, but passes the given options to the program, e.g. to use the alternate screen or
+// custom input and output.
+func SelectWithOptions(opts []tea.ProgramOption, label string, items ...string) ([]int, error) {
+	return SelectContext(context.Background(), label, items, opts...)
+}
+
+// SelectContext is like SelectWithOptions, but aborts the selection when the context is done, see ui.RunContext.
+// ui.TimeoutError is returned if its deadline expired.
+func SelectContext(ctx context.Context, label string, items []string, opts ...tea.ProgramOption) ([]int, error) {
+	return New(items).WithLabel(label).WithProgramOptions(opts...).Run(ctx)
+}
+
+// Showcase demonstrates the Model component with a preselected item and constraints on the number of selected items.
+func Showcase() {
+	fmt.Println("=== Multi-Select Showcase ===")
+
+	fmt.Println("\nSelect 1-3 toppings (space toggles, enter confirms):")
+	m := New([]string{"Cheese", "Mushrooms", "Olives", "Peppers", "Pineapple"}).
+		WithLabel("Toppings:").
+		WithSelected(0).
+		WithMinSelections(1).
+		WithMaxSelections(3)
+	if _, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Selected: %v (Indices: %v)\n", m.SelectedItems(), m.SelectedIndices())
+	}
+}
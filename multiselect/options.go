@@ -0,0 +1,122 @@
+package multiselect
+
+import (
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/checkboxgroup"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy. Like in checkboxgroup, the copy shares
+// the state of the items with the model unless Selected replaces it.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	newModel.group = m.group.With()
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Label sets the label shown above the items.
+func Label(label string) Option {
+	return func(m *Model) {
+		checkboxgroup.Label(label)(m.group)
+	}
+}
+
+// Selected selects the items with the given indices initially, replacing the current selection. Invalid indices and
+// those exceeding the maximum set by MaxSelections are ignored; a maximum set afterwards keeps an exceeding selection
+// from being confirmed. The selection of the model the option is applied to using With is left unchanged.
+func Selected(indices ...int) Option {
+	return func(m *Model) {
+		checkboxgroup.Checked(indices...)(m.group)
+	}
+}
+
+// MinSelections sets the minimum number of selected items required to confirm the selection.
+func MinSelections(n int) Option {
+	return func(m *Model) {
+		checkboxgroup.Min(n)(m.group)
+	}
+}
+
+// MaxSelections sets the maximum number of selected items. Further items cannot be selected once it is reached. 0
+// means unlimited.
+func MaxSelections(n int) Option {
+	return func(m *Model) {
+		checkboxgroup.Max(n)(m.group)
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		checkboxgroup.Cancel(cancelable)(m.group)
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		checkboxgroup.Quit(quitable)(m.group)
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		checkboxgroup.ID(id)(m.group)
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		checkboxgroup.KeyMap(km)(m.group)
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		checkboxgroup.Styled(styles)(m.group)
+	}
+}
+
+// CheckedStyle sets the style of the markers of selected items.
+func CheckedStyle(style lipgloss.Style) Option {
+	return func(m *Model) {
+		styles := m.group.Styles()
+		styles.Checked = style
+		Styled(styles)(m)
+	}
+}
+
+// UncheckedStyle sets the style of the markers of items that are not selected.
+func UncheckedStyle(style lipgloss.Style) Option {
+	return func(m *Model) {
+		styles := m.group.Styles()
+		styles.Checkbox = style
+		Styled(styles)(m)
+	}
+}
@@ -0,0 +1,20 @@
+package multiselect
+
+import (
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/checkboxgroup"
+)
+
+// Styles holds the styles of the model. The checkboxes are the markers of the items: Checked styles those of selected
+// items, Checkbox those of the others.
+type Styles = checkboxgroup.Styles
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return checkboxgroup.DefaultStyles()
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return checkboxgroup.ThemedStyles(t)
+}
@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"context"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// settings holds the go-ui specific settings of a single Run.
+type settings struct {
+	accessible  bool                       // accessible determines if line-based prompts are used instead of the terminal UI
+	answers     map[string]any             // answers are preset answers keyed by prompt ID or label
+	echoAnswers bool                       // echoAnswers determines if preset answers are printed
+	defaults    map[string]any             // defaults are default answers keyed by prompt ID or label
+	input       io.Reader                  // input is the reader user input is read from
+	inputSet    bool                       // inputSet is set if the input was set using WithInput
+	output      io.Writer                  // output is the writer output is written to
+	record      string                     // record is the path the run is recorded to, if any
+	plan        *Plan                      // plan collects the prompts instead of running them in dry-run mode
+	debug       bool                       // debug determines if the debug overlay is shown
+	ctx         context.Context            // ctx is the context of the run, which aborts waiting for the terminal
+	noWait      bool                       // noWait determines if the run fails instead of waiting for the terminal
+	err         error                      // err is an error that occurred while resolving the settings
+	windowSize  func() (width, height int) // windowSize returns the size of a terminal bubbletea cannot query, if set
+	resized     <-chan struct{}            // resized signals that the size returned by windowSize changed
+	mouse       bool                       // mouse determines if mouse events are reported and translated to MouseMsg
+	remember    string                     // remember is the key the confirmed answer is remembered under, if any
+	memory      *memory                    // memory holds the remembered answer offered by the prompt, if any
+	printers    []*Printer                 // printers are the printers attached to the prompt besides the default one
+	theme       *Theme                     // theme is the theme styling the prompt instead of the current one, if set
+}
+
+// probes maps the probe programs used by resolve to the settings collected for them.
+var probes sync.Map
+
+// option returns a tea.ProgramOption that changes go-ui specific settings. This allows passing go-ui options alongside
+// regular program options through all helpers. Applied to a regular program, the option has no effect.
+func option(fn func(*settings)) tea.ProgramOption {
+	return func(p *tea.Program) {
+		if s, ok := probes.Load(p); ok {
+			fn(s.(*settings))
+		}
+	}
+}
+
+// resolve returns the settings configured by the given options, starting with the defaults.
+func resolve(opts []tea.ProgramOption) *settings {
+	s := &settings{
+		accessible: envBool("UI_ACCESSIBLE"),
+		debug:      envBool("UI_DEBUG"),
+		input:      os.Stdin,
+		output:     os.Stdout,
+	}
+	s.answers, s.err = envAnswers()
+
+	probe := new(tea.Program)
+	probes.Store(probe, s)
+	defer probes.Delete(probe)
+	for _, opt := range opts {
+		opt(probe)
+	}
+	return s
+}
+
+// envBool returns the boolean value of the given environment variable, or false if it is unset or invalid.
+func envBool(name string) bool {
+	b, _ := strconv.ParseBool(os.Getenv(name))
+	return b
+}
+
+// WithAccessible enables or disables the accessible mode, which replaces the terminal UI of all components with
+// simple line-based prompts that work with screen readers and dumb terminals. The mode is enabled by default if the
+// environment variable UI_ACCESSIBLE is set to a true value.
+func WithAccessible(accessible bool) tea.ProgramOption {
+	return option(func(s *settings) {
+		s.accessible = accessible
+	})
+}
+
+// WithInput sets the reader user input is read from instead of os.Stdin, e.g. an SSH session or a PTY in tests. In
+// contrast to tea.WithInput, it applies to the accessible mode as well. By default, prompts read from the controlling
+// terminal if stdin is not a terminal, e.g. because data is piped into the program; WithInput(os.Stdin) reads the
+// answers from the pipe instead.
+func WithInput(r io.Reader) tea.ProgramOption {
+	return func(p *tea.Program) {
+		tea.WithInput(r)(p)
+		option(func(s *settings) {
+			s.input, s.inputSet = r, true
+		})(p)
+	}
+}
+
+// WithOutput sets the writer output is written to instead of os.Stdout, e.g. an SSH session or a PTY in tests. In
+// contrast to tea.WithOutput, it applies to the accessible mode as well.
+func WithOutput(w io.Writer) tea.ProgramOption {
+	return func(p *tea.Program) {
+		tea.WithOutput(w)(p)
+		option(func(s *settings) {
+			s.output = w
+		})(p)
+	}
+}
+
+// WithWindowSize sets the function returning the size of the terminal when bubbletea cannot query it, e.g. for an SSH
+// session with a pseudo terminal. The size is sent to the model when the program starts and whenever a value is
+// received from resized, which may be nil.
+func WithWindowSize(size func() (width, height int), resized <-chan struct{}) tea.ProgramOption {
+	return option(func(s *settings) {
+		s.windowSize = size
+		s.resized = resized
+	})
+}
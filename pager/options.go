@@ -0,0 +1,93 @@
+package pager
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Title sets the title shown above the text.
+func Title(title string) Option {
+	return func(m *Model) {
+		m.title = title
+	}
+}
+
+// Wrap sets whether long lines are wrapped instead of scrolled horizontally.
+func Wrap(wrap bool) Option {
+	return func(m *Model) {
+		m.wrap = wrap
+	}
+}
+
+// LineNumbers sets whether line numbers are shown left of the lines.
+func LineNumbers(show bool) Option {
+	return func(m *Model) {
+		m.lineNumbers = show
+	}
+}
+
+// Height sets the number of visible rows. If it is 0, which is the default, the pager fills the terminal window.
+func Height(height int) Option {
+	return func(m *Model) {
+		m.height = height
+	}
+}
+
+// LineStyle sets the function returning the style of a line, e.g. to color the lines of a diff. If it is nil, the
+// Text style is used.
+func LineStyle(fn func(line string) lipgloss.Style) Option {
+	return func(m *Model) {
+		m.lineStyle = fn
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
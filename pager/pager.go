@@ -0,0 +1,758 @@
+package pager
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/styles"
+)
+
+var (
+	lineUpKey    = key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "line up"))
+	lineDownKey  = key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "line down"))
+	pageUpKey    = key.NewBinding(key.WithKeys("pgup", "b"), key.WithHelp("pgup/b", "page up"))
+	pageDownKey  = key.NewBinding(key.WithKeys("pgdown", " ", "f"), key.WithHelp("pgdown/space", "page down"))
+	halfUpKey    = key.NewBinding(key.WithKeys("ctrl+u"), key.WithHelp("ctrl+u", "half page up"))
+	halfDownKey  = key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("ctrl+d", "half page down"))
+	leftKey      = key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "scroll left"))
+	rightKey     = key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "scroll right"))
+	homeKey      = key.NewBinding(key.WithKeys("home", "g"), key.WithHelp("home/g", "top"))
+	endKey       = key.NewBinding(key.WithKeys("end", "G"), key.WithHelp("end/G", "bottom"))
+	wrapKey      = key.NewBinding(key.WithKeys("w"), key.WithHelp("w", "toggle wrapping"))
+	searchKey    = key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "search"))
+	nextMatchKey = key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "next match"))
+	prevMatchKey = key.NewBinding(key.WithKeys("N"), key.WithHelp("N", "previous match"))
+	closeKey     = key.NewBinding(key.WithKeys("q"), key.WithHelp("q", "close"))
+)
+
+// tabWidth is the distance between tab stops.
+const tabWidth = 8
+
+// scrollStep is the number of columns scrolled horizontally at once.
+const scrollStep = 8
+
+// escapeSequence matches ANSI escape sequences, which are removed from the text.
+var escapeSequence = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]`)
+
+// row is a line of the text as shown on the screen; a wrapped line consists of multiple rows.
+type row struct {
+	line  int    // line is the index of the line the row belongs to.
+	text  string // text is the part of the line shown in the row.
+	first bool   // first determines if the row is the first row of the line.
+}
+
+// Model represents a pager showing a long text, like less. The text can be scrolled vertically and horizontally or
+// wrapped, and searched with the matches highlighted.
+type Model struct {
+	title          string                           // title is shown above the text.
+	lines          []string                         // lines are the lines of the text.
+	textWidth      int                              // textWidth is the width of the widest line.
+	rows           []row                            // rows are the rows of the text, see layout.
+	rowsWidth      int                              // rowsWidth is the width the rows were laid out for, 0 if outdated.
+	rowsWrap       bool                             // rowsWrap is the wrap flag the rows were laid out for.
+	offset         int                              // offset is the index of the first visible row.
+	xOffset        int                              // xOffset is the first visible column if lines are not wrapped.
+	wrap           bool                             // wrap determines if long lines are wrapped.
+	lineNumbers    bool                             // lineNumbers determines if line numbers are shown.
+	height         int                              // height is the number of visible rows, 0 to fill the window.
+	width          int                              // width is the available width, updated from window size messages.
+	winHeight      int                              // winHeight is the window height, updated from window size messages.
+	lineStyle      func(line string) lipgloss.Style // lineStyle returns the style of a line, nil for the Text style.
+	searching      bool                             // searching determines if a search pattern is being entered.
+	query          string                           // query is the search text being entered.
+	term           string                           // term is the text of the active search.
+	pattern        *regexp.Regexp                   // pattern is the pattern of the active search, nil if none.
+	matches        []int                            // matches holds the indexes of the lines matching the pattern.
+	match          int                              // match is the index of the current match in matches.
+	quitable       bool                             // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption              // programOptions are passed to the program running the model
+	embedded       bool                             // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                             // focused determines if the model handles key messages
+	keymap         ui.KeyMap                        // keymap holds the key bindings of the model.
+	styles         Styles                           // styles holds the styles of the model.
+	customStyles   bool                             // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error                            // err is shown below the text
+
+	canceled bool // canceled indicates whether the pager was canceled
+	quit     bool // quit indicates whether the pager was quit
+}
+
+// New creates and returns a new Model showing the given text with the given title, configured by the given options.
+// Tabs are expanded and ANSI escape sequences are removed.
+func New(title, text string, opts ...Option) *Model {
+	m := &Model{
+		title:     title,
+		width:     80,
+		winHeight: 24,
+		quitable:  true,
+		focused:   true,
+		keymap:    ui.DefaultKeyMap(),
+		styles:    DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	m.SetText(text)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithTitle sets the title of the Model and returns a new Model with the updated title.
+func (m *Model) WithTitle(title string) *Model {
+	return m.With(Title(title))
+}
+
+// WithWrap sets whether long lines are wrapped and returns a new Model with the updated flag.
+func (m *Model) WithWrap(wrap bool) *Model {
+	return m.With(Wrap(wrap))
+}
+
+// WithLineNumbers sets whether line numbers are shown and returns a new Model with the updated flag.
+func (m *Model) WithLineNumbers(show bool) *Model {
+	return m.With(LineNumbers(show))
+}
+
+// WithHeight sets the number of visible rows, 0 to fill the window, and returns a new Model with the updated height.
+func (m *Model) WithHeight(height int) *Model {
+	return m.With(Height(height))
+}
+
+// WithLineStyle sets the function returning the style of a line, e.g. to color the lines of a diff, and returns a
+// new Model with the updated function.
+func (m *Model) WithLineStyle(fn func(line string) lipgloss.Style) *Model {
+	return m.With(LineStyle(fn))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// SetText replaces the text shown by the pager, keeping the scroll position if possible.
+func (m *Model) SetText(text string) {
+	m.SetLines(strings.Split(strings.TrimSuffix(text, "\n"), "\n"))
+}
+
+// SetLines replaces the lines shown by the pager, keeping the scroll position if possible.
+func (m *Model) SetLines(lines []string) {
+	m.lines = make([]string, len(lines))
+	m.textWidth = 0
+	for i, line := range lines {
+		m.lines[i] = sanitize(line)
+		m.textWidth = max(m.textWidth, styles.Width(m.lines[i]))
+	}
+	m.rowsWidth = 0
+	m.matches = nil
+	m.findMatches(0)
+	m.clamp()
+}
+
+// AppendLines appends lines to the text, keeping the scroll position.
+func (m *Model) AppendLines(lines ...string) {
+	start := len(m.lines)
+	for _, line := range lines {
+		line = sanitize(line)
+		m.lines = append(m.lines, line)
+		m.textWidth = max(m.textWidth, styles.Width(line))
+	}
+	if m.rowsWidth > 0 {
+		m.addRows(start, m.rowsWidth)
+	}
+	m.findMatches(start)
+}
+
+// Lines returns the lines of the text.
+func (m *Model) Lines() []string {
+	return m.lines
+}
+
+// sanitize removes ANSI escape sequences and carriage returns from the line and expands tabs.
+func sanitize(line string) string {
+	line = escapeSequence.ReplaceAllString(line, "")
+	if !strings.ContainsAny(line, "\t\r") {
+		return line
+	}
+	var b strings.Builder
+	col := 0
+	styles.Graphemes(line, func(_ int, g string, w int) bool {
+		switch g {
+		case "\r":
+		case "\t":
+			n := tabWidth - col%tabWidth
+			b.WriteString(strings.Repeat(" ", n))
+			col += n
+		default:
+			b.WriteString(g)
+			col += w
+		}
+		return true
+	})
+	return b.String()
+}
+
+// cut returns the part of s between the display columns from and from+width. Wide characters cut in half are
+// replaced by spaces.
+func cut(s string, from, width int) string {
+	var b strings.Builder
+	col := 0
+	styles.Graphemes(s, func(_ int, g string, w int) bool {
+		switch {
+		case col < from && col+w <= from:
+		case col < from:
+			b.WriteString(strings.Repeat(" ", min(col+w-from, width)))
+		case col+w > from+width:
+			b.WriteString(strings.Repeat(" ", max(0, from+width-col)))
+			return false
+		default:
+			b.WriteString(g)
+		}
+		col += w
+		return true
+	})
+	return b.String()
+}
+
+// wrapLine splits s into parts of at most width display columns.
+func wrapLine(s string, width int) []string {
+	if width <= 0 || styles.Width(s) <= width {
+		return []string{s}
+	}
+	var parts []string
+	var b strings.Builder
+	col := 0
+	styles.Graphemes(s, func(_ int, g string, w int) bool {
+		if col+w > width && col > 0 {
+			parts = append(parts, b.String())
+			b.Reset()
+			col = 0
+		}
+		b.WriteString(g)
+		col += w
+		return true
+	})
+	return append(parts, b.String())
+}
+
+// gutterWidth returns the width of the line numbers including the following space, 0 if they are not shown.
+func (m *Model) gutterWidth() int {
+	if !m.lineNumbers {
+		return 0
+	}
+	return len(strconv.Itoa(len(m.lines))) + 1
+}
+
+// contentWidth returns the number of columns available for the text.
+func (m *Model) contentWidth() int {
+	return max(1, m.width-m.gutterWidth())
+}
+
+// layout splits the lines into rows, wrapping them if enabled. The rows are only recomputed if the text or the
+// width changed.
+func (m *Model) layout() {
+	width := m.contentWidth()
+	if m.rowsWidth == width && m.rowsWrap == m.wrap {
+		return
+	}
+	m.rows = nil
+	m.addRows(0, width)
+	m.rowsWidth, m.rowsWrap = width, m.wrap
+}
+
+// addRows appends the rows of the lines starting at the given index, wrapped to the given width if enabled.
+func (m *Model) addRows(start, width int) {
+	for i := start; i < len(m.lines); i++ {
+		if !m.wrap {
+			m.rows = append(m.rows, row{line: i, text: m.lines[i], first: true})
+			continue
+		}
+		for j, part := range wrapLine(m.lines[i], width) {
+			m.rows = append(m.rows, row{line: i, text: part, first: j == 0})
+		}
+	}
+}
+
+// viewHeight returns the number of visible rows.
+func (m *Model) viewHeight() int {
+	if m.height > 0 {
+		return m.height
+	}
+	h := m.winHeight - 1 // status line
+	if m.title != "" {
+		h--
+	}
+	if m.err != nil {
+		h--
+	}
+	return max(1, h)
+}
+
+// maxOffset returns the largest offset, at which the last row is shown at the bottom.
+func (m *Model) maxOffset() int {
+	m.layout()
+	return max(0, len(m.rows)-m.viewHeight())
+}
+
+// clamp keeps the offsets within the text.
+func (m *Model) clamp() {
+	m.offset = min(max(m.offset, 0), m.maxOffset())
+	if m.wrap {
+		m.xOffset = 0
+	} else {
+		m.xOffset = min(max(m.xOffset, 0), max(0, m.textWidth-m.contentWidth()))
+	}
+}
+
+// ScrollPercent returns the position of the visible part within the text, from 0 at the top to 1 at the bottom.
+func (m *Model) ScrollPercent() float64 {
+	if maxOffset := m.maxOffset(); maxOffset > 0 {
+		return float64(m.offset) / float64(maxOffset)
+	}
+	return 1
+}
+
+// AtBottom returns whether the end of the text is visible.
+func (m *Model) AtBottom() bool {
+	return m.offset >= m.maxOffset()
+}
+
+// GotoTop scrolls to the top of the text.
+func (m *Model) GotoTop() {
+	m.offset = 0
+}
+
+// GotoBottom scrolls to the end of the text.
+func (m *Model) GotoBottom() {
+	m.offset = m.maxOffset()
+}
+
+// ScrollBy scrolls down by n rows, or up if n is negative.
+func (m *Model) ScrollBy(n int) {
+	m.offset += n
+	m.clamp()
+}
+
+// Search searches for the given text, highlighting all matches and scrolling to the first match at or below the top
+// of the visible part. The search ignores case unless the text contains upper-case letters. An empty text clears the
+// search. It returns the number of matching lines.
+func (m *Model) Search(text string) int {
+	m.term = text
+	m.matches = nil
+	if text == "" {
+		m.pattern = nil
+		return 0
+	}
+	expr := regexp.QuoteMeta(text)
+	if strings.IndexFunc(text, unicode.IsUpper) < 0 {
+		expr = "(?i)" + expr
+	}
+	m.pattern = regexp.MustCompile(expr)
+	m.findMatches(0)
+	if len(m.matches) == 0 {
+		return 0
+	}
+
+	top := m.topLine()
+	m.match = 0
+	for i, line := range m.matches {
+		if line >= top {
+			m.match = i
+			break
+		}
+	}
+	m.showMatch()
+	return len(m.matches)
+}
+
+// findMatches adds the indexes of the lines starting at the given index that match the search pattern.
+func (m *Model) findMatches(start int) {
+	if m.pattern == nil {
+		return
+	}
+	for i := start; i < len(m.lines); i++ {
+		if m.pattern.MatchString(m.lines[i]) {
+			m.matches = append(m.matches, i)
+		}
+	}
+	m.match = min(m.match, max(0, len(m.matches)-1))
+}
+
+// nextMatch moves to the next match in the given direction, wrapping around at the end of the text.
+func (m *Model) nextMatch(dir int) {
+	if len(m.matches) == 0 {
+		return
+	}
+	m.match = (m.match + dir + len(m.matches)) % len(m.matches)
+	m.showMatch()
+}
+
+// rowOf returns the index of the first row of the line with the given index.
+func (m *Model) rowOf(line int) int {
+	m.layout()
+	for i, r := range m.rows {
+		if r.line >= line {
+			return i
+		}
+	}
+	return len(m.rows)
+}
+
+// topLine returns the index of the line shown at the top.
+func (m *Model) topLine() int {
+	m.layout()
+	if m.offset < len(m.rows) {
+		return m.rows[m.offset].line
+	}
+	return 0
+}
+
+// relayout applies a change of the layout by fn, keeping the line shown at the top.
+func (m *Model) relayout(fn func()) {
+	line := m.topLine()
+	fn()
+	m.offset = m.rowOf(line)
+	m.clamp()
+}
+
+// showMatch scrolls the current match into view.
+func (m *Model) showMatch() {
+	line := m.matches[m.match]
+	first := m.rowOf(line)
+	if h := m.viewHeight(); first < m.offset || first >= m.offset+h {
+		m.offset = first - h/3
+	}
+	if !m.wrap {
+		loc := m.pattern.FindStringIndex(m.lines[line])
+		start := styles.Width(m.lines[line][:loc[0]])
+		end := styles.Width(m.lines[line][:loc[1]])
+		if width := m.contentWidth(); start < m.xOffset || end > m.xOffset+width {
+			m.xOffset = start - width/3
+		}
+	}
+	m.clamp()
+}
+
+// Focus focuses the model, so that it handles key messages. It returns no command and exists for compatibility with
+// other focusable models.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the text. It implements ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag. A pager cannot be canceled, it is only closed.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns a nil command.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// updateSearch handles key messages while a search pattern is entered.
+func (m *Model) updateSearch(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.searching = false
+		m.Search(m.query)
+	case tea.KeyEsc:
+		m.searching = false
+	case tea.KeyBackspace:
+		if m.query == "" {
+			m.searching = false
+		} else {
+			r := []rune(m.query)
+			m.query = string(r[:len(r)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.query += string(msg.Runes)
+	case tea.KeyCtrlC:
+		if m.quitable {
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m.done()
+		}
+	}
+	return nil
+}
+
+// Update handles window size and key messages, scrolling the text, searching it and closing the pager.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.relayout(func() { m.width, m.winHeight = msg.Width, msg.Height })
+		return m, nil
+	case tea.KeyMsg:
+		if !m.focused {
+			return m, nil
+		}
+		if m.searching {
+			return m, m.updateSearch(msg)
+		}
+		h := m.viewHeight()
+		switch {
+		case key.Matches(msg, lineUpKey):
+			m.ScrollBy(-1)
+		case key.Matches(msg, lineDownKey):
+			m.ScrollBy(1)
+		case key.Matches(msg, pageUpKey):
+			m.ScrollBy(-h)
+		case key.Matches(msg, pageDownKey):
+			m.ScrollBy(h)
+		case key.Matches(msg, halfUpKey):
+			m.ScrollBy(-h / 2)
+		case key.Matches(msg, halfDownKey):
+			m.ScrollBy(h / 2)
+		case key.Matches(msg, leftKey):
+			m.xOffset -= scrollStep
+			m.clamp()
+		case key.Matches(msg, rightKey):
+			m.xOffset += scrollStep
+			m.clamp()
+		case key.Matches(msg, homeKey):
+			m.GotoTop()
+		case key.Matches(msg, endKey):
+			m.GotoBottom()
+		case key.Matches(msg, wrapKey):
+			m.relayout(func() { m.wrap = !m.wrap })
+		case key.Matches(msg, searchKey):
+			m.searching, m.query = true, ""
+		case msg.Type == tea.KeyRunes && len(msg.Runes) > 1 && msg.Runes[0] == '/':
+			// Fast typing can deliver the search key and the text in a single message.
+			m.searching, m.query = true, string(msg.Runes[1:])
+		case key.Matches(msg, nextMatchKey):
+			m.nextMatch(1)
+		case key.Matches(msg, prevMatchKey):
+			m.nextMatch(-1)
+		case key.Matches(msg, m.keymap.Cancel) && m.pattern != nil:
+			m.Search("")
+		case key.Matches(msg, closeKey), key.Matches(msg, m.keymap.Confirm), key.Matches(msg, m.keymap.Cancel):
+			m.canceled, m.quit = false, false
+			return m, m.done()
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, m.done()
+			}
+		}
+	}
+	return m, nil
+}
+
+// highlight renders the text of a row, highlighting the matches of the search pattern.
+func (m *Model) highlight(text string, style, matchStyle lipgloss.Style) string {
+	if m.pattern == nil {
+		return style.Render(text)
+	}
+	var b strings.Builder
+	pos := 0
+	for _, loc := range m.pattern.FindAllStringIndex(text, -1) {
+		if loc[0] > pos {
+			b.WriteString(style.Render(text[pos:loc[0]]))
+		}
+		b.WriteString(matchStyle.Render(text[loc[0]:loc[1]]))
+		pos = loc[1]
+	}
+	if pos < len(text) {
+		b.WriteString(style.Render(text[pos:]))
+	}
+	return b.String()
+}
+
+// status returns the text of the status line: the search prompt or result, and the position within the text.
+func (m *Model) status() string {
+	var left string
+	switch {
+	case m.searching:
+		left = m.styles.Prompt.Render("/") + m.query + m.styles.Prompt.Render("█")
+	case m.pattern != nil && len(m.matches) == 0:
+		left = m.styles.Status.Render(fmt.Sprintf("Pattern not found: %s", m.term))
+	case m.pattern != nil:
+		left = m.styles.Status.Render(fmt.Sprintf("%s: match %d of %d (n/N)", m.term, m.match+1, len(m.matches)))
+	default:
+		left = m.styles.Status.Render("q close · / search · w wrap")
+	}
+
+	m.layout()
+	first, last := 0, 0
+	if len(m.rows) > 0 {
+		first = m.rows[m.offset].line + 1
+		last = m.rows[min(len(m.rows), m.offset+m.viewHeight())-1].line + 1
+	}
+	right := m.styles.Status.Render(fmt.Sprintf("lines %d-%d of %d  %3.0f%%", first, last, len(m.lines),
+		m.ScrollPercent()*100))
+
+	gap := max(2, m.width-lipgloss.Width(left)-lipgloss.Width(right))
+	return left + strings.Repeat(" ", gap) + right
+}
+
+// View renders the title, the visible rows with their line numbers and the status line.
+func (m *Model) View() string {
+	m.layout()
+	var b strings.Builder
+	if m.title != "" {
+		fmt.Fprintf(&b, "%s\n", m.styles.Title.Render(m.title))
+	}
+
+	current := -1
+	if m.pattern != nil && len(m.matches) > 0 {
+		current = m.matches[m.match]
+	}
+	gutter := m.gutterWidth()
+	width := m.contentWidth()
+	h := m.viewHeight()
+	for i := m.offset; i < m.offset+h; i++ {
+		if i >= len(m.rows) {
+			b.WriteString("\n")
+			continue
+		}
+		r := m.rows[i]
+		if gutter > 0 {
+			number := ""
+			if r.first {
+				number = strconv.Itoa(r.line + 1)
+			}
+			b.WriteString(m.styles.LineNumber.Render(fmt.Sprintf("%*s ", gutter-1, number)))
+		}
+
+		text := r.text
+		if !m.wrap {
+			text = cut(text, m.xOffset, width)
+		}
+		style := m.styles.Text
+		if m.lineStyle != nil {
+			style = m.lineStyle(m.lines[r.line])
+		}
+		matchStyle := m.styles.Match
+		if r.line == current {
+			matchStyle = m.styles.CurrentMatch
+		}
+		fmt.Fprintf(&b, "%s\n", m.highlight(text, style, matchStyle))
+	}
+
+	b.WriteString(m.status())
+	if m.err != nil {
+		fmt.Fprintf(&b, "\n%s", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return b.String()
+}
+
+// Run shows the pager until the user closes it. It returns ui.QuitError if quitting was requested.
+func (m *Model) Run(ctx context.Context) error {
+	return ui.RunContext(ctx, m, m.programOptions...)
+}
+
+// RunAccessible writes the text to out without paging. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	if m.title != "" {
+		fmt.Fprintln(out, m.title)
+	}
+	for _, line := range m.lines {
+		if _, err := fmt.Fprintln(out, line); err != nil {
+			return err
+		}
+	}
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Show shows the given text with the given title in a pager until the user closes it.
+func Show(ctx context.Context, title, text string) error {
+	return New(title, text).Run(ctx)
+}
+
+// Showcase demonstrates the Model component with a generated text containing long lines.
+func Showcase() {
+	fmt.Println("=== Pager Showcase ===")
+
+	var b strings.Builder
+	for i := 1; i <= 200; i++ {
+		fmt.Fprintf(&b, "Line %d:\tThe quick brown fox jumps over the lazy dog.", i)
+		if i%10 == 0 {
+			b.WriteString(strings.Repeat(" This line is long enough to scroll horizontally or to be wrapped.", 3))
+		}
+		b.WriteString("\n")
+	}
+	m := New("Generated text (/ searches, e.g. for \"fox\", w toggles wrapping)", b.String()).
+		WithLineNumbers(true).WithHeight(15)
+	if err := m.Run(context.Background()); err != nil {
+		ui.Handle(err, ui.HandleOptions{})
+	}
+}
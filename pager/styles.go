@@ -0,0 +1,44 @@
+package pager
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Title        lipgloss.Style // Title is the style of the title above the text.
+	Text         lipgloss.Style // Text is the style of the text.
+	LineNumber   lipgloss.Style // LineNumber is the style of the line numbers.
+	Match        lipgloss.Style // Match is the style of the matches of the search.
+	CurrentMatch lipgloss.Style // CurrentMatch is the style of the matches in the line of the current match.
+	Status       lipgloss.Style // Status is the style of the status line below the text.
+	Prompt       lipgloss.Style // Prompt is the style of the search prompt.
+	Error        lipgloss.Style // Error is the style of the error shown below the text.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Title:        lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Text:         lipgloss.NewStyle().Foreground(t.Text),
+		LineNumber:   lipgloss.NewStyle().Faint(true),
+		Match:        lipgloss.NewStyle().Reverse(true),
+		CurrentMatch: lipgloss.NewStyle().Foreground(t.Accent).Reverse(true).Bold(true),
+		Status:       lipgloss.NewStyle().Faint(true),
+		Prompt:       lipgloss.NewStyle().Foreground(t.Accent),
+		Error:        t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
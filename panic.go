@@ -0,0 +1,181 @@
+package ui
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// PanicError is returned by Run when the model panicked. The program is shut down regularly before, so the terminal
+// is restored (alternate screen left, cursor shown, mouse disabled).
+type PanicError struct {
+	Value any    // Value is the value passed to panic.
+	Stack []byte // Stack is the stack trace of the panicking goroutine.
+}
+
+// Error returns the panic value as an error message.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// panicMsg is sent when a command panicked.
+type panicMsg struct {
+	err *PanicError
+}
+
+// safeModel wraps a model, converting panics in its methods and commands into quitting the program.
+type safeModel struct {
+	tea.Model
+	program   *tea.Program  // program is the program running the model.
+	recording *recording    // recording records frames and input events, if enabled.
+	debugger  *debugger     // debugger shows the debug overlay, if enabled.
+	mouse     *mouse        // mouse translates mouse events, if enabled.
+	keys      keyNormalizer // keys translates platform-specific key quirks.
+	memory    *memory       // memory offers the remembered answer, if enabled.
+
+	mu  sync.Mutex
+	err *PanicError // err is set when the model panicked.
+}
+
+// newSafeModel wraps the model, enabling recording and the debug overlay as configured by the settings.
+func newSafeModel(m tea.Model, s *settings) (*safeModel, error) {
+	sm := &safeModel{Model: m}
+	var err error
+	if s.record != "" {
+		if sm.recording, err = createRecording(s.record); err != nil {
+			return nil, err
+		}
+	}
+	if s.debug {
+		if sm.debugger, err = newDebugger(); err != nil {
+			sm.recording.close()
+			return nil, err
+		}
+	}
+	sm.mouse = newMouse(s)
+	sm.memory = s.memory
+	return sm, nil
+}
+
+// close closes the files of the recording and the debug overlay, and stops marking zones.
+func (s *safeModel) close() error {
+	s.mouse.close()
+	err := s.recording.close()
+	if derr := s.debugger.close(); err == nil {
+		err = derr
+	}
+	return err
+}
+
+// newPanicError returns a PanicError for the recovered value, including the current stack trace.
+func newPanicError(v any) *PanicError {
+	return &PanicError{Value: v, Stack: debug.Stack()}
+}
+
+// panicked stores the first panic and returns the command quitting the program.
+func (s *safeModel) panicked(err *PanicError) tea.Cmd {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		s.err = err
+	}
+	return tea.Quit
+}
+
+// panicErr returns the first panic, if any.
+func (s *safeModel) panicErr() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		return nil
+	}
+	return s.err
+}
+
+// Init calls the Init method of the model.
+func (s *safeModel) Init() (cmd tea.Cmd) {
+	defer func() {
+		if r := recover(); r != nil {
+			cmd = s.panicked(newPanicError(r))
+		}
+	}()
+	return safeCmd(s.Model.Init())
+}
+
+// Update normalizes keys, translates mouse events if enabled, handles the key forgetting a remembered answer and calls
+// the Update method of the model.
+func (s *safeModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(panicMsg); ok {
+		return s, s.panicked(msg.err)
+	}
+
+	s.recording.input(msg)
+	if msg, ok := msg.(tea.KeyMsg); ok && s.debugger.key(msg) {
+		return s, nil
+	}
+
+	msgs, kcmd := s.keys.update(msg)
+	cmds := []tea.Cmd{kcmd}
+	for _, msg := range msgs {
+		msgs, mcmd := s.mouse.update(msg)
+		cmds = append(cmds, mcmd)
+		for _, msg := range msgs {
+			if !s.memory.handle(msg) {
+				cmds = append(cmds, s.update(msg))
+			}
+		}
+	}
+	return s, tea.Batch(cmds...)
+}
+
+// update calls the Update method of the model.
+func (s *safeModel) update(msg tea.Msg) (cmd tea.Cmd) {
+	defer func() {
+		if r := recover(); r != nil {
+			cmd = s.panicked(newPanicError(r))
+		}
+	}()
+	s.Model, cmd = s.Model.Update(msg)
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		s.debugger.handled(msg, s.Model)
+	}
+	return safeCmd(s.debugger.cmd(cmd))
+}
+
+// View calls the View method of the model. As View cannot return a command, the program is quit asynchronously.
+func (s *safeModel) View() (view string) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.panicked(newPanicError(r))
+			go s.program.Quit()
+			view = ""
+		}
+	}()
+	view = s.mouse.view(s.debugger.view(s.memory.view(s.Model.View()), s.Model))
+	s.recording.view(view)
+	return view
+}
+
+// safeCmd wraps the command so that a panic while executing it is reported as a panicMsg.
+func safeCmd(cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() (msg tea.Msg) {
+		defer func() {
+			if r := recover(); r != nil {
+				msg = panicMsg{err: newPanicError(r)}
+			}
+		}()
+
+		msg = cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			for i, cmd := range batch {
+				batch[i] = safeCmd(cmd)
+			}
+		}
+		return msg
+	}
+}
@@ -0,0 +1,121 @@
+package password
+
+import (
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Label sets the label shown above the password.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Confirm sets whether the password is asked a second time, e.g. when choosing a new password.
+func Confirm(confirm bool) Option {
+	return func(m *Model) {
+		m.confirm = confirm
+	}
+}
+
+// ShowStrength sets whether the estimated strength of the password is shown while typing. It is enabled by default.
+func ShowStrength(show bool) Option {
+	return func(m *Model) {
+		m.showStrength = show
+	}
+}
+
+// MinStrength sets the minimum estimated strength of accepted passwords, see Rate.
+func MinStrength(s Strength) Option {
+	return func(m *Model) {
+		m.minStrength = s
+	}
+}
+
+// MinLength sets the minimum number of characters of accepted passwords.
+func MinLength(n int) Option {
+	return func(m *Model) {
+		m.minLength = n
+	}
+}
+
+// Validator sets the validator the password must pass before it is accepted.
+func Validator(v ui.Validator[[]byte]) Option {
+	return func(m *Model) {
+		m.validator = v
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// TextKeyMap sets the editing keys of the password inputs, overriding the default text key map.
+func TextKeyMap(km textinput.KeyMap) Option {
+	return func(m *Model) {
+		m.passwordInput.KeyMap = km
+		m.confirmInput.KeyMap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
@@ -0,0 +1,566 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"       // Manages key bindings
+	"github.com/charmbracelet/bubbles/textinput" // Provides text input model
+	tea "github.com/charmbracelet/bubbletea"     // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/answer"
+	"github.com/nmeilick/go-ui/internal/plain"
+)
+
+var _ ui.Prompt[[]byte] = (*Model)(nil)
+
+var (
+	revealKey = key.NewBinding(key.WithKeys("ctrl+r"), key.WithHelp("ctrl+r", "reveal"))
+	backKey   = key.NewBinding(key.WithKeys("up", "shift+tab"), key.WithHelp("↑", "back to password"))
+)
+
+// ErrMismatch is returned when the confirmation differs from the password.
+var ErrMismatch = errors.New("passwords do not match")
+
+// Strength is the estimated strength of a password.
+type Strength int
+
+const (
+	VeryWeak Strength = iota // VeryWeak passwords are short or consist of few distinct characters.
+	Weak                     // Weak passwords are short or use one kind of characters.
+	Fair                     // Fair passwords are of medium length and mix kinds of characters.
+	Good                     // Good passwords are long and mix kinds of characters.
+	Strong                   // Strong passwords are very long and mix kinds of characters.
+)
+
+// String returns the name of the strength.
+func (s Strength) String() string {
+	switch s {
+	case VeryWeak:
+		return "very weak"
+	case Weak:
+		return "weak"
+	case Fair:
+		return "fair"
+	case Good:
+		return "good"
+	default:
+		return "strong"
+	}
+}
+
+// Rate estimates the strength of the password from its length, the kinds of characters it mixes (lower case, upper
+// case, digits and others) and the number of distinct characters. It is a rough estimate meant for feedback while
+// typing, not a replacement for checking passwords against lists of leaked passwords.
+func Rate(pw []byte) Strength {
+	var lower, upper, digit, other bool
+	distinct := map[rune]bool{}
+	n := 0
+	for _, r := range string(pw) {
+		n++
+		distinct[r] = true
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+	kinds := 0
+	for _, b := range []bool{lower, upper, digit, other} {
+		if b {
+			kinds++
+		}
+	}
+
+	score := 0
+	switch {
+	case n >= 16:
+		score = 3
+	case n >= 12:
+		score = 2
+	case n >= 8:
+		score = 1
+	}
+	score += max(0, kinds-1)
+	if len(distinct) < 5 || len(distinct) < n/3 {
+		score -= 2
+	}
+	return Strength(max(int(VeryWeak), min(int(Strong), score-1)))
+}
+
+// Model represents a password prompt. The password is masked while typing unless revealed with ctrl+r, its strength
+// is shown, and it can be asked a second time for confirmation. The password is returned as a byte slice, which the
+// caller can overwrite once it is no longer needed.
+type Model struct {
+	label          string               // label is shown above the password.
+	passwordInput  textinput.Model      // passwordInput reads the password.
+	confirmInput   textinput.Model      // confirmInput reads the confirmation of the password.
+	confirm        bool                 // confirm determines if the password is asked a second time.
+	confirming     bool                 // confirming indicates whether the confirmation is being entered.
+	revealed       bool                 // revealed determines if the password is shown in clear text.
+	showStrength   bool                 // showStrength determines if the strength of the password is shown.
+	minStrength    Strength             // minStrength is the minimum strength of accepted passwords.
+	minLength      int                  // minLength is the minimum number of characters of accepted passwords.
+	validator      ui.Validator[[]byte] // validator validates the password before it is accepted
+	value          []byte               // value is the accepted password.
+	cancelable     bool                 // cancelable determines if input can be canceled with escape key
+	quitable       bool                 // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption  // programOptions are passed to the program running the model
+	id             string               // id identifies the prompt, e.g. for preset answers
+	embedded       bool                 // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                 // focused determines if the model handles key messages
+	keymap         ui.KeyMap            // keymap holds the key bindings of the model.
+	styles         Styles               // styles holds the styles of the model.
+	customStyles   bool                 // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error                // err is shown below the model, e.g. why the password was rejected
+
+	canceled bool // canceled indicates whether the input was canceled
+	quit     bool // quit indicates whether the input was quit
+}
+
+// newInput returns a masked text input.
+func newInput() textinput.Model {
+	ti := textinput.New()
+	ti.KeyMap = ui.DefaultTextKeyMap()
+	ti.Prompt = ""
+	ti.EchoMode = textinput.EchoPassword
+	ti.EchoCharacter = '*'
+	ti.CharLimit = 256
+	ti.Width = 40
+	return ti
+}
+
+// New creates and returns a new Model with the given label, configured by the given options.
+func New(label string, opts ...Option) *Model {
+	m := &Model{
+		label:         label,
+		passwordInput: newInput(),
+		confirmInput:  newInput(),
+		showStrength:  true,
+		cancelable:    true,
+		quitable:      true,
+		focused:       true,
+		keymap:        ui.DefaultKeyMap(),
+		styles:        DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.passwordInput.Focus()
+	return m
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithConfirm sets whether the password is asked a second time and returns a new Model with the updated flag.
+func (m *Model) WithConfirm(confirm bool) *Model {
+	return m.With(Confirm(confirm))
+}
+
+// WithShowStrength sets whether the strength of the password is shown and returns a new Model with the updated flag.
+func (m *Model) WithShowStrength(show bool) *Model {
+	return m.With(ShowStrength(show))
+}
+
+// WithMinStrength sets the minimum strength of accepted passwords and returns a new Model with the updated strength.
+func (m *Model) WithMinStrength(s Strength) *Model {
+	return m.With(MinStrength(s))
+}
+
+// WithMinLength sets the minimum number of characters of accepted passwords and returns a new Model with the updated
+// length.
+func (m *Model) WithMinLength(n int) *Model {
+	return m.With(MinLength(n))
+}
+
+// WithValidator sets the validator the password must pass before it is accepted and returns a new Model with the
+// updated validator.
+func (m *Model) WithValidator(v ui.Validator[[]byte]) *Model {
+	return m.With(Validator(v))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Value returns the accepted password, or nil if none was accepted yet. The slice is shared with the model, so
+// overwriting it also clears the model's copy.
+func (m *Model) Value() []byte {
+	return m.value
+}
+
+// Strength returns the estimated strength of the entered password.
+func (m *Model) Strength() Strength {
+	return Rate([]byte(m.passwordInput.Value()))
+}
+
+// check returns an error if the password is not acceptable.
+func (m *Model) check(pw []byte) error {
+	switch {
+	case len(pw) == 0:
+		return errors.New("password is empty")
+	case len([]rune(string(pw))) < m.minLength:
+		return fmt.Errorf("password must have at least %d characters", m.minLength)
+	case Rate(pw) < m.minStrength:
+		return fmt.Errorf("password is too weak: %s, at least %s is required", Rate(pw), m.minStrength)
+	}
+	if m.validator != nil {
+		return m.validator.Validate(pw)
+	}
+	return nil
+}
+
+// accept stores the password and clears the text inputs.
+func (m *Model) accept(pw []byte) {
+	m.value = pw
+	m.passwordInput.SetValue("")
+	m.confirmInput.SetValue("")
+	m.confirming, m.err = false, nil
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is the password as a string or byte slice. It must meet the same
+// requirements as a typed password; no confirmation is asked. It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	var pw []byte
+	if b, ok := v.([]byte); ok {
+		pw = append([]byte(nil), b...)
+	} else {
+		pw = []byte(answer.String(v))
+	}
+	if err := m.check(pw); err != nil {
+		return err
+	}
+	m.accept(pw)
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the accepted password as a string. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	if m.value == nil {
+		return nil
+	}
+	return string(m.value)
+}
+
+// Secret reports that the answer must not be revealed. It implements ui.SecretModel.
+func (m *Model) Secret() bool {
+	return true
+}
+
+// Focus focuses the model, so that it handles key messages, and returns the command starting the cursor blink.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	if m.confirming {
+		return m.confirmInput.Focus()
+	}
+	return m.passwordInput.Focus()
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+	m.passwordInput.Blur()
+	m.confirmInput.Blur()
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns the command starting the cursor blink.
+func (m *Model) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// setRevealed shows or masks the password and its confirmation.
+func (m *Model) setRevealed(revealed bool) {
+	m.revealed = revealed
+	mode := textinput.EchoPassword
+	if revealed {
+		mode = textinput.EchoNormal
+	}
+	m.passwordInput.EchoMode, m.confirmInput.EchoMode = mode, mode
+}
+
+// UsesKey reports whether the prompt handles the key itself, like shift+tab returning from the confirmation to the
+// password. It implements ui.KeyModel.
+func (m *Model) UsesKey(msg tea.KeyMsg) bool {
+	return m.confirming && key.Matches(msg, backKey)
+}
+
+// Update handles key messages, editing the password and its confirmation.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if !m.focused {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, revealKey):
+			m.setRevealed(!m.revealed)
+			return m, nil
+		case m.confirming && key.Matches(msg, backKey):
+			m.confirming, m.err = false, nil
+			m.confirmInput.SetValue("")
+			m.confirmInput.Blur()
+			return m, m.passwordInput.Focus()
+		case key.Matches(msg, m.keymap.Confirm):
+			pw := []byte(m.passwordInput.Value())
+			if !m.confirming {
+				if m.err = m.check(pw); m.err != nil {
+					ui.RecordValidationFailure(m.Key(), m.err)
+					return m, nil
+				}
+				if m.confirm {
+					m.confirming = true
+					m.passwordInput.Blur()
+					return m, m.confirmInput.Focus()
+				}
+			} else if m.confirmInput.Value() != string(pw) {
+				m.err = ErrMismatch
+				m.confirmInput.SetValue("")
+				return m, nil
+			}
+			m.accept(pw)
+			m.canceled, m.quit = false, false
+			return m, m.done()
+		case key.Matches(msg, m.keymap.Cancel):
+			if m.cancelable {
+				m.passwordInput.SetValue("")
+				m.confirmInput.SetValue("")
+				m.canceled, m.quit = true, false
+				return m, m.done()
+			}
+			return m, nil
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.passwordInput.SetValue("")
+				m.confirmInput.SetValue("")
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, m.done()
+			}
+			return m, nil
+		}
+		m.err = nil
+	}
+
+	var cmd tea.Cmd
+	if m.confirming {
+		m.confirmInput, cmd = m.confirmInput.Update(msg)
+	} else {
+		m.passwordInput, cmd = m.passwordInput.Update(msg)
+	}
+	return m, cmd
+}
+
+// meter renders the strength of the entered password as a bar followed by its name.
+func (m *Model) meter() string {
+	g := ui.Glyphs()
+	s := m.Strength()
+	style := m.styles.Weak
+	switch {
+	case s >= Good:
+		style = m.styles.Strong
+	case s == Fair:
+		style = m.styles.Fair
+	}
+	const segments = 5
+	filled := int(s) + 1
+	bar := style.Render(strings.Repeat(g.SliderFill, filled*2)) +
+		m.styles.Track.Render(strings.Repeat(g.SliderTrack, (segments-filled)*2))
+	return bar + " " + style.Render(s.String())
+}
+
+// View renders the label, the password with its strength and, if asked, the confirmation.
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.label != "" {
+		fmt.Fprintf(&b, "%s\n", m.styles.Label.Render(m.label))
+	}
+	fmt.Fprintf(&b, "%s %s", m.styles.Prompt.Render("Password:"), m.passwordInput.View())
+	if m.showStrength && m.passwordInput.Value() != "" {
+		fmt.Fprintf(&b, "\n%s %s", m.styles.Prompt.Render("Strength:"), m.meter())
+	}
+	if m.confirming {
+		fmt.Fprintf(&b, "\n%s %s", m.styles.Prompt.Render("Confirm: "), m.confirmInput.View())
+	}
+
+	hint := "ctrl+r reveal"
+	if m.revealed {
+		hint = "ctrl+r hide"
+	}
+	if m.confirming {
+		hint += " · ↑ back"
+	}
+	fmt.Fprintf(&b, "\n%s", m.styles.Hint.Render(hint))
+	if m.err != nil {
+		fmt.Fprintf(&b, "\n%s", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return b.String()
+}
+
+// Run runs the model and returns the accepted password. It implements ui.Prompt[[]byte].
+func (m *Model) Run(ctx context.Context) ([]byte, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return nil, err
+	}
+	return m.Value(), nil
+}
+
+// RunAccessible asks for the password and, if enabled, its confirmation as lines of text instead of using the
+// terminal UI. As the lines are echoed by the terminal, a note is shown. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	if m.label != "" {
+		p.Println(m.label)
+	}
+	for {
+		s, err := p.Line("Password (input is visible): ", "")
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+		pw := []byte(s)
+		if err := m.check(pw); err != nil {
+			ui.RecordValidationFailure(m.Key(), err)
+			p.Println(fmt.Sprintf("Error: %v", err))
+			continue
+		}
+		if m.showStrength {
+			p.Println(fmt.Sprintf("Strength: %s", Rate(pw)))
+		}
+
+		if m.confirm {
+			c, err := p.Line("Confirm password: ", "")
+			switch {
+			case errors.Is(err, io.EOF):
+				m.canceled, m.quit = true, false
+				return nil
+			case err != nil:
+				return err
+			case c != s:
+				p.Println(fmt.Sprintf("Error: %v", ErrMismatch))
+				continue
+			}
+		}
+		m.accept(pw)
+		m.canceled, m.quit = false, false
+		return nil
+	}
+}
+
+// Showcase demonstrates the Model component by asking for a new password with confirmation.
+func Showcase() {
+	fmt.Println("=== Password Showcase ===")
+
+	pw, err := New("Choose a password for the new account:").WithConfirm(true).WithMinStrength(Fair).
+		Run(context.Background())
+	if ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Password accepted (%d bytes, %s).\n", len(pw), Rate(pw))
+		clear(pw)
+	}
+}
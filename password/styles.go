@@ -0,0 +1,44 @@
+package password
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Label  lipgloss.Style // Label is the style of the label.
+	Prompt lipgloss.Style // Prompt is the style of the prompts in front of the password and the confirmation.
+	Weak   lipgloss.Style // Weak is the style of the strength meter for very weak and weak passwords.
+	Fair   lipgloss.Style // Fair is the style of the strength meter for fair passwords.
+	Strong lipgloss.Style // Strong is the style of the strength meter for good and strong passwords.
+	Track  lipgloss.Style // Track is the style of the unfilled part of the strength meter.
+	Hint   lipgloss.Style // Hint is the style of the hint on the available keys.
+	Error  lipgloss.Style // Error is the style of the error shown below the password.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:  lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Prompt: lipgloss.NewStyle().Foreground(t.Accent),
+		Weak:   lipgloss.NewStyle().Foreground(t.Failure),
+		Fair:   lipgloss.NewStyle().Foreground(t.Label),
+		Strong: lipgloss.NewStyle().Foreground(t.Success),
+		Track:  lipgloss.NewStyle().Faint(true),
+		Hint:   lipgloss.NewStyle().Faint(true),
+		Error:  t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
@@ -0,0 +1,16 @@
+package pick
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui/internal/benchview"
+)
+
+func BenchmarkView30(b *testing.B) {
+	benchview.Run(b, func() tea.Model { return New(benchview.Items(30), Label("Pick")) })
+}
+
+func BenchmarkView1000(b *testing.B) {
+	benchview.Run(b, func() tea.Model { return New(benchview.Items(1000), Label("Pick")) })
+}
@@ -0,0 +1,167 @@
+package pick
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Label sets the label of the Model.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Items sets the items to pick from. The selection is moved to the last item if it is beyond the new items.
+func Items(items ...string) Option {
+	return func(m *Model) {
+		m.items = items
+		m.selectedIdx = max(0, min(m.selectedIdx, len(items)-1))
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
+
+// LabelStyle sets the style of the label.
+func LabelStyle(style lipgloss.Style) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles.Label = style
+	}
+}
+
+// SelectedIndex sets the index of the initially selected item.
+func SelectedIndex(i int) Option {
+	return func(m *Model) {
+		if i < 0 {
+			i = 0
+		} else if i > len(m.items)-1 {
+			i = len(m.items) - 1
+		}
+		m.selectedIdx = i
+	}
+}
+
+// SelectedItemStyle sets the style of the selected item.
+func SelectedItemStyle(style lipgloss.Style) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles.SelectedItem = style
+	}
+}
+
+// NormalItemStyle sets the style of the normal (unselected) items.
+func NormalItemStyle(style lipgloss.Style) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles.NormalItem = style
+	}
+}
+
+// LabelColor sets the color of the label.
+func LabelColor(color lipgloss.Color) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles.Label = lipgloss.NewStyle().Foreground(color)
+	}
+}
+
+// SelectedItemColor sets the color of the selected item.
+func SelectedItemColor(color lipgloss.Color) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles.SelectedItem = lipgloss.NewStyle().Foreground(color)
+	}
+}
+
+// NormalItemColor sets the color of the normal (unselected) items.
+func NormalItemColor(color lipgloss.Color) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles.NormalItem = lipgloss.NewStyle().Foreground(color)
+	}
+}
+
+// SelectedFormat sets the format string for the selected item.
+func SelectedFormat(format string) Option {
+	return func(m *Model) {
+		m.selectedFormat = format
+	}
+}
+
+// NormalFormat sets the format string for normal (unselected) items.
+func NormalFormat(format string) Option {
+	return func(m *Model) {
+		m.normalFormat = format
+	}
+}
+
+// Horizontal displays the items horizontally.
+func Horizontal() Option {
+	return func(m *Model) {
+		m.horizontal = true
+	}
+}
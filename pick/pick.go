@@ -1,35 +1,102 @@
 package pick
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"os"
+	"io"
 	"strings"
 
-	// Provides list model
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
 	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
 	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
 	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/answer"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/internal/render"
+	"github.com/nmeilick/go-ui/styles"
 )
 
+var _ ui.Prompt[int] = (*Model)(nil)
+
 // Model represents a selectable list component.
 type Model struct {
-	items             []string       // items is the list of items to select from.
-	label             string         // label is the label for the list.
-	cancelable        bool           // cancelable determines if selection can be canceled with escape key
-	quitable          bool           // quitable determines if execution can be quit via ctrl+c
-	selectedIdx       int            // selectedIdx is the index of the currently selected item.
-	labelStyle        lipgloss.Style // labelStyle is the style for the label.
-	selectedItemStyle lipgloss.Style // selectedItemStyle is the style for the selected item.
-	normalItemStyle   lipgloss.Style // normalItemStyle is the style for the normal (unselected) items.
-	selectedFormat    string         // selectedFormat is the format string for the selected item.
-	normalFormat      string         // normalFormat is the format string for normal (unselected) items.
-	horizontal        bool           // horizontal indicates if the items should be displayed horizontally.
+	items          []string               // items is the list of items to select from.
+	label          string                 // label is the label for the list.
+	cancelable     bool                   // cancelable determines if selection can be canceled with escape key
+	quitable       bool                   // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption    // programOptions are passed to the program running the model
+	id             string                 // id identifies the prompt, e.g. for preset answers
+	embedded       bool                   // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                   // focused determines if the model handles key messages
+	keymap         ui.KeyMap              // keymap holds the key bindings of the model.
+	err            error                  // err is shown below the model, e.g. why the previous answer was rejected
+	selectedIdx    int                    // selectedIdx is the index of the currently selected item.
+	styles         Styles                 // styles holds the styles of the model.
+	customStyles   bool                   // customStyles is set if styles were set explicitly, which a theme switch keeps
+	selectedFormat string                 // selectedFormat is the format string for the selected item; empty to use the glyphs.
+	normalFormat   string                 // normalFormat is the format string for normal (unselected) items.
+	horizontal     bool                   // horizontal indicates if the items should be displayed horizontally.
+	width          int                    // width is the width of the terminal, 0 if unknown.
+	zones          ui.Zones               // zones marks the items, so they can be clicked.
+	cache          *render.Cache[itemKey] // cache holds the rendered items.
 
 	canceled bool // canceled indicates whether the selection was canceled
 	quit     bool // quit indicates whether the selection was quit
 }
 
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is the index or the item. It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	idx, err := answer.Index(v, m.items)
+	if err != nil {
+		return err
+	}
+	m.selectedIdx = idx
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the picked item. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	return m.SelectedItem()
+}
+
+// Choices returns the items to pick from. It implements ui.ChoiceModel.
+func (m *Model) Choices() []string {
+	return append([]string(nil), m.items...)
+}
+
+// Focus focuses the model, so that it handles key messages. It returns no command and exists for compatibility with
+// other focusable models.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
 // Canceled returns the canceled flag.
 func (m *Model) Canceled() bool {
 	return m.canceled
@@ -53,113 +120,131 @@ func (m *Model) SelectedItem() string {
 	return ""
 }
 
-// New creates and returns a new Model with the given items.
-func New(items []string) *Model {
-	return &Model{
-		items:             items,
-		label:             "",
-		cancelable:        true,
-		quitable:          true,
-		selectedIdx:       0,
-		labelStyle:        lipgloss.NewStyle().Foreground(lipgloss.Color("#FFD700")).Bold(true), // Gold
-		selectedItemStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")),            // Bright Green
-		normalItemStyle:   lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF")),            // White
-		selectedFormat:    "►%s◄",
-		normalFormat:      " %s ",
-		horizontal:        false,
+// New creates and returns a new Model with the given items, configured by the given options.
+func New(items []string, opts ...Option) *Model {
+	m := &Model{
+		items:          items,
+		label:          "",
+		cancelable:     true,
+		quitable:       true,
+		focused:        true,
+		keymap:         ui.DefaultKeyMap(),
+		selectedIdx:    0,
+		styles:         DefaultStyles(),
+		selectedFormat: "",
+		normalFormat:   " %s ",
+		horizontal:     false,
+		zones:          ui.NewZones(),
+		cache:          render.New[itemKey](),
 
 		canceled: false,
 		quit:     false,
 	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
 // WithLabel sets the label of the Model and returns a new Model with the updated label.
 func (m *Model) WithLabel(label string) *Model {
-	newModel := *m
-	newModel.label = label
-	return &newModel
+	return m.With(Label(label))
+}
+
+// WithItems sets the items to pick from and returns a new Model with the updated items.
+func (m *Model) WithItems(items ...string) *Model {
+	return m.With(Items(items...))
 }
 
 // WithCancel sets the cancelable flag and returns a new Model with the updated flag.
 func (m *Model) WithCancel(cancelable bool) *Model {
-	newModel := *m
-	newModel.cancelable = cancelable
-	return &newModel
+	return m.With(Cancel(cancelable))
 }
 
 // WithQuit sets the quitable flag and returns a new Model with the updated flag.
 func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
 	newModel := *m
-	newModel.quitable = quitable
+	newModel.embedded = embedded
 	return &newModel
 }
 
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
 // WithLabelStyle sets the style of the label and returns a new Model with the updated label style.
 func (m *Model) WithLabelStyle(style lipgloss.Style) *Model {
-	newModel := *m
-	newModel.labelStyle = style
-	return &newModel
+	return m.With(LabelStyle(style))
 }
 
 // WithSelectedIndex sets the index of the initially selected item and returns a new Model with the updated selected index.
 func (m *Model) WithSelectedIndex(i int) *Model {
-	if i < 0 {
-		i = 0
-	} else if i > len(m.items)-1 {
-		i = len(m.items) - 1
-	}
-	newModel := *m
-	newModel.selectedIdx = i
-	return &newModel
+	return m.With(SelectedIndex(i))
 }
 
 // WithSelectedItemStyle sets the style of the selected item and returns a new Model with the updated selected item style.
 func (m *Model) WithSelectedItemStyle(style lipgloss.Style) *Model {
-	newModel := *m
-	newModel.selectedItemStyle = style
-	return &newModel
+	return m.With(SelectedItemStyle(style))
 }
 
 // WithNormalItemStyle sets the style of the normal (unselected) items and returns a new Model with the updated normal item style.
 func (m *Model) WithNormalItemStyle(style lipgloss.Style) *Model {
-	newModel := *m
-	newModel.normalItemStyle = style
-	return &newModel
+	return m.With(NormalItemStyle(style))
 }
 
 // WithLabelColor sets the color of the label and returns a new Model with the updated label color.
 func (m *Model) WithLabelColor(color lipgloss.Color) *Model {
-	newModel := *m
-	newModel.labelStyle = lipgloss.NewStyle().Foreground(color)
-	return &newModel
+	return m.With(LabelColor(color))
 }
 
 // WithSelectedItemColor sets the color of the selected item and returns a new Model with the updated selected item color.
 func (m *Model) WithSelectedItemColor(color lipgloss.Color) *Model {
-	newModel := *m
-	newModel.selectedItemStyle = lipgloss.NewStyle().Foreground(color)
-	return &newModel
+	return m.With(SelectedItemColor(color))
 }
 
 // WithNormalItemColor sets the color of the normal (unselected) items and returns a new Model with the updated normal item color.
 func (m *Model) WithNormalItemColor(color lipgloss.Color) *Model {
-	newModel := *m
-	newModel.normalItemStyle = lipgloss.NewStyle().Foreground(color)
-	return &newModel
+	return m.With(NormalItemColor(color))
 }
 
 // WithSelectedFormat sets the format string for the selected item and returns a new Model with the updated selected format.
 func (m *Model) WithSelectedFormat(format string) *Model {
-	newModel := *m
-	newModel.selectedFormat = format
-	return &newModel
+	return m.With(SelectedFormat(format))
 }
 
 // WithNormalFormat sets the format string for normal (unselected) items and returns a new Model with the updated normal format.
 func (m *Model) WithNormalFormat(format string) *Model {
-	newModel := *m
-	newModel.normalFormat = format
-	return &newModel
+	return m.With(NormalFormat(format))
 }
 
 // WithHorizontal sets whether the items should be displayed horizontally and returns a new Model with the updated horizontal setting.
@@ -174,94 +259,200 @@ func (m *Model) Init() tea.Cmd {
 	return nil
 }
 
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
 // Update handles user input and updates the list state by processing key messages and updating the selected index accordingly.
+// With mouse support, clicking an item selects it, clicking the selected item picks it, and the wheel moves the
+// selection. Window size messages determine the width the label is wrapped at and whether horizontal items fit.
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	case ui.ThemeMsg:
+		m.restyle(msg.Theme)
+		return m, nil
+	case ui.MouseMsg:
+		if !m.Focused() || len(m.items) == 0 {
+			return m, nil
+		}
+		if d := msg.Wheel(); d != 0 {
+			m.selectedIdx = max(0, min(len(m.items)-1, m.selectedIdx+d))
+			return m, nil
+		}
+		if i, ok := m.zones.Item(msg); ok && msg.Clicked() {
+			if i == m.selectedIdx {
+				m.canceled, m.quit = false, false
+				return m, m.done()
+			}
+			m.selectedIdx = i
+		}
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "up", "j", "left":
+		if !m.Focused() {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, m.keymap.Prev):
 			m.selectedIdx--
 			if m.selectedIdx < 0 {
 				m.selectedIdx = len(m.items) - 1
 			}
-		case "down", "k", "right":
+		case key.Matches(msg, m.keymap.Next):
 			m.selectedIdx++
 			if m.selectedIdx >= len(m.items) {
 				m.selectedIdx = 0
 			}
-		case "enter":
+		case key.Matches(msg, m.keymap.Confirm):
 			m.canceled, m.quit = false, false
-			return m, tea.Quit
-		case "esc":
+			return m, m.done()
+		case key.Matches(msg, m.keymap.Cancel):
 			if m.cancelable {
 				m.selectedIdx = -1
 				m.canceled, m.quit = true, false
-				return m, tea.Quit
+				return m, m.done()
 			}
-		case "ctrl+c":
+		case key.Matches(msg, m.keymap.Quit):
 			if m.quitable {
 				m.selectedIdx = -1
-				m.canceled, m.quit = true, true
-				return m, tea.Quit
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, m.done()
 			}
 		}
 	}
 	return m, nil
 }
 
-// View renders the list as a string, displaying the label and items with their respective styles.
+// View renders the list as a string, displaying the label and items with their respective styles. Horizontal items
+// that do not fit into the terminal or follow a multi-line label are shown vertically, and the label is wrapped at the
+// width of the terminal.
 func (m *Model) View() string {
 	var b strings.Builder
 
-	if m.label != "" {
-		if m.horizontal {
-			fmt.Fprintf(&b, "%s ", m.labelStyle.Render(m.label))
-		} else {
-			fmt.Fprintf(&b, "%s\n", m.labelStyle.Render(m.label))
-		}
-	}
-
-	var items []string
+	g := ui.Glyphs()
+	version := render.Fingerprint(m.styles.SelectedItem, m.styles.NormalItem) + m.selectedFormat + "\x00" +
+		m.normalFormat + "\x00" + g.SelectedLeft + "\x00" + g.SelectedRight
+	items := make([]string, len(m.items))
 	for i, item := range m.items {
-		var line string
-		var format string
-		var style lipgloss.Style
-		if i == m.selectedIdx {
-			style = m.selectedItemStyle
-			format = m.selectedFormat
-		} else {
-			style = m.normalItemStyle
-			format = m.normalFormat
-		}
-		if !strings.Contains(format, "%s") {
-			format += "%s"
-		}
-		line = fmt.Sprintf(format, style.Render(item))
-		items = append(items, line)
+		selected := i == m.selectedIdx
+		line := m.cache.Get(version, itemKey{item: item, selected: selected}, func() string {
+			return m.renderItem(item, selected, g)
+		})
+		items[i] = m.zones.MarkItem(i, line)
 	}
 
-	if m.horizontal {
-		fmt.Fprint(&b, strings.Join(items, "  "))
+	row := strings.Join(items, "  ")
+	label := m.styles.Label.Render(m.label)
+	horizontal := m.horizontal && !strings.Contains(m.label, "\n") &&
+		(m.width <= 0 || styles.Width(label)+1+styles.Width(row) <= m.width)
+	switch {
+	case m.label == "":
+	case horizontal:
+		fmt.Fprintf(&b, "%s ", label)
+	default:
+		fmt.Fprintf(&b, "%s\n", m.styles.Label.Render(styles.Wrap(m.label, styles.InnerWidth(m.styles.Label, m.width))))
+	}
+	if horizontal {
+		fmt.Fprint(&b, row)
 	} else {
 		fmt.Fprint(&b, strings.Join(items, "\n"))
 	}
 
+	if m.err != nil {
+		fmt.Fprintf(&b, "\n%s", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+
 	return b.String()
 }
 
+// itemKey identifies a rendered item in the cache.
+type itemKey struct {
+	item     string // item is the text of the item.
+	selected bool   // selected determines if the item is selected.
+}
+
+// renderItem renders the item with its style and format.
+func (m *Model) renderItem(item string, selected bool, g ui.GlyphSet) string {
+	style, format := m.styles.NormalItem, m.normalFormat
+	if selected {
+		style, format = m.styles.SelectedItem, m.selectedFormat
+		if format == "" {
+			format = g.SelectedLeft + "%s" + g.SelectedRight
+		}
+	}
+	if !strings.Contains(format, "%s") {
+		format += "%s"
+	}
+	return fmt.Sprintf(format, style.Render(item))
+}
+
+// Run runs the model and returns the index of the picked item. It implements ui.Prompt[int].
+func (m *Model) Run(ctx context.Context) (int, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return -1, err
+	}
+	return m.selectedIdx, nil
+}
+
+// RunAccessible asks to pick an item from a numbered list instead of using the terminal UI. It implements
+// ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	idx, err := plain.New(in, out).Choice(m.label, m.items, m.selectedIdx)
+	switch {
+	case errors.Is(err, io.EOF):
+		m.selectedIdx = -1
+		m.canceled, m.quit = true, false
+		return nil
+	case err != nil:
+		return err
+	}
+	m.selectedIdx = idx
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// ValuePrompt returns a prompt that runs the model and returns the picked item instead of its index.
+func (m *Model) ValuePrompt() ui.Prompt[string] {
+	return ui.PromptFunc[string](func(ctx context.Context) (string, error) {
+		if _, err := m.Run(ctx); err != nil {
+			return "", err
+		}
+		return m.SelectedItem(), nil
+	})
+}
+
 // Pick asks to pick an item and return its index or an error.
 // Use errors.Is(ui.Canceled) or errors.Is(ui.Quit) to determine if the selection
 // was canceled or aborting of the program was requested.
 func Pick(label string, horizontal bool, idx int, items ...string) (int, error) {
+	return PickWithOptions(nil, label, horizontal, idx, items...)
+}
+
+// PickWithOptions is like Pick, but passes the given options to the program, e.g. to use the alternate screen or
+// custom input and output.
+func PickWithOptions(opts []tea.ProgramOption, label string, horizontal bool, idx int, items ...string) (int, error) {
+	return PickContext(context.Background(), label, horizontal, idx, items, opts...)
+}
+
+// PickContext is like PickWithOptions, but aborts the selection when the context is done, see ui.RunContext.
+// ui.TimeoutError is returned if its deadline expired.
+func PickContext(ctx context.Context, label string, horizontal bool, idx int, items []string,
+	opts ...tea.ProgramOption) (int, error) {
 	if len(items) == 0 {
 		items = []string{"yes", "no"}
 	}
-	m := New(items).WithLabel(label).WithSelectedIndex(idx).WithHorizontal(horizontal)
-	_, err := tea.NewProgram(m).Run()
-	if err = ui.ErrorOrValidate(err, m); err != nil {
-		return -1, err
-	}
-	return m.selectedIdx, nil
+	m := New(items).WithLabel(label).WithSelectedIndex(idx).WithHorizontal(horizontal).WithProgramOptions(opts...)
+	return m.Run(ctx)
 }
 
 // Showcase demonstrates all features of the Model component by creating various list models and running interactive examples in the terminal.
@@ -269,16 +460,7 @@ func Showcase() {
 	items := []string{"Apple", "Banana", "Cherry"}
 
 	handle := func(m *Model) {
-		err := ui.Run(m)
-		switch {
-		case errors.Is(err, ui.QuitError):
-			fmt.Println("Quit")
-			os.Exit(0)
-		case errors.Is(err, ui.CanceledError):
-			fmt.Println("Canceled")
-		case err != nil:
-			fmt.Printf("Error running program: %v", err)
-		default:
+		if err := ui.Handle(ui.Run(m), ui.HandleOptions{}); err == nil {
 			fmt.Printf("Picked item: %s (Index: %d)\n", m.SelectedItem(), m.SelectedIdx())
 		}
 	}
@@ -0,0 +1,37 @@
+package pick
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Label        lipgloss.Style // Label is the style of the label.
+	SelectedItem lipgloss.Style // SelectedItem is the style of the selected item.
+	NormalItem   lipgloss.Style // NormalItem is the style of the normal (unselected) items.
+	Error        lipgloss.Style // Error is the style of the error shown below the items.
+}
+
+// DefaultStyles returns the default styles, which use the current theme of the ui package.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the styles of the model in the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	s := t.PromptStyles()
+	return Styles{
+		Label:        s.Label,
+		SelectedItem: s.Selected,
+		NormalItem:   s.Normal,
+		Error:        t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
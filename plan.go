@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// ChoiceModel is implemented by models offering a fixed set of choices, which are listed in plans.
+type ChoiceModel interface {
+	Choices() []string
+}
+
+// PlanStep describes a prompt that would be asked.
+type PlanStep struct {
+	Key     string   // Key identifies the prompt, see AnswerableModel.
+	Default any      // Default is the answer if the user confirmed it right away, or the preset one; masked if secret.
+	Choices []string // Choices are the choices offered, if the model implements ChoiceModel.
+	Preset  bool     // Preset indicates whether an answer was preset, so the prompt would not be shown.
+}
+
+// Plan collects the prompts that would be asked in dry-run mode, see WithPlan.
+type Plan struct {
+	mu    sync.Mutex
+	steps []PlanStep
+}
+
+// WithPlan enables the dry-run mode: instead of running models, their prompts are added to the given plan, e.g. to
+// document interactive flows or to verify that all answers are preset before running non-interactively. Prompts are
+// models implementing AnswerableModel; other models are skipped. Preset answers are applied, so that the flow
+// continues with the answers it would get.
+func WithPlan(p *Plan) tea.ProgramOption {
+	return option(func(s *settings) {
+		s.plan = p
+	})
+}
+
+// Steps returns the prompts added to the plan.
+func (p *Plan) Steps() []PlanStep {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]PlanStep(nil), p.steps...)
+}
+
+// String returns the plan with one line per prompt.
+func (p *Plan) String() string {
+	var b strings.Builder
+	for i, step := range p.Steps() {
+		fmt.Fprintf(&b, "%d. %s", i+1, step.Key)
+		if step.Preset {
+			fmt.Fprintf(&b, " = %v (preset)", step.Default)
+		} else if step.Default != nil && step.Default != "" {
+			fmt.Fprintf(&b, " [default: %v]", step.Default)
+		}
+		if len(step.Choices) > 0 {
+			fmt.Fprintf(&b, " (choices: %s)", strings.Join(step.Choices, ", "))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// add adds the prompt of the model to the plan, applying a preset answer if there is one.
+func (p *Plan) add(m tea.Model, s *settings) error {
+	am, ok := m.(AnswerableModel)
+	if !ok {
+		return nil
+	}
+	step := PlanStep{Key: am.Key()}
+	if _, v, ok := presetAnswer(m, s); ok {
+		if err := am.SetAnswer(v); err != nil {
+			return fmt.Errorf("%s: %w", step.Key, err)
+		}
+		step.Preset = true
+	}
+	// Secret answers are masked like in the echoed answers, so that plans can be shared.
+	if step.Default = am.Answer(); step.Default != nil && step.Default != "" {
+		step.Default = displayAnswer(m, step.Default)
+	}
+	if cm, ok := m.(ChoiceModel); ok {
+		step.Choices = cm.Choices()
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.steps = append(p.steps, step)
+	return nil
+}
@@ -0,0 +1,125 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// Printer prints lines above the running prompt, so that background workers can log progress without corrupting its
+// rendering. While no prompt runs, or in accessible mode, lines are written to the output directly. A Printer is safe
+// for concurrent use. Lines printed while a prompt uses the alternate screen are discarded, see tea.Program.Println.
+type Printer struct {
+	mu      sync.Mutex
+	program *tea.Program // program is the running program lines are printed above, or nil.
+	out     io.Writer    // out receives the lines while no program runs.
+	buf     []byte       // buf holds the incomplete last line written using Write.
+}
+
+// defaultPrinter is the printer attached to all prompts, see Println.
+var defaultPrinter = NewPrinter(nil)
+
+// NewPrinter returns a new printer writing to out while no prompt runs, os.Stdout if nil. Pass it to the prompts using
+// WithPrinter.
+func NewPrinter(out io.Writer) *Printer {
+	if out == nil {
+		out = os.Stdout
+	}
+	return &Printer{out: out}
+}
+
+// DefaultPrinter returns the printer that is attached to all prompts run by Run and Session, which Println and Printf
+// print to.
+func DefaultPrinter() *Printer {
+	return defaultPrinter
+}
+
+// Println prints a line above the running prompt using the default printer. Arguments are formatted like fmt.Println.
+func Println(args ...any) {
+	defaultPrinter.Println(args...)
+}
+
+// Printf prints a line above the running prompt using the default printer. Arguments are formatted like fmt.Printf,
+// a trailing newline is optional.
+func Printf(format string, args ...any) {
+	defaultPrinter.Printf(format, args...)
+}
+
+// WithPrinter attaches the printer to the prompts run with the options, in addition to the default printer.
+func WithPrinter(p *Printer) tea.ProgramOption {
+	return option(func(s *settings) {
+		s.printers = append(s.printers, p)
+	})
+}
+
+// Println prints a line above the running prompt. Arguments are formatted like fmt.Println.
+func (p *Printer) Println(args ...any) {
+	p.print(strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+}
+
+// Printf prints a line above the running prompt. Arguments are formatted like fmt.Printf, a trailing newline is
+// optional.
+func (p *Printer) Printf(format string, args ...any) {
+	p.print(strings.TrimSuffix(fmt.Sprintf(format, args...), "\n"))
+}
+
+// Write implements io.Writer, e.g. for log.SetOutput. Complete lines are printed right away, an incomplete last line
+// once it is completed by a later write.
+func (p *Printer) Write(b []byte) (int, error) {
+	p.mu.Lock()
+	p.buf = append(p.buf, b...)
+	i := bytes.LastIndexByte(p.buf, '\n')
+	if i < 0 {
+		p.mu.Unlock()
+		return len(b), nil
+	}
+	text := string(p.buf[:i])
+	p.buf = append(p.buf[:0], p.buf[i+1:]...)
+	p.mu.Unlock()
+
+	p.print(text)
+	return len(b), nil
+}
+
+// print prints text, which may consist of several lines, above the running program or to the output.
+func (p *Printer) print(text string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.program != nil {
+		p.program.Println(text)
+		return
+	}
+	fmt.Fprintln(p.out, text)
+}
+
+// attach makes the printer print above the program until the returned function is called. A nil program makes it
+// print to out instead, e.g. in accessible mode.
+func (p *Printer) attach(program *tea.Program, out io.Writer) (detach func()) {
+	p.mu.Lock()
+	prevProgram, prevOut := p.program, p.out
+	p.program, p.out = program, out
+	p.mu.Unlock()
+	return func() {
+		p.mu.Lock()
+		p.program, p.out = prevProgram, prevOut
+		p.mu.Unlock()
+	}
+}
+
+// attachPrinters attaches the default printer and those set using WithPrinter to the program, see Printer.attach.
+func attachPrinters(s *settings, program *tea.Program) (detach func()) {
+	var detachers []func()
+	for _, p := range append([]*Printer{defaultPrinter}, s.printers...) {
+		detachers = append(detachers, p.attach(program, s.output))
+	}
+	return func() {
+		for i := len(detachers) - 1; i >= 0; i-- {
+			detachers[i]()
+		}
+	}
+}
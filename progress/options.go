@@ -0,0 +1,114 @@
+package progress
+
+import (
+	"github.com/charmbracelet/bubbles/progress"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy. The copy shares the progress of the
+// model, so it should be configured before the operation starts.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Label sets the label of the Model.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Bytes sets whether amounts are formatted as bytes.
+func Bytes(bytes bool) Option {
+	return func(m *Model) {
+		m.bytes = bytes
+	}
+}
+
+// Width sets the width of the bar.
+func Width(width int) Option {
+	return func(m *Model) {
+		m.bar.Width = width
+	}
+}
+
+// Gradient fills the determinate bar with a gradient between the given colors, e.g. "#5A56E0" and "#EE6FF8".
+func Gradient(colorA, colorB string) Option {
+	return func(m *Model) {
+		progress.WithGradient(colorA, colorB)(&m.bar)
+	}
+}
+
+// Percent sets whether the percentage is shown.
+func Percent(show bool) Option {
+	return func(m *Model) {
+		m.showPercent = show
+	}
+}
+
+// Rate sets whether the rate is shown.
+func Rate(show bool) Option {
+	return func(m *Model) {
+		m.showRate = show
+	}
+}
+
+// ETA sets whether the estimated time remaining is shown.
+func ETA(show bool) Option {
+	return func(m *Model) {
+		m.showETA = show
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the operation finished.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
@@ -0,0 +1,601 @@
+package progress
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"      // Manages key bindings
+	"github.com/charmbracelet/bubbles/progress" // Provides progress bar model
+	tea "github.com/charmbracelet/bubbletea"    // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+)
+
+// tickInterval is the interval in which the progress is polled and the bar is redrawn.
+const tickInterval = 100 * time.Millisecond
+
+// rateSmoothing is the weight of the latest sample in the exponential moving average of the rate.
+const rateSmoothing = 0.2
+
+// Update reports the progress of an operation to RunChannel.
+type Update struct {
+	Current int64 // Current is the amount of work done.
+	Total   int64 // Total is the total amount of work; 0 keeps the previous total.
+	Err     error // Err fails the operation, ending RunChannel.
+}
+
+// Model represents a progress bar. A bar with a total of zero or less is indeterminate and shows activity instead of
+// a percentage. The progress may be reported from any goroutine using Add, Set and SetTotal, or by wrapping a reader or
+// writer.
+type Model struct {
+	bar            progress.Model                  // bar renders the determinate bar.
+	label          string                          // label is shown before the bar.
+	current        int64                           // current is the amount of work done; accessed atomically
+	total          int64                           // total is the total amount of work; accessed atomically
+	bytes          bool                            // bytes determines if amounts are formatted as bytes.
+	showPercent    bool                            // showPercent determines if the percentage is shown.
+	showRate       bool                            // showRate determines if the rate is shown.
+	showETA        bool                            // showETA determines if the estimated time remaining is shown.
+	fn             func(ctx context.Context) error // fn is the operation to run.
+	ctx            context.Context                 // ctx is passed to fn.
+	cancel         context.CancelFunc              // cancel cancels ctx.
+	started        time.Time                       // started is the time the operation started.
+	elapsed        time.Duration                   // elapsed is the duration of the finished operation.
+	lastTick       time.Time                       // lastTick is the time of the previous rate sample.
+	lastCurrent    int64                           // lastCurrent is the amount of work done at the previous rate sample.
+	rate           float64                         // rate is the smoothed amount of work done per second.
+	frame          int                             // frame is the animation frame of the indeterminate bar.
+	finished       bool                            // finished indicates whether the operation returned.
+	err            error                           // err is the error returned by the operation.
+	cancelable     bool                            // cancelable determines if the operation can be canceled with escape key
+	quitable       bool                            // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption             // programOptions are passed to the program running the model
+	embedded       bool                            // embedded determines if a DoneMsg is emitted instead of quitting the program
+	keymap         ui.KeyMap                       // keymap holds the key bindings of the model.
+	styles         Styles                          // styles holds the styles of the model.
+	customStyles   bool                            // customStyles is set if styles were set explicitly, which a theme switch keeps
+
+	canceled bool // canceled indicates whether the operation was canceled
+	quit     bool // quit indicates whether the operation was quit
+}
+
+// New creates and returns a new Model with the given label and total amount of work, configured by the given options.
+// A total of zero or less creates an indeterminate bar.
+func New(label string, total int64, opts ...Option) *Model {
+	bar := progress.New(progress.WithDefaultGradient(), progress.WithoutPercentage(), progress.WithWidth(40))
+	if ui.LegacyConsole() {
+		bar.Full, bar.Empty = '#', '-'
+	}
+
+	m := &Model{
+		bar:         bar,
+		label:       label,
+		total:       total,
+		showPercent: true,
+		showRate:    true,
+		showETA:     true,
+		cancelable:  true,
+		quitable:    true,
+		keymap:      ui.DefaultKeyMap(),
+		styles:      DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithBytes sets whether amounts are formatted as bytes, e.g. "1.5 MB" and "200 kB/s", and returns a new Model with
+// the updated flag.
+func (m *Model) WithBytes(bytes bool) *Model {
+	return m.With(Bytes(bytes))
+}
+
+// WithWidth sets the width of the bar and returns a new Model with the updated width.
+func (m *Model) WithWidth(width int) *Model {
+	return m.With(Width(width))
+}
+
+// WithPercent sets whether the percentage is shown and returns a new Model with the updated flag.
+func (m *Model) WithPercent(show bool) *Model {
+	return m.With(Percent(show))
+}
+
+// WithRate sets whether the rate is shown and returns a new Model with the updated flag.
+func (m *Model) WithRate(show bool) *Model {
+	return m.With(Rate(show))
+}
+
+// WithETA sets whether the estimated time remaining is shown and returns a new Model with the updated flag.
+func (m *Model) WithETA(show bool) *Model {
+	return m.With(ETA(show))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the operation finished.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Add adds n to the amount of work done. It is safe for concurrent use.
+func (m *Model) Add(n int64) {
+	atomic.AddInt64(&m.current, n)
+}
+
+// Set sets the amount of work done. It is safe for concurrent use.
+func (m *Model) Set(n int64) {
+	atomic.StoreInt64(&m.current, n)
+}
+
+// SetTotal sets the total amount of work; zero or less makes the bar indeterminate. It is safe for concurrent use.
+func (m *Model) SetTotal(n int64) {
+	atomic.StoreInt64(&m.total, n)
+}
+
+// Current returns the amount of work done. It is safe for concurrent use.
+func (m *Model) Current() int64 {
+	return atomic.LoadInt64(&m.current)
+}
+
+// Total returns the total amount of work. It is safe for concurrent use.
+func (m *Model) Total() int64 {
+	return atomic.LoadInt64(&m.total)
+}
+
+// Percent returns the fraction of work done between 0 and 1, or 0 for an indeterminate bar.
+func (m *Model) Percent() float64 {
+	total := m.Total()
+	if total <= 0 {
+		return 0
+	}
+	return math.Min(1, math.Max(0, float64(m.Current())/float64(total)))
+}
+
+// Rate returns the smoothed amount of work done per second.
+func (m *Model) Rate() float64 {
+	return m.rate
+}
+
+// ETA returns the estimated time remaining, or zero if it is unknown.
+func (m *Model) ETA() time.Duration {
+	total := m.Total()
+	if total <= 0 || m.rate <= 0 {
+		return 0
+	}
+	remaining := total - m.Current()
+	if remaining <= 0 {
+		return 0
+	}
+	return time.Duration(float64(remaining) / m.rate * float64(time.Second))
+}
+
+// Err returns the error returned by the operation.
+func (m *Model) Err() error {
+	return m.err
+}
+
+// Reader returns a reader that reads from r and adds the number of bytes read to the progress.
+func (m *Model) Reader(r io.Reader) io.Reader {
+	return &reader{r: r, m: m}
+}
+
+// Writer returns a writer that writes to w and adds the number of bytes written to the progress.
+func (m *Model) Writer(w io.Writer) io.Writer {
+	return &writer{w: w, m: m}
+}
+
+// reader counts the bytes read from the wrapped reader.
+type reader struct {
+	r io.Reader
+	m *Model
+}
+
+// Read reads from the wrapped reader and adds the number of bytes read to the progress.
+func (r *reader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.m.Add(int64(n))
+	return n, err
+}
+
+// writer counts the bytes written to the wrapped writer.
+type writer struct {
+	w io.Writer
+	m *Model
+}
+
+// Write writes to the wrapped writer and adds the number of bytes written to the progress.
+func (w *writer) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.m.Add(int64(n))
+	return n, err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the operation finished. Use the model's
+// Err method to determine whether it failed.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// tickMsg triggers polling the progress of a Model.
+type tickMsg struct {
+	model *Model
+	time  time.Time
+}
+
+// finishedMsg is sent when the operation of a Model returned.
+type finishedMsg struct {
+	model *Model
+	err   error
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// tick returns the command scheduling the next poll of the progress.
+func (m *Model) tick() tea.Cmd {
+	return tea.Tick(tickInterval, func(t time.Time) tea.Msg {
+		return tickMsg{model: m, time: t}
+	})
+}
+
+// Init starts polling the progress and the operation, if any.
+func (m *Model) Init() tea.Cmd {
+	m.started = time.Now()
+	m.lastTick, m.lastCurrent = m.started, m.Current()
+	if m.fn == nil {
+		return m.tick()
+	}
+	if m.ctx == nil {
+		m.ctx, m.cancel = context.WithCancel(context.Background())
+	}
+	ctx, fn := m.ctx, m.fn
+	return tea.Batch(m.tick(), func() tea.Msg {
+		return finishedMsg{model: m, err: fn(ctx)}
+	})
+}
+
+// Finish ends the progress with the given error. It is used to finish an embedded model that runs no operation
+// itself.
+func (m *Model) Finish(err error) {
+	if m.finished {
+		return
+	}
+	m.finished, m.err = true, err
+	if !m.started.IsZero() {
+		m.elapsed = time.Since(m.started)
+	}
+}
+
+// Update polls the progress and handles the result of the operation and cancellation.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.finished {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, m.keymap.Cancel):
+			if m.cancelable {
+				m.canceled, m.quit = true, false
+				return m, m.abort()
+			}
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, m.abort()
+			}
+		}
+	case tickMsg:
+		if msg.model != m || m.finished {
+			return m, nil
+		}
+		m.sample(msg.time)
+		m.frame++
+		return m, m.tick()
+	case finishedMsg:
+		if msg.model != m {
+			return m, nil
+		}
+		if m.canceled || m.quit {
+			m.Finish(context.Canceled)
+		} else {
+			m.Finish(msg.err)
+		}
+		return m, m.done()
+	}
+	return m, nil
+}
+
+// sample updates the smoothed rate with the work done since the previous sample.
+func (m *Model) sample(now time.Time) {
+	current := m.Current()
+	dt := now.Sub(m.lastTick).Seconds()
+	if dt <= 0 {
+		return
+	}
+	rate := float64(current-m.lastCurrent) / dt
+	if m.rate == 0 {
+		m.rate = rate
+	} else {
+		m.rate = rateSmoothing*rate + (1-rateSmoothing)*m.rate
+	}
+	m.lastTick, m.lastCurrent = now, current
+}
+
+// abort cancels the context of the operation, which finishes the model once the operation returned. Without an
+// operation, the model is finished immediately.
+func (m *Model) abort() tea.Cmd {
+	if m.fn == nil {
+		m.Finish(context.Canceled)
+		return m.done()
+	}
+	m.cancel()
+	return nil
+}
+
+// View renders the label, the bar and the statistics, and a success or failure glyph when done.
+func (m *Model) View() string {
+	var b strings.Builder
+	g := ui.Glyphs()
+	switch {
+	case m.finished && m.err != nil:
+		fmt.Fprintf(&b, "%s ", m.styles.Failure.Render(g.Failure))
+	case m.finished:
+		fmt.Fprintf(&b, "%s ", m.styles.Success.Render(g.Success))
+	}
+	if m.label != "" {
+		fmt.Fprintf(&b, "%s ", m.styles.Label.Render(m.label))
+	}
+
+	total := m.Total()
+	switch {
+	case total > 0:
+		b.WriteString(m.bar.ViewAs(m.Percent()))
+	case m.finished:
+		b.WriteString(m.bar.ViewAs(1))
+	default:
+		b.WriteString(m.indeterminate())
+	}
+
+	stats := m.stats(total)
+	if len(stats) > 0 {
+		fmt.Fprintf(&b, " %s", m.styles.Stats.Render(strings.Join(stats, " · ")))
+	}
+	switch {
+	case !m.finished && (m.canceled || m.quit):
+		b.WriteString(" (canceling...)")
+	case m.finished && (m.canceled || m.quit):
+		b.WriteString(" (canceled)")
+	case m.finished && m.err != nil:
+		fmt.Fprintf(&b, ": %v", m.err)
+	}
+	return b.String() + "\n"
+}
+
+// stats returns the statistics shown after the bar.
+func (m *Model) stats(total int64) []string {
+	var stats []string
+	if m.showPercent && total > 0 {
+		stats = append(stats, fmt.Sprintf("%3.0f%%", m.Percent()*100))
+	}
+	if total > 0 {
+		stats = append(stats, fmt.Sprintf("%s/%s", m.amount(m.Current()), m.amount(total)))
+	} else {
+		stats = append(stats, m.amount(m.Current()))
+	}
+	if m.finished {
+		return append(stats, m.elapsed.Round(100*time.Millisecond).String())
+	}
+	if m.showRate && m.rate > 0 {
+		stats = append(stats, m.amount(int64(m.rate))+"/s")
+	}
+	if eta := m.ETA(); m.showETA && eta > 0 {
+		stats = append(stats, "ETA "+eta.Round(time.Second).String())
+	}
+	return stats
+}
+
+// indeterminate renders a block bouncing within the width of the bar.
+func (m *Model) indeterminate() string {
+	width := m.bar.Width
+	size := max(1, width/5)
+	span := width - size
+	pos := 0
+	if span > 0 {
+		pos = m.frame % (2 * span)
+		if pos > span {
+			pos = 2*span - pos
+		}
+	}
+	return m.styles.Empty.Render(strings.Repeat(string(m.bar.Empty), pos)) +
+		m.styles.Activity.Render(strings.Repeat(string(m.bar.Full), size)) +
+		m.styles.Empty.Render(strings.Repeat(string(m.bar.Empty), max(0, span-pos)))
+}
+
+// amount formats an amount of work, as bytes if enabled.
+func (m *Model) amount(n int64) string {
+	if !m.bytes {
+		return fmt.Sprintf("%d", n)
+	}
+	const unit = 1000
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "kMGTPE"[exp])
+}
+
+// Run shows the progress bar while fn runs and returns the error returned by fn. fn reports its progress using Add,
+// Set and SetTotal, or by reading or writing through Reader or Writer. The user can cancel the operation with esc or
+// quit with ctrl+c, which cancels the context passed to fn and returns ui.CanceledError or ui.QuitError once fn
+// returned.
+func (m *Model) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.ctx, m.cancel = context.WithCancel(ctx)
+	defer m.cancel()
+
+	m.fn, m.finished, m.err, m.rate = fn, false, nil, 0
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return err
+	}
+	return m.err
+}
+
+// RunChannel shows the progress bar while applying the updates received from the channel, until it is closed or an
+// update carries an error, which is returned.
+func (m *Model) RunChannel(ctx context.Context, updates <-chan Update) error {
+	return m.Run(ctx, func(ctx context.Context) error {
+		for {
+			select {
+			case u, ok := <-updates:
+				if !ok {
+					return nil
+				}
+				if u.Total > 0 {
+					m.SetTotal(u.Total)
+				}
+				m.Set(u.Current)
+				if u.Err != nil {
+					return u.Err
+				}
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	})
+}
+
+// RunAccessible runs the operation, announcing its start and result as plain lines. It implements
+// ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	if m.fn == nil {
+		return nil
+	}
+	if m.ctx == nil {
+		m.ctx, m.cancel = context.WithCancel(context.Background())
+	}
+	fmt.Fprintf(out, "%s...\n", m.label)
+	m.started = time.Now()
+	m.Finish(m.fn(m.ctx))
+	if m.err != nil {
+		fmt.Fprintf(out, "%s: failed: %v\n", m.label, m.err)
+	} else {
+		fmt.Fprintf(out, "%s: done (%s)\n", m.label, m.amount(m.Current()))
+	}
+	return nil
+}
+
+// Showcase demonstrates the Model component with a simulated download and an indeterminate operation.
+func Showcase() {
+	fmt.Println("=== Progress Showcase ===")
+
+	const size = 50_000_000
+	m := New("Downloading", size).WithBytes(true)
+	_ = ui.Handle(m.Run(context.Background(), func(ctx context.Context) error {
+		_, err := io.Copy(m.Writer(io.Discard), &slowReader{ctx: ctx, remaining: size})
+		return err
+	}), ui.HandleOptions{})
+
+	updates := make(chan Update)
+	go func() {
+		defer close(updates)
+		for i := int64(1); i <= 30; i++ {
+			time.Sleep(100 * time.Millisecond)
+			updates <- Update{Current: i}
+		}
+	}()
+	_ = ui.Handle(New("Indexing files", 0).RunChannel(context.Background(), updates), ui.HandleOptions{})
+}
+
+// slowReader simulates a download by returning zeros at a limited rate.
+type slowReader struct {
+	ctx       context.Context
+	remaining int64
+}
+
+// Read returns up to 100 kB of zeros every 10 milliseconds.
+func (r *slowReader) Read(p []byte) (int, error) {
+	if r.remaining <= 0 {
+		return 0, io.EOF
+	}
+	select {
+	case <-r.ctx.Done():
+		return 0, r.ctx.Err()
+	case <-time.After(10 * time.Millisecond):
+	}
+	n := int64(min(len(p), 100_000))
+	n = min(n, r.remaining)
+	for i := range p[:n] {
+		p[i] = 0
+	}
+	r.remaining -= n
+	return int(n), nil
+}
@@ -0,0 +1,40 @@
+package progress
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model. The colors of the determinate bar are configured with the Gradient option.
+type Styles struct {
+	Label    lipgloss.Style // Label is the style of the label.
+	Stats    lipgloss.Style // Stats is the style of the percentage, amounts, rate and ETA.
+	Activity lipgloss.Style // Activity is the style of the moving block of an indeterminate bar.
+	Empty    lipgloss.Style // Empty is the style of the empty part of an indeterminate bar.
+	Success  lipgloss.Style // Success is the style of the glyph of a finished operation.
+	Failure  lipgloss.Style // Failure is the style of the glyph of a failed operation.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:    lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Stats:    lipgloss.NewStyle().Foreground(t.Text),
+		Activity: lipgloss.NewStyle().Foreground(t.Accent),
+		Empty:    lipgloss.NewStyle().Faint(true),
+		Success:  lipgloss.NewStyle().Foreground(t.Success),
+		Failure:  lipgloss.NewStyle().Foreground(t.Failure),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
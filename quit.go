@@ -0,0 +1,32 @@
+package ui
+
+import "sync/atomic"
+
+// QuitPolicy determines what the Quit key binding (ctrl+c by default) does in all components.
+type QuitPolicy int32
+
+const (
+	QuitProgram  QuitPolicy = iota // QuitProgram requests to quit the whole program, reported as QuitError.
+	CancelPrompt                   // CancelPrompt cancels the current prompt only, like the Cancel key binding.
+)
+
+var quitPolicy atomic.Int32
+
+// DefaultQuitPolicy returns the quit policy used by all components.
+func DefaultQuitPolicy() QuitPolicy {
+	return QuitPolicy(quitPolicy.Load())
+}
+
+// SetDefaultQuitPolicy sets the quit policy used by all components, e.g. CancelPrompt for applications in which
+// ctrl+c should only abort the current prompt.
+func SetDefaultQuitPolicy(p QuitPolicy) {
+	quitPolicy.Store(int32(p))
+}
+
+// Flags returns the canceled and quit flags a component sets when the Quit key binding was pressed.
+func (p QuitPolicy) Flags() (canceled, quit bool) {
+	if p == CancelPrompt {
+		return true, false
+	}
+	return false, true
+}
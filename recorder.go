@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// EventType is the type of an Event.
+type EventType int
+
+const (
+	EventShown    EventType = iota // EventShown is recorded when a prompt is shown.
+	EventAnswered                  // EventAnswered is recorded when a prompt was answered.
+	EventCanceled                  // EventCanceled is recorded when a prompt was canceled.
+	EventQuit                      // EventQuit is recorded when quitting the program was requested.
+	EventFailed                    // EventFailed is recorded when running a prompt failed.
+)
+
+// String returns the name of the event type.
+func (t EventType) String() string {
+	switch t {
+	case EventShown:
+		return "shown"
+	case EventAnswered:
+		return "answered"
+	case EventCanceled:
+		return "canceled"
+	case EventQuit:
+		return "quit"
+	case EventFailed:
+		return "failed"
+	}
+	return "unknown"
+}
+
+// Event is a structured record of something happening to a prompt.
+type Event struct {
+	Type     EventType     // Type is the type of the event.
+	Key      string        // Key identifies the prompt, see AnswerableModel.
+	Time     time.Time     // Time is the time the event occurred.
+	Answer   any           // Answer is the answer given, if the prompt was answered.
+	Preset   bool          // Preset indicates whether the answer was preset instead of entered by the user.
+	Secret   bool          // Secret indicates whether the prompt implements SecretModel and hides its answer.
+	Duration time.Duration // Duration is the time the prompt was active.
+	Err      error         // Err is the error returned by the prompt, if any.
+}
+
+var recorder struct {
+	sync.Mutex
+	fn func(Event)
+}
+
+// SetRecorder sets a function receiving an event whenever a prompt is shown or finished, e.g. to write an audit trail
+// of interactive sessions. Prompts are models implementing AnswerableModel. Passing nil disables recording.
+func SetRecorder(fn func(Event)) {
+	recorder.Lock()
+	defer recorder.Unlock()
+	recorder.fn = fn
+}
+
+// record passes the event to the recorder and the metrics, if any.
+func record(e Event) {
+	recorder.Lock()
+	defer recorder.Unlock()
+	if recorder.fn != nil {
+		recorder.fn(e)
+	}
+	writeJSON(e)
+	measure(e)
+}
+
+// resultEvent returns the event describing the result of running the model.
+func resultEvent(m tea.Model, key string, preset bool, start time.Time, err error) Event {
+	e := Event{
+		Key:      key,
+		Time:     time.Now(),
+		Preset:   preset,
+		Duration: time.Since(start),
+		Err:      err,
+	}
+	if sm, ok := m.(SecretModel); ok {
+		e.Secret = sm.Secret()
+	}
+	switch {
+	case err == nil:
+		e.Type = EventAnswered
+		if am, ok := m.(AnswerableModel); ok {
+			e.Answer = am.Answer()
+		}
+	case errors.Is(err, QuitError):
+		e.Type = EventQuit
+	case errors.Is(err, CanceledError):
+		e.Type = EventCanceled
+	default:
+		e.Type = EventFailed
+	}
+	return e
+}
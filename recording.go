@@ -0,0 +1,138 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/muesli/termenv"
+)
+
+// recordEntry is a single frame or input event of a recording, stored as one JSON object per line.
+type recordEntry struct {
+	Time  time.Duration `json:"time"`            // Time is the offset from the first entry of the recording.
+	Frame *string       `json:"frame,omitempty"` // Frame is the rendered view, if it changed.
+	Key   string        `json:"key,omitempty"`   // Key is the pressed key.
+	Mouse string        `json:"mouse,omitempty"` // Mouse is the mouse event.
+	Size  *recordSize   `json:"size,omitempty"`  // Size is the size of the terminal after it was resized.
+}
+
+// recordSize is the size of the terminal in a recording.
+type recordSize struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// recording writes the frames and input events of a program to a file. A nil recording records nothing.
+type recording struct {
+	f     *os.File
+	enc   *json.Encoder
+	start time.Time // start is the time of the first entry.
+	frame string    // frame is the last recorded frame.
+	err   error     // err is the first error writing the file.
+}
+
+// Record records all frames and input events of the run to the file at the given path, e.g. for demos, bug reports
+// or comparing the rendering before and after a change. The recording can be played back using Replay.
+func Record(path string) tea.ProgramOption {
+	return option(func(s *settings) {
+		s.record = path
+	})
+}
+
+// createRecording creates the file at the given path and returns a recording writing to it.
+func createRecording(path string) (*recording, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return &recording{f: f, enc: json.NewEncoder(f)}, nil
+}
+
+// write writes the entry, keeping the first error.
+func (r *recording) write(e recordEntry) {
+	if r.err != nil {
+		return
+	}
+	if r.start.IsZero() {
+		r.start = time.Now()
+	}
+	e.Time = time.Since(r.start)
+	r.err = r.enc.Encode(e)
+}
+
+// input records the message if it is an input event.
+func (r *recording) input(msg tea.Msg) {
+	if r == nil {
+		return
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		r.write(recordEntry{Key: msg.String()})
+	case tea.MouseMsg:
+		r.write(recordEntry{Mouse: msg.String()})
+	case tea.WindowSizeMsg:
+		r.write(recordEntry{Size: &recordSize{Width: msg.Width, Height: msg.Height}})
+	}
+}
+
+// view records the view if it differs from the last frame.
+func (r *recording) view(view string) {
+	if r == nil || view == r.frame {
+		return
+	}
+	r.frame = view
+	r.write(recordEntry{Frame: &view})
+}
+
+// close closes the file and returns the first error that occurred.
+func (r *recording) close() error {
+	if r == nil {
+		return nil
+	}
+	err := r.f.Close()
+	if r.err != nil {
+		err = r.err
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", r.f.Name(), err)
+	}
+	return nil
+}
+
+// Replay plays back the frames of a recording created using Record on stdout. A speed of 2 plays the recording twice
+// as fast, a speed of 0 or less shows the frames without any delay.
+func Replay(path string, speed float64) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	out := termenv.NewOutput(os.Stdout)
+	dec := json.NewDecoder(f)
+	var last time.Duration
+	for {
+		var e recordEntry
+		if err := dec.Decode(&e); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if e.Frame == nil {
+			continue
+		}
+		if speed > 0 {
+			time.Sleep(time.Duration(float64(e.Time-last) / speed))
+		}
+		last = e.Time
+		out.ClearScreen()
+		fmt.Fprint(out, *e.Frame)
+	}
+	fmt.Fprintln(out)
+	return nil
+}
@@ -0,0 +1,149 @@
+package ui
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui/history"
+)
+
+// rememberFile is the file remembered answers are kept in, see SetRememberFile.
+var rememberFile struct {
+	sync.Mutex
+	path string
+}
+
+// hintStyle is the style of the hint shown below a prompt offering a remembered answer.
+var hintStyle = lipgloss.NewStyle().Faint(true)
+
+// WithRemember remembers the answer of the prompt under the given key once the user confirmed it, and offers it as
+// the default the next time the prompt is run with the same key, taking precedence over defaults set by WithDefaults.
+// While a remembered answer is offered, the Forget binding of the default key map (ctrl+x) restores the regular
+// default and clears the remembered answer. The prompt must implement AnswerableModel, and secret answers are never
+// remembered (see SecretModel); the answers are kept in the file set by SetRememberFile. Errors reading or writing the
+// file are ignored, so that remembering never keeps a prompt from working.
+func WithRemember(key string) tea.ProgramOption {
+	return option(func(s *settings) {
+		s.remember = key
+	})
+}
+
+// SetRememberFile sets the file the answers of prompts run with WithRemember are kept in. By default, it is
+// remembered.json in the state directory of the program, named after the executable, e.g.
+// ~/.local/state/mytool/remembered.json on Linux.
+func SetRememberFile(path string) {
+	rememberFile.Lock()
+	defer rememberFile.Unlock()
+	rememberFile.path = path
+}
+
+// ForgetAnswer clears the answer remembered under the given key, e.g. for a command resetting the remembered answers.
+func ForgetAnswer(key string) error {
+	store, err := rememberStore()
+	if err != nil {
+		return err
+	}
+	return store.Clear(key)
+}
+
+// rememberStore returns the store keeping the remembered answers, one entry per key.
+func rememberStore() (*history.Store, error) {
+	rememberFile.Lock()
+	path := rememberFile.path
+	rememberFile.Unlock()
+	if path == "" {
+		app := strings.TrimSuffix(filepath.Base(os.Args[0]), ".exe")
+		var err error
+		if path, err = history.StateFile(app, "remembered.json"); err != nil {
+			return nil, err
+		}
+	}
+	return history.New(path, history.MaxEntries(1)), nil
+}
+
+// memory is the remembered answer of a prompt run with WithRemember.
+type memory struct {
+	key       string          // key is the key the answer is remembered under.
+	model     AnswerableModel // model is the prompt.
+	store     *history.Store  // store keeps the remembered answers.
+	initial   any             // initial is the answer of the prompt before the remembered one was offered.
+	recalled  bool            // recalled is set if the remembered answer is offered.
+	forgotten bool            // forgotten is set if the user cleared the remembered answer.
+	confirmed bool            // confirmed is set once the user confirmed the answer, hiding the hint.
+}
+
+// recall offers the answer remembered for the model as its default, if enabled by WithRemember. It returns nil if
+// answers are not remembered for the model.
+func recall(m tea.Model, s *settings) *memory {
+	am, ok := m.(AnswerableModel)
+	if !ok || s.remember == "" {
+		return nil
+	}
+	if sm, ok := m.(SecretModel); ok && sm.Secret() {
+		return nil
+	}
+	store, err := rememberStore()
+	if err != nil {
+		return nil
+	}
+	mem := &memory{key: s.remember, model: am, store: store, initial: am.Answer()}
+	entries, err := store.Entries(s.remember)
+	if err != nil || len(entries) == 0 {
+		return mem
+	}
+	var v any
+	if json.Unmarshal([]byte(entries[0]), &v) == nil && am.SetAnswer(v) == nil {
+		mem.recalled = true
+	}
+	return mem
+}
+
+// save remembers the confirmed answer of the model.
+func (m *memory) save() {
+	if m == nil {
+		return
+	}
+	if b, err := json.Marshal(m.model.Answer()); err == nil {
+		_ = m.store.Add(m.key, string(b))
+	}
+}
+
+// handle clears the remembered answer if the Forget key was pressed while it is offered, and reports whether the
+// message was consumed.
+func (m *memory) handle(msg tea.Msg) bool {
+	k, ok := msg.(tea.KeyMsg)
+	if m == nil || !ok {
+		return false
+	}
+	km := DefaultKeyMap()
+	switch {
+	case key.Matches(k, km.Confirm):
+		m.confirmed = true
+	case m.recalled && key.Matches(k, km.Forget):
+		_ = m.model.SetAnswer(m.initial)
+		_ = m.store.Clear(m.key)
+		m.recalled, m.forgotten = false, true
+		return true
+	}
+	return false
+}
+
+// view adds a hint below the view while the remembered answer is offered, and after it was cleared.
+func (m *memory) view(view string) string {
+	if m == nil || m.confirmed {
+		return view
+	}
+	switch {
+	case m.recalled:
+		return view + "\n" + hintStyle.Render("remembered answer · "+DefaultKeyMap().Forget.Help().Key+" to forget")
+	case m.forgotten:
+		return view + "\n" + hintStyle.Render("remembered answer forgotten")
+	}
+	return view
+}
@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+)
+
+// ErrorSetter is implemented by models that can display an error, e.g. why the previous answer was rejected.
+type ErrorSetter interface {
+	SetError(err error)
+}
+
+// RenderError renders the error as a styled single-line banner.
+func RenderError(err error) string {
+	return RenderErrorWith(DefaultErrorStyle(), err)
+}
+
+// RenderErrorWith renders the error like RenderError, using the given style.
+func RenderErrorWith(style lipgloss.Style, err error) string {
+	return style.Render(fmt.Sprintf("%s %v", Glyphs().Failure, err))
+}
+
+// DefaultErrorStyle returns the style used by RenderError, which components use for errors by default. It is built
+// from the current theme.
+func DefaultErrorStyle() lipgloss.Style {
+	return CurrentTheme().ErrorStyle()
+}
+
+// Retry returns a prompt that runs the given prompt until its answer passes validation or the given number of
+// attempts is exhausted, in which case the last validation error is returned. If attempts is less than 1, the number
+// of attempts is unlimited. The validation error is displayed by prompts implementing ErrorSetter and printed to
+// stderr otherwise. Errors returned by the prompt itself, like CanceledError, are returned immediately.
+func Retry[T any](prompt Prompt[T], validate func(T) error, attempts int) Prompt[T] {
+	return PromptFunc[T](func(ctx context.Context) (T, error) {
+		es, _ := prompt.(ErrorSetter)
+		var zero T
+		var err error
+		for i := 0; attempts < 1 || i < attempts; i++ {
+			v, runErr := prompt.Run(ctx)
+			if runErr != nil {
+				return zero, runErr
+			}
+			if err = validate(v); err == nil {
+				if es != nil {
+					es.SetError(nil)
+				}
+				return v, nil
+			}
+			if es != nil {
+				es.SetError(err)
+			} else {
+				fmt.Fprintln(os.Stderr, RenderError(err))
+			}
+		}
+		return zero, err
+	})
+}
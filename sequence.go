@@ -0,0 +1,264 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// Step is a single prompt of a sequence.
+type Step struct {
+	Key   string    // Key identifies the answer in the results. If empty, the key of the model is used.
+	Model tea.Model // Model is the prompt to run. It is finished as soon as it quits the program.
+}
+
+// key returns the key identifying the answer of the step.
+func (s Step) key() string {
+	if s.Key != "" {
+		return s.Key
+	}
+	return promptKey(s.Model)
+}
+
+// Results holds the answers given in a sequence, keyed by step. Answers are obtained from models implementing
+// AnswerableModel.
+type Results map[string]any
+
+// Sequence runs the given steps back-to-back in a single program and returns their answers. The sequence stops as
+// soon as a step is canceled or quit, in which case the answers given so far are returned along with CanceledError or
+// QuitError. The Back binding of the default key map (shift+tab) returns to the previous step, which shows its answer
+// for editing, unless the current step uses the key itself, see KeyModel; steps with preset answers are skipped. Going back is not supported in accessible mode.
+func Sequence(steps ...Step) (Results, error) {
+	return SequenceWithOptions(nil, steps...)
+}
+
+// SequenceWithOptions is like Sequence, but passes the given options to the program.
+func SequenceWithOptions(opts []tea.ProgramOption, steps ...Step) (Results, error) {
+	return SequenceContext(context.Background(), steps, opts...)
+}
+
+// SequenceContext is like SequenceWithOptions, but aborts the sequence when the context is done, like RunContext.
+// TimeoutError is returned if its deadline expired.
+func SequenceContext(ctx context.Context, steps []Step, opts ...tea.ProgramOption) (Results, error) {
+	if ctx.Err() != nil {
+		return nil, contextErr(ctx)
+	}
+	s := resolve(opts)
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	results := make(Results)
+	if s.accessible || s.plan != nil {
+		for _, step := range steps {
+			if err := RunContext(ctx, step.Model, opts...); err != nil {
+				return results, err
+			}
+			if am, ok := step.Model.(AnswerableModel); ok {
+				results[step.key()] = am.Answer()
+			}
+		}
+		return results, nil
+	}
+
+	seq := &sequence{
+		steps:    steps,
+		results:  results,
+		answers:  s.answers,
+		defaults: s.defaults,
+	}
+	s.ctx = ctx
+	if err := run(seq, s, contextOptions(ctx, opts)); err != nil {
+		return results, contextResult(ctx, err)
+	}
+	return results, seq.err
+}
+
+// stepDoneMsg is sent when the step with the given index quit.
+type stepDoneMsg struct {
+	step int
+}
+
+// sequence is the model running the steps of a sequence.
+type sequence struct {
+	steps    []Step             // steps are the steps to run.
+	current  int                // current is the index of the running step.
+	started  time.Time          // started is the time the current step was started.
+	results  Results            // results holds the answers given so far.
+	answers  map[string]any     // answers are the preset answers.
+	defaults map[string]any     // defaults are the default answers.
+	summary  []string           // summary holds a line for each finished step.
+	visited  []visit            // visited holds the steps shown before the current one, for going back.
+	size     *tea.WindowSizeMsg // size is the last known window size, passed on to new steps.
+	err      error              // err is set if a step was canceled or quit.
+}
+
+// visit is a step shown by a sequence.
+type visit struct {
+	step    int // step is the index of the step.
+	summary int // summary is the number of summary lines before the step was finished.
+}
+
+// Init starts the first step.
+func (s *sequence) Init() tea.Cmd {
+	return s.start()
+}
+
+// hosted returns the model of the current step. It implements hostModel.
+func (s *sequence) hosted() tea.Model {
+	if s.current < len(s.steps) {
+		return s.steps[s.current].Model
+	}
+	return nil
+}
+
+// start starts the current step. Steps with preset answers are skipped. If no steps are left, the program is quit.
+func (s *sequence) start() tea.Cmd {
+	for ; s.current < len(s.steps); s.current++ {
+		step := s.steps[s.current]
+		s.started = time.Now()
+
+		applyDefault(step.Model, s.defaults)
+		if am, ok := step.Model.(AnswerableModel); ok {
+			if v, ok := lookupAnswer(s.answers, am.Key()); ok {
+				if err := am.SetAnswer(v); err != nil {
+					s.err = fmt.Errorf("%s: %w", am.Key(), err)
+					return tea.Quit
+				}
+				record(resultEvent(step.Model, am.Key(), true, s.started, nil))
+				s.finished(step)
+				continue
+			}
+		}
+
+		return s.show()
+	}
+	return tea.Quit
+}
+
+// show shows the current step.
+func (s *sequence) show() tea.Cmd {
+	step := s.steps[s.current]
+	record(Event{Type: EventShown, Key: promptKey(step.Model), Time: s.started})
+	cmds := []tea.Cmd{wrapStepCmd(s.current, step.Model.Init())}
+	if s.size != nil {
+		var cmd tea.Cmd
+		s.steps[s.current].Model, cmd = step.Model.Update(*s.size)
+		cmds = append(cmds, wrapStepCmd(s.current, cmd))
+	}
+	return tea.Batch(cmds...)
+}
+
+// back returns to the previously shown step, dropping the answers given since. The step keeps its answer, so it can
+// be edited.
+func (s *sequence) back() tea.Cmd {
+	v := s.visited[len(s.visited)-1]
+	s.visited = s.visited[:len(s.visited)-1]
+	for i := v.step; i < s.current; i++ {
+		delete(s.results, s.steps[i].key())
+	}
+	s.summary = s.summary[:v.summary]
+	s.current, s.started = v.step, time.Now()
+	return s.show()
+}
+
+// finish finishes the current step and starts the next one, unless the step was canceled or quit.
+func (s *sequence) finish() tea.Cmd {
+	step := s.steps[s.current]
+	var err error
+	if sm, ok := step.Model.(StandardModel); ok {
+		err = ErrorOrValidate(nil, sm)
+	}
+	record(resultEvent(step.Model, promptKey(step.Model), false, s.started, err))
+	if err != nil {
+		s.err = err
+		return tea.Quit
+	}
+
+	s.visited = append(s.visited, visit{step: s.current, summary: len(s.summary)})
+	s.finished(step)
+	s.current++
+	return s.start()
+}
+
+// finished stores the answer of the step and adds it to the summary.
+func (s *sequence) finished(step Step) {
+	am, ok := step.Model.(AnswerableModel)
+	if !ok {
+		return
+	}
+	key, answer := step.key(), am.Answer()
+	s.results[key] = answer
+	s.summary = append(s.summary, fmt.Sprintf("%s: %v", normalizeKey(key), displayAnswer(step.Model, answer)))
+}
+
+// Update handles the Back key and passes theme messages to all steps and all other messages to the current step.
+func (s *sequence) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case stepDoneMsg:
+		if msg.step == s.current {
+			return s, s.finish()
+		}
+		return s, nil
+	case tea.WindowSizeMsg:
+		s.size = &msg
+	case ThemeMsg:
+		// Steps not shown yet were styled when they were created.
+		var cmds []tea.Cmd
+		for i := range s.steps {
+			var cmd tea.Cmd
+			s.steps[i].Model, cmd = s.steps[i].Model.Update(msg)
+			cmds = append(cmds, wrapStepCmd(i, cmd))
+		}
+		return s, tea.Batch(cmds...)
+	case tea.KeyMsg:
+		if len(s.visited) > 0 && s.current < len(s.steps) && key.Matches(msg, DefaultKeyMap().Back) &&
+			!usesKey(s.steps[s.current].Model, msg) {
+			return s, s.back()
+		}
+	}
+
+	if s.current >= len(s.steps) {
+		return s, nil
+	}
+	var cmd tea.Cmd
+	s.steps[s.current].Model, cmd = s.steps[s.current].Model.Update(msg)
+	return s, wrapStepCmd(s.current, cmd)
+}
+
+// View renders the summary of the finished steps followed by the current step.
+func (s *sequence) View() string {
+	var b strings.Builder
+	for _, line := range s.summary {
+		fmt.Fprintln(&b, line)
+	}
+	if s.current < len(s.steps) {
+		fmt.Fprint(&b, s.steps[s.current].Model.View())
+	}
+	return b.String()
+}
+
+// wrapStepCmd wraps the command returned by the given step so that quitting the program finishes the step instead.
+func wrapStepCmd(step int, cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		switch msg := cmd().(type) {
+		case tea.QuitMsg:
+			return stepDoneMsg{step: step}
+		case tea.BatchMsg:
+			batch := make(tea.BatchMsg, len(msg))
+			for i, cmd := range msg {
+				batch[i] = wrapStepCmd(step, cmd)
+			}
+			return batch
+		default:
+			return msg
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package ui
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// Session runs prompts one after another in a single program, avoiding the flicker and input latency of starting a
+// new program for each prompt. When a prompt is finished, a summary line is committed to the scrollback. A Session
+// must be closed after use, as it uses the terminal exclusively until then.
+type Session struct {
+	opts     []tea.ProgramOption // opts are the options of the program.
+	settings *settings           // settings are the go-ui settings resolved from opts.
+	program  *tea.Program        // program is the program hosting the prompts.
+	host     *sessionModel       // host is the model of the program.
+	printer  *Printer            // printer prints above the prompts of the session, see Printer.
+	started  bool                // started indicates whether the program was started.
+	done     chan struct{}       // done is closed when the program exited.
+	err      error               // err is returned by prompts run after the program exited.
+	exitErr  error               // exitErr is the error the program exited with, nil if it was closed normally.
+
+	mu sync.Mutex // mu serializes running prompts.
+}
+
+// NewSession returns a new session running prompts with the given options. The program is started when the first
+// prompt is run.
+func NewSession(opts ...tea.ProgramOption) *Session {
+	s := &Session{
+		opts:     opts,
+		settings: resolve(opts),
+		host:     &sessionModel{},
+		done:     make(chan struct{}),
+	}
+	s.printer = NewPrinter(s.settings.output)
+	s.settings.printers = append(s.settings.printers, s.printer)
+	return s
+}
+
+// start starts the program in the background.
+func (s *Session) start() {
+	s.started = true
+	if err := acquireTerminal(s.settings); err != nil {
+		s.err, s.exitErr = err, err
+		close(s.done)
+		return
+	}
+	sm, err := newSafeModel(s.host, s.settings)
+	if err != nil {
+		releaseTerminal(s.settings)
+		s.err, s.exitErr = err, err
+		close(s.done)
+		return
+	}
+	opts := append(s.opts, legacyOptions(s.settings)...)
+	s.program = tea.NewProgram(sm, append(opts, tea.WithoutSignalHandler())...)
+	sm.program = s.program
+
+	stop := handleSignals(s.program)
+	stopResize := forwardWindowSize(s.program, s.settings)
+	detach, untrack := attachPrinters(s.settings, s.program), track(s.program)
+	go func() {
+		defer close(s.done)
+		defer releaseTerminal(s.settings)
+		_, err := s.program.Run()
+		untrack()
+		detach()
+		stopResize()
+		if cerr := sm.close(); err == nil {
+			err = cerr
+		}
+		switch {
+		case stop():
+			s.exitErr = TerminatedError
+		case sm.panicErr() != nil:
+			s.exitErr = sm.panicErr()
+		default:
+			s.exitErr = err
+		}
+		s.err = s.exitErr
+		if s.err == nil {
+			s.err = fmt.Errorf("session closed: %w", QuitError)
+		}
+	}()
+}
+
+// Run runs the model in the session and returns like ui.Run once the model quits. If the session's program exited,
+// e.g. because a signal was received, the corresponding error is returned.
+func (s *Session) Run(m tea.Model) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.settings.err != nil {
+		return s.settings.err
+	}
+	return withMiddleware(s.run)(m)
+}
+
+// run runs the model in the session's program, or like Run if an answer was preset or in accessible or dry-run mode.
+func (s *Session) run(m tea.Model) error {
+	if _, _, ok := presetAnswer(m, s.settings); ok || s.settings.accessible || s.settings.plan != nil {
+		// runModel changes the settings for the prompt, e.g. its input and memory, so it gets a copy.
+		settings := *s.settings
+		return runModel(m, &settings, s.opts)
+	}
+
+	if !s.started {
+		s.start()
+	}
+	select {
+	case <-s.done:
+		return s.err
+	default:
+	}
+	applyDefault(m, s.settings.defaults)
+	applyTheme(m, s.settings)
+
+	start := time.Now()
+	key := promptKey(m)
+	_, isPrompt := m.(AnswerableModel)
+	if isPrompt {
+		record(Event{Type: EventShown, Key: key, Time: start})
+	}
+	mem := recall(m, s.settings)
+
+	result := make(chan error, 1)
+	s.program.Send(sessionRunMsg{model: m, memory: mem, result: result})
+	select {
+	case err := <-result:
+		if err == nil {
+			mem.save()
+		}
+		if isPrompt {
+			record(resultEvent(m, key, false, start, err))
+		}
+		return err
+	case <-s.done:
+		return s.err
+	}
+}
+
+// Printer returns a printer that prints lines above the prompts of the session while its program runs, e.g. for the
+// progress of background work between prompts. Lines are written to the output of the session otherwise.
+func (s *Session) Printer() *Printer {
+	return s.printer
+}
+
+// Close quits the program of the session, waits for it to exit and returns the error it exited with, e.g. if the
+// terminal could not be restored. It returns nil if the session was closed normally or no prompt was run.
+func (s *Session) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.started {
+		return nil
+	}
+	if s.program != nil {
+		s.program.Quit()
+	}
+	<-s.done
+	return s.exitErr
+}
+
+// sessionRunMsg makes the session model run the given model.
+type sessionRunMsg struct {
+	model  tea.Model
+	memory *memory
+	result chan<- error
+}
+
+// sessionModel is the model of a session's program, hosting the model currently run.
+type sessionModel struct {
+	current tea.Model          // current is the model currently run, or nil.
+	id      int                // id identifies the current model, see stepDoneMsg.
+	result  chan<- error       // result receives the result of the current model.
+	memory  *memory            // memory offers the remembered answer of the current model, if enabled.
+	size    *tea.WindowSizeMsg // size is the last known window size, passed on to new models.
+}
+
+// hosted returns the model currently run. It implements hostModel.
+func (m *sessionModel) hosted() tea.Model {
+	return m.current
+}
+
+// Init does nothing, as models are started when they are run.
+func (m *sessionModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update starts and finishes models and passes all other messages to the current model.
+func (m *sessionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case sessionRunMsg:
+		m.id++
+		m.current, m.memory, m.result = msg.model, msg.memory, msg.result
+		cmds := []tea.Cmd{wrapStepCmd(m.id, m.current.Init())}
+		if m.size != nil {
+			var cmd tea.Cmd
+			m.current, cmd = m.current.Update(*m.size)
+			cmds = append(cmds, wrapStepCmd(m.id, cmd))
+		}
+		return m, tea.Batch(cmds...)
+	case stepDoneMsg:
+		if msg.step != m.id || m.current == nil {
+			return m, nil
+		}
+		return m, m.finish()
+	case tea.WindowSizeMsg:
+		m.size = &msg
+	}
+
+	if m.current == nil || m.memory.handle(msg) {
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.current, cmd = m.current.Update(msg)
+	return m, wrapStepCmd(m.id, cmd)
+}
+
+// finish finishes the current model, commits its summary to the scrollback and reports the result.
+func (m *sessionModel) finish() tea.Cmd {
+	var err error
+	if sm, ok := m.current.(StandardModel); ok {
+		err = ErrorOrValidate(nil, sm)
+	}
+
+	var cmds []tea.Cmd
+	if am, ok := m.current.(AnswerableModel); ok && err == nil {
+		cmds = append(cmds, tea.Println(answerSummary(am)))
+	}
+	result := m.result
+	cmds = append(cmds, func() tea.Msg {
+		result <- err
+		return nil
+	})
+
+	m.current, m.memory, m.result = nil, nil, nil
+	return tea.Sequence(cmds...)
+}
+
+// View renders the current model, with the hint of its remembered answer, if any.
+func (m *sessionModel) View() string {
+	if m.current == nil {
+		return ""
+	}
+	return m.memory.view(m.current.View())
+}
@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// handleSignals quits the given program gracefully when SIGINT or SIGTERM is received, so the terminal is restored
+// before Run returns. The returned function stops the handling and reports whether a signal was received.
+//
+// On Windows, where ctrl+break is reported as SIGINT, the first SIGINT is sent to the model as ctrl+c instead, so it
+// is handled like the quit key; only a second one quits the program.
+func handleSignals(p *tea.Program) (stop func() bool) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+
+	var received atomic.Bool
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		keyed := !interruptIsKey
+		for {
+			select {
+			case sig := <-sigs:
+				if sig == os.Interrupt && !keyed {
+					keyed = true
+					p.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+					continue
+				}
+				received.Store(true)
+				p.Quit()
+			case <-done:
+			}
+			return
+		}
+	}()
+
+	return func() bool {
+		signal.Stop(sigs)
+		close(done)
+		<-finished
+		return received.Load()
+	}
+}
@@ -0,0 +1,131 @@
+package slider
+
+import (
+	"math"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Label sets the label of the slider.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Step sets the amount by which the value changes with the arrow keys. The selected value is snapped to the nearest
+// step from the minimum. A step of 0 allows any value, which is only reachable via Default or SetValue.
+func Step(step float64) Option {
+	return func(m *Model) {
+		m.step = math.Abs(step)
+		m.value = m.snap(m.value)
+	}
+}
+
+// BigStep sets the amount by which the value changes with shift and an arrow key, or page up and down. By default,
+// it is ten steps.
+func BigStep(step float64) Option {
+	return func(m *Model) {
+		m.bigStep = math.Abs(step)
+	}
+}
+
+// Default sets the initially selected value, snapped to the nearest step and clamped to the range.
+func Default(v float64) Option {
+	return func(m *Model) {
+		m.value = m.snap(v)
+	}
+}
+
+// Precision sets the number of decimals shown. By default, it is the number of decimals of the step.
+func Precision(decimals int) Option {
+	return func(m *Model) {
+		m.precision = decimals
+	}
+}
+
+// Format sets the function formatting the value and the tick labels, e.g. to add a unit.
+func Format(format func(float64) string) Option {
+	return func(m *Model) {
+		m.format = format
+	}
+}
+
+// Ticks sets the values labeled below the slider. Values outside of the range are ignored.
+func Ticks(values ...float64) Option {
+	return func(m *Model) {
+		m.ticks = values
+	}
+}
+
+// Width sets the width of the slider in cells.
+func Width(width int) Option {
+	return func(m *Model) {
+		m.width = max(width, 2)
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
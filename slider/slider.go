@@ -0,0 +1,500 @@
+package slider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/plain"
+)
+
+var _ ui.Prompt[float64] = (*Model)(nil)
+
+var (
+	decKey    = key.NewBinding(key.WithKeys("left", "h", "down", "j", "-"), key.WithHelp("←/h", "decrease"))
+	incKey    = key.NewBinding(key.WithKeys("right", "l", "up", "k", "+"), key.WithHelp("→/l", "increase"))
+	bigDecKey = key.NewBinding(key.WithKeys("shift+left", "pgdown"), key.WithHelp("shift+←", "decrease more"))
+	bigIncKey = key.NewBinding(key.WithKeys("shift+right", "pgup"), key.WithHelp("shift+→", "increase more"))
+	minKey    = key.NewBinding(key.WithKeys("home", "g"), key.WithHelp("home", "minimum"))
+	maxKey    = key.NewBinding(key.WithKeys("end", "G"), key.WithHelp("end", "maximum"))
+)
+
+// Model represents a slider selecting a number from a range in steps.
+type Model struct {
+	label          string               // label is the label for the slider.
+	min            float64              // min is the smallest selectable value.
+	max            float64              // max is the largest selectable value.
+	step           float64              // step is the amount by which the value changes with the arrow keys.
+	bigStep        float64              // bigStep is the amount by which the value changes with shift and an arrow key.
+	value          float64              // value is the selected value.
+	precision      int                  // precision is the number of decimals shown, or -1 to derive it from the step.
+	format         func(float64) string // format formats the value and the tick labels, if set.
+	ticks          []float64            // ticks are the values labeled below the slider.
+	width          int                  // width is the width of the slider in cells.
+	cancelable     bool                 // cancelable determines if selection can be canceled with escape key
+	quitable       bool                 // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption  // programOptions are passed to the program running the model
+	id             string               // id identifies the prompt, e.g. for preset answers
+	embedded       bool                 // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                 // focused determines if the model handles key messages
+	keymap         ui.KeyMap            // keymap holds the key bindings of the model.
+	styles         Styles               // styles holds the styles of the model.
+	customStyles   bool                 // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error                // err is shown below the model, e.g. why the previous answer was rejected
+
+	canceled bool // canceled indicates whether the selection was canceled
+	quit     bool // quit indicates whether the selection was quit
+}
+
+// New creates and returns a new Model with the given label and range, configured by the given options. The value
+// changes in steps of 1 unless configured otherwise, and the minimum is selected initially.
+func New(label string, min, max float64, opts ...Option) *Model {
+	if max < min {
+		min, max = max, min
+	}
+	m := &Model{
+		label:      label,
+		min:        min,
+		max:        max,
+		step:       1,
+		value:      min,
+		precision:  -1,
+		width:      40,
+		cancelable: true,
+		quitable:   true,
+		focused:    true,
+		keymap:     ui.DefaultKeyMap(),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.value = m.snap(m.value)
+	return m
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithStep sets the amount by which the value changes with the arrow keys and returns a new Model with the updated
+// step.
+func (m *Model) WithStep(step float64) *Model {
+	return m.With(Step(step))
+}
+
+// WithBigStep sets the amount by which the value changes with shift and an arrow key and returns a new Model with the
+// updated step.
+func (m *Model) WithBigStep(step float64) *Model {
+	return m.With(BigStep(step))
+}
+
+// WithDefault sets the initially selected value and returns a new Model with the updated value.
+func (m *Model) WithDefault(v float64) *Model {
+	return m.With(Default(v))
+}
+
+// WithPrecision sets the number of decimals shown and returns a new Model with the updated precision.
+func (m *Model) WithPrecision(decimals int) *Model {
+	return m.With(Precision(decimals))
+}
+
+// WithFormat sets the function formatting the value and the tick labels and returns a new Model with the updated
+// function.
+func (m *Model) WithFormat(format func(float64) string) *Model {
+	return m.With(Format(format))
+}
+
+// WithTicks sets the values labeled below the slider and returns a new Model with the updated ticks.
+func (m *Model) WithTicks(values ...float64) *Model {
+	return m.With(Ticks(values...))
+}
+
+// WithWidth sets the width of the slider in cells and returns a new Model with the updated width.
+func (m *Model) WithWidth(width int) *Model {
+	return m.With(Width(width))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Value returns the selected value.
+func (m *Model) Value() float64 {
+	return m.value
+}
+
+// Int returns the selected value rounded to the nearest integer.
+func (m *Model) Int() int {
+	return int(math.Round(m.value))
+}
+
+// SetValue sets the selected value, snapped to the nearest step and clamped to the range.
+func (m *Model) SetValue(v float64) {
+	m.value = m.snap(v)
+}
+
+// snap returns v rounded to the nearest step from the minimum and clamped to the range.
+func (m *Model) snap(v float64) float64 {
+	if m.step > 0 {
+		v = m.min + math.Round((v-m.min)/m.step)*m.step
+		// Avoid accumulating floating point errors like 0.30000000000000004.
+		v, _ = strconv.ParseFloat(strconv.FormatFloat(v, 'f', m.decimals()+6, 64), 64)
+	}
+	return min(max(v, m.min), m.max)
+}
+
+// decimals returns the number of decimals shown: the configured precision, or the number of decimals of the step.
+func (m *Model) decimals() int {
+	if m.precision >= 0 {
+		return m.precision
+	}
+	s := strconv.FormatFloat(m.step, 'f', -1, 64)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return len(s) - i - 1
+	}
+	return 0
+}
+
+// String returns the selected value as plain number, e.g. "0.5".
+func (m *Model) String() string {
+	return m.number(m.value)
+}
+
+// number returns v as plain number with the shown number of decimals.
+func (m *Model) number(v float64) string {
+	return strconv.FormatFloat(v, 'f', m.decimals(), 64)
+}
+
+// text returns v formatted with the configured format function, or as plain number.
+func (m *Model) text(v float64) string {
+	if m.format != nil {
+		return m.format(v)
+	}
+	return m.number(v)
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is a number or a string holding one. It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	var f float64
+	switch v := v.(type) {
+	case float64:
+		f = v
+	case float32:
+		f = float64(v)
+	case int:
+		f = float64(v)
+	case int64:
+		f = float64(v)
+	case string:
+		var err error
+		if f, err = strconv.ParseFloat(strings.TrimSpace(v), 64); err != nil {
+			return fmt.Errorf("invalid number: %s", v)
+		}
+	default:
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+	if f < m.min || f > m.max {
+		return fmt.Errorf("value must be between %s and %s", m.number(m.min), m.number(m.max))
+	}
+	m.value = m.snap(f)
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the selected value as plain number string. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	return m.String()
+}
+
+// Focus focuses the model, so that it handles key messages. It returns no command and exists for compatibility with
+// other focusable models.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns a nil command.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// bigStepSize returns the configured big step, or ten steps if none is configured.
+func (m *Model) bigStepSize() float64 {
+	if m.bigStep > 0 {
+		return m.bigStep
+	}
+	return 10 * m.step
+}
+
+// Update handles key messages, changing the value and confirming it.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !m.focused {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, bigDecKey):
+		m.SetValue(m.value - m.bigStepSize())
+	case key.Matches(keyMsg, bigIncKey):
+		m.SetValue(m.value + m.bigStepSize())
+	case key.Matches(keyMsg, decKey):
+		m.SetValue(m.value - m.step)
+	case key.Matches(keyMsg, incKey):
+		m.SetValue(m.value + m.step)
+	case key.Matches(keyMsg, minKey):
+		m.SetValue(m.min)
+	case key.Matches(keyMsg, maxKey):
+		m.SetValue(m.max)
+	case key.Matches(keyMsg, m.keymap.Confirm):
+		m.canceled, m.quit = false, false
+		return m, m.done()
+	case key.Matches(keyMsg, m.keymap.Cancel):
+		if m.cancelable {
+			m.canceled, m.quit = true, false
+			return m, m.done()
+		}
+	case key.Matches(keyMsg, m.keymap.Quit):
+		if m.quitable {
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m, m.done()
+		}
+	}
+	return m, nil
+}
+
+// position returns the cell of the slider representing v.
+func (m *Model) position(v float64) int {
+	if m.max <= m.min || m.width < 2 {
+		return 0
+	}
+	return int(math.Round((v - m.min) / (m.max - m.min) * float64(m.width-1)))
+}
+
+// View renders the label, the slider with its readout and, if configured, the tick labels below it.
+func (m *Model) View() string {
+	var b strings.Builder
+	g := ui.Glyphs()
+	var prefix string
+	if m.label != "" {
+		prefix = m.styles.Label.Render(m.label) + " "
+	}
+	b.WriteString(prefix)
+
+	pos := m.position(m.value)
+	handle := m.styles.Handle
+	if !m.focused {
+		handle = m.styles.Track
+	}
+	b.WriteString(m.styles.Fill.Render(strings.Repeat(g.SliderFill, pos)))
+	b.WriteString(handle.Render(g.SliderHandle))
+	b.WriteString(m.styles.Track.Render(strings.Repeat(g.SliderTrack, max(0, m.width-pos-1))))
+	fmt.Fprintf(&b, " %s", m.styles.Value.Render(m.text(m.value)))
+
+	if ticks := m.tickLines(); ticks != nil {
+		indent := strings.Repeat(" ", lipgloss.Width(prefix))
+		for _, line := range ticks {
+			fmt.Fprintf(&b, "\n%s%s", indent, m.styles.Tick.Render(strings.TrimRight(line, " ")))
+		}
+	}
+	if m.err != nil {
+		fmt.Fprintf(&b, "\n%s", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return b.String()
+}
+
+// tickLines returns the line with the tick marks and the line with the tick labels, or nil if there are no ticks.
+// Labels are centered below their ticks and omitted if they would overlap the previous label.
+func (m *Model) tickLines() []string {
+	if len(m.ticks) == 0 {
+		return nil
+	}
+	ticks := append([]float64(nil), m.ticks...)
+	sort.Float64s(ticks)
+
+	marks := []rune(strings.Repeat(" ", m.width))
+	var labels strings.Builder
+	end := 0
+	for _, v := range ticks {
+		if v < m.min || v > m.max {
+			continue
+		}
+		pos := m.position(v)
+		marks[pos] = []rune(ui.Glyphs().Tick)[0]
+
+		label := m.text(v)
+		w := lipgloss.Width(label)
+		start := min(max(pos-w/2, 0), max(m.width-w, 0))
+		if end > 0 && start <= end {
+			continue
+		}
+		labels.WriteString(strings.Repeat(" ", start-end))
+		labels.WriteString(label)
+		end = start + w
+	}
+	return []string{string(marks), labels.String()}
+}
+
+// Run runs the model and returns the selected value. It implements ui.Prompt[float64].
+func (m *Model) Run(ctx context.Context) (float64, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return 0, err
+	}
+	return m.value, nil
+}
+
+// RunInt runs the model and returns the selected value rounded to the nearest integer.
+func (m *Model) RunInt(ctx context.Context) (int, error) {
+	if _, err := m.Run(ctx); err != nil {
+		return 0, err
+	}
+	return m.Int(), nil
+}
+
+// RunAccessible asks for the value as a line of text instead of using the terminal UI. It implements
+// ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	label := fmt.Sprintf("%s (%s-%s) ", m.label, m.number(m.min), m.number(m.max))
+	for {
+		s, err := p.Line(label, m.String())
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+		if err := m.SetAnswer(s); err != nil {
+			p.Println(fmt.Sprintf("Error: %v", err))
+			continue
+		}
+		return nil
+	}
+}
+
+// Showcase demonstrates the Model component with an integer and a formatted range.
+func Showcase() {
+	fmt.Println("=== Slider Showcase ===")
+
+	fmt.Println("\nInteger range with ticks (shift+arrow or page up/down for big steps):")
+	m := New("Workers:", 1, 64).WithDefault(8).WithTicks(1, 16, 32, 48, 64)
+	if n, err := m.RunInt(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Selected: %d\n", n)
+	}
+
+	fmt.Println("\nFractional range with a custom format:")
+	m = New("Opacity:", 0, 1).WithStep(0.05).WithDefault(0.8).WithWidth(30).
+		WithFormat(func(v float64) string { return fmt.Sprintf("%.0f%%", v*100) }).WithTicks(0, 0.5, 1)
+	if v, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Selected: %.2f\n", v)
+	}
+}
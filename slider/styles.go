@@ -0,0 +1,42 @@
+package slider
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Label  lipgloss.Style // Label is the style of the label.
+	Fill   lipgloss.Style // Fill is the style of the filled part of the slider.
+	Track  lipgloss.Style // Track is the style of the unfilled part of the slider.
+	Handle lipgloss.Style // Handle is the style of the handle marking the value.
+	Value  lipgloss.Style // Value is the style of the readout next to the slider.
+	Tick   lipgloss.Style // Tick is the style of the tick marks and labels below the slider.
+	Error  lipgloss.Style // Error is the style of the error shown below the slider.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:  lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Fill:   lipgloss.NewStyle().Foreground(t.Accent),
+		Track:  lipgloss.NewStyle().Faint(true),
+		Handle: lipgloss.NewStyle().Foreground(t.Accent).Bold(true),
+		Value:  lipgloss.NewStyle().Foreground(t.Text).Bold(true),
+		Tick:   lipgloss.NewStyle().Faint(true),
+		Error:  t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
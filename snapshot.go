@@ -0,0 +1,14 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// Snapshot renders the model at the given size without running a program, e.g. for golden-file tests of styled
+// prompts. The size is passed to the model as a tea.WindowSizeMsg first, so the model is updated; commands returned by
+// Init and Update are not executed. To compare snapshots across terminals, fix the color profile and glyphs
+// beforehand, e.g. using lipgloss.SetColorProfile(termenv.Ascii) for snapshots without colors and ForceUnicode.
+func Snapshot(m tea.Model, width, height int) string {
+	m, _ = m.Update(tea.WindowSizeMsg{Width: width, Height: height})
+	return m.View()
+}
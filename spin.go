@@ -0,0 +1,135 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/key"     // Manages key bindings
+	"github.com/charmbracelet/bubbles/spinner" // Provides spinner model
+	tea "github.com/charmbracelet/bubbletea"   // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"        // Styles terminal UI components
+)
+
+// Spin shows a spinner with the given label while fn runs and returns the error returned by fn. The user can cancel
+// the operation with esc or quit with ctrl+c, which cancels the context passed to fn and returns CanceledError or
+// QuitError once fn returned.
+func Spin(label string, fn func(ctx context.Context) error) error {
+	return SpinWithOptions(nil, label, fn)
+}
+
+// SpinWithOptions is like Spin, but passes the given options to the program.
+func SpinWithOptions(opts []tea.ProgramOption, label string, fn func(ctx context.Context) error) error {
+	return SpinContext(context.Background(), label, fn, opts...)
+}
+
+// SpinContext is like SpinWithOptions, but the context passed to fn is derived from ctx, and the spinner is stopped
+// when ctx is done, like RunContext. TimeoutError is returned if its deadline expired.
+func SpinContext(ctx context.Context, label string, fn func(ctx context.Context) error, opts ...tea.ProgramOption) error {
+	fnCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	m := &spinModel{
+		spinner: spinner.New(spinner.WithSpinner(spinner.Dot)),
+		label:   label,
+		fn:      fn,
+		ctx:     fnCtx,
+		cancel:  cancel,
+	}
+	if err := RunContext(ctx, m, opts...); err != nil {
+		return err
+	}
+	return m.err
+}
+
+// spinDoneMsg is sent when the function of a spinModel returned.
+type spinDoneMsg struct {
+	err error
+}
+
+// spinModel shows a spinner while running a function.
+type spinModel struct {
+	spinner spinner.Model                   // spinner is the spinner shown while running.
+	label   string                          // label is shown next to the spinner.
+	fn      func(ctx context.Context) error // fn is the function to run.
+	ctx     context.Context                 // ctx is passed to fn.
+	cancel  context.CancelFunc              // cancel cancels ctx.
+	done    bool                            // done indicates whether fn returned.
+	err     error                           // err is the error returned by fn.
+
+	canceled bool // canceled indicates whether the operation was canceled
+	quit     bool // quit indicates whether the operation was quit
+}
+
+// Canceled returns the canceled flag.
+func (m *spinModel) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *spinModel) Quit() bool {
+	return m.quit
+}
+
+// Init starts the spinner and the function.
+func (m *spinModel) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, func() tea.Msg {
+		return spinDoneMsg{err: m.fn(m.ctx)}
+	})
+}
+
+// Update advances the spinner and handles cancellation.
+func (m *spinModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		km := DefaultKeyMap()
+		switch {
+		case key.Matches(msg, km.Cancel):
+			m.canceled, m.quit = true, false
+			m.cancel()
+		case key.Matches(msg, km.Quit):
+			m.canceled, m.quit = DefaultQuitPolicy().Flags()
+			m.cancel()
+		}
+		return m, nil
+	case spinDoneMsg:
+		m.done, m.err = true, msg.err
+		return m, tea.Quit
+	}
+
+	var cmd tea.Cmd
+	m.spinner, cmd = m.spinner.Update(msg)
+	return m, cmd
+}
+
+// View renders the spinner and label while running, and a success or failure glyph when done. The colors are those of
+// the current theme, so that they follow SetTheme and ApplyTheme.
+func (m *spinModel) View() string {
+	t := CurrentTheme()
+	spinnerStyle := lipgloss.NewStyle().Foreground(t.Accent)
+	successStyle := lipgloss.NewStyle().Foreground(t.Success)
+	failureStyle := lipgloss.NewStyle().Foreground(t.Failure)
+	switch {
+	case !m.done && (m.canceled || m.quit):
+		return fmt.Sprintf("%s %s (canceling...)\n", spinnerStyle.Render(m.spinner.View()), m.label)
+	case !m.done:
+		return fmt.Sprintf("%s %s\n", spinnerStyle.Render(m.spinner.View()), m.label)
+	case m.canceled || m.quit:
+		return fmt.Sprintf("%s %s (canceled)\n", failureStyle.Render(Glyphs().Failure), m.label)
+	case m.err != nil:
+		return fmt.Sprintf("%s %s: %v\n", failureStyle.Render(Glyphs().Failure), m.label, m.err)
+	}
+	return fmt.Sprintf("%s %s\n", successStyle.Render(Glyphs().Success), m.label)
+}
+
+// RunAccessible runs the function, announcing its start and result as plain lines. It implements AccessibleModel.
+func (m *spinModel) RunAccessible(in io.Reader, out io.Writer) error {
+	fmt.Fprintf(out, "%s...\n", m.label)
+	m.done, m.err = true, m.fn(m.ctx)
+	if m.err != nil {
+		fmt.Fprintf(out, "%s: failed: %v\n", m.label, m.err)
+	} else {
+		fmt.Fprintf(out, "%s: done\n", m.label)
+	}
+	return nil
+}
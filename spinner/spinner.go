@@ -0,0 +1,408 @@
+package spinner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"     // Manages key bindings
+	"github.com/charmbracelet/bubbles/spinner" // Provides spinner model
+	tea "github.com/charmbracelet/bubbletea"   // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+)
+
+// ErrSkip is returned by a task to mark it as skipped instead of failed. It may be wrapped to give a reason, e.g.
+// fmt.Errorf("%w: already up to date", spinner.ErrSkip).
+var ErrSkip = errors.New("skipped")
+
+// State is the state of a task shown by the spinner.
+type State int
+
+const (
+	Running   State = iota // Running means the task has not finished yet.
+	Succeeded              // Succeeded means the task finished without error.
+	Failed                 // Failed means the task returned an error or was canceled.
+	Skipped                // Skipped means the task returned ErrSkip.
+)
+
+// String returns the name of the state.
+func (s State) String() string {
+	switch s {
+	case Running:
+		return "running"
+	case Succeeded:
+		return "succeeded"
+	case Failed:
+		return "failed"
+	case Skipped:
+		return "skipped"
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// Model represents a spinner shown while a task runs, which turns into a success, failure or skip glyph when the task
+// finished.
+type Model struct {
+	spinner        spinner.Model                   // spinner is the spinner shown while running.
+	label          string                          // label is shown next to the spinner.
+	showElapsed    bool                            // showElapsed determines if the elapsed time is shown.
+	fn             func(ctx context.Context) error // fn is the task to run; nil if the state is set by the caller
+	ctx            context.Context                 // ctx is passed to fn.
+	cancel         context.CancelFunc              // cancel cancels ctx.
+	state          State                           // state is the state of the task.
+	err            error                           // err is the error returned by the task.
+	started        time.Time                       // started is the time the task started.
+	elapsed        time.Duration                   // elapsed is the duration of the finished task.
+	cancelable     bool                            // cancelable determines if the task can be canceled with escape key
+	quitable       bool                            // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption             // programOptions are passed to the program running the model
+	embedded       bool                            // embedded determines if a DoneMsg is emitted instead of quitting the program
+	keymap         ui.KeyMap                       // keymap holds the key bindings of the model.
+	styles         Styles                          // styles holds the styles of the model.
+	customStyles   bool                            // customStyles is set if styles were set explicitly, which a theme switch keeps
+
+	canceled bool // canceled indicates whether the task was canceled
+	quit     bool // quit indicates whether the task was quit
+}
+
+// New creates and returns a new Model with the given label, configured by the given options.
+func New(label string, opts ...Option) *Model {
+	style := spinner.Dot
+	if ui.LegacyConsole() {
+		style = spinner.Line
+	}
+
+	m := &Model{
+		label:       label,
+		showElapsed: true,
+		cancelable:  true,
+		quitable:    true,
+		keymap:      ui.DefaultKeyMap(),
+		styles:      DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	m.spinner = spinner.New(spinner.WithSpinner(style))
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithSpinner sets the animation of the spinner and returns a new Model with the updated animation.
+func (m *Model) WithSpinner(s spinner.Spinner) *Model {
+	return m.With(Spinner(s))
+}
+
+// WithElapsed sets whether the elapsed time is shown and returns a new Model with the updated flag.
+func (m *Model) WithElapsed(show bool) *Model {
+	return m.With(Elapsed(show))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the task finished.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// SetLabel changes the label, e.g. to report progress while the task runs.
+func (m *Model) SetLabel(label string) {
+	m.label = label
+}
+
+// State returns the state of the task.
+func (m *Model) State() State {
+	return m.state
+}
+
+// Err returns the error returned by the task, or nil if it succeeded or is still running.
+func (m *Model) Err() error {
+	return m.err
+}
+
+// Elapsed returns the time the task has been running, or the duration of the task once it finished.
+func (m *Model) Elapsed() time.Duration {
+	if m.state != Running || m.started.IsZero() {
+		return m.elapsed
+	}
+	return time.Since(m.started)
+}
+
+// Finish sets the state according to the given error, like the result of a task: Succeeded for nil, Skipped for
+// ErrSkip and Failed otherwise. It is used to finish an embedded model that runs no task itself.
+func (m *Model) Finish(err error) {
+	if m.state != Running {
+		return
+	}
+	m.err = err
+	switch {
+	case err == nil:
+		m.state = Succeeded
+	case errors.Is(err, ErrSkip):
+		m.state = Skipped
+	default:
+		m.state = Failed
+	}
+	if !m.started.IsZero() {
+		m.elapsed = time.Since(m.started)
+	}
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the task finished. Use the model's State
+// and Err methods to determine how it finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// finishedMsg is sent when the task of a Model returned.
+type finishedMsg struct {
+	model *Model
+	err   error
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// Init starts the spinner, the elapsed time and the task, if any.
+func (m *Model) Init() tea.Cmd {
+	m.started = time.Now()
+	if m.fn == nil {
+		return m.spinner.Tick
+	}
+	if m.ctx == nil {
+		m.ctx, m.cancel = context.WithCancel(context.Background())
+	}
+	ctx, fn := m.ctx, m.fn
+	return tea.Batch(m.spinner.Tick, func() tea.Msg {
+		return finishedMsg{model: m, err: fn(ctx)}
+	})
+}
+
+// Update advances the spinner and handles the result of the task and cancellation.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.state != Running {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, m.keymap.Cancel):
+			if m.cancelable {
+				m.canceled, m.quit = true, false
+				return m, m.abort()
+			}
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, m.abort()
+			}
+		}
+		return m, nil
+	case finishedMsg:
+		if msg.model != m {
+			return m, nil
+		}
+		if m.canceled || m.quit {
+			m.Finish(context.Canceled)
+		} else {
+			m.Finish(msg.err)
+		}
+		return m, m.done()
+	}
+
+	if m.state != Running {
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.spinner, cmd = m.spinner.Update(msg)
+	return m, cmd
+}
+
+// abort cancels the context of the task, which finishes the model once the task returned. Without a task, the model
+// is finished immediately.
+func (m *Model) abort() tea.Cmd {
+	if m.fn == nil {
+		m.Finish(context.Canceled)
+		return m.done()
+	}
+	m.cancel()
+	return nil
+}
+
+// View renders the spinner and label while running, and the glyph of the final state when done.
+func (m *Model) View() string {
+	var b strings.Builder
+	g := ui.Glyphs()
+	switch m.state {
+	case Running:
+		b.WriteString(m.styles.Spinner.Render(m.spinner.View()))
+	case Succeeded:
+		b.WriteString(m.styles.Success.Render(g.Success))
+	case Failed:
+		b.WriteString(m.styles.Failure.Render(g.Failure))
+	case Skipped:
+		b.WriteString(m.styles.Skipped.Render(g.Skipped))
+	}
+	fmt.Fprintf(&b, " %s", m.styles.Label.Render(m.label))
+
+	switch {
+	case m.state == Running && (m.canceled || m.quit):
+		b.WriteString(" (canceling...)")
+	case m.state == Failed && (m.canceled || m.quit):
+		b.WriteString(" (canceled)")
+	case m.state == Skipped && m.err != ErrSkip:
+		fmt.Fprintf(&b, " (%v)", m.err)
+	case m.state == Skipped:
+		b.WriteString(" (skipped)")
+	case m.state == Failed:
+		fmt.Fprintf(&b, ": %v", m.err)
+	}
+
+	if m.showElapsed && !m.started.IsZero() {
+		fmt.Fprintf(&b, " %s", m.styles.Elapsed.Render(formatElapsed(m.Elapsed())))
+	}
+	return b.String() + "\n"
+}
+
+// formatElapsed formats the elapsed time with a precision of a tenth of a second.
+func formatElapsed(d time.Duration) string {
+	return fmt.Sprintf("(%s)", d.Round(100*time.Millisecond))
+}
+
+// Run shows the spinner while fn runs and returns the error returned by fn, or nil if fn returned ErrSkip. The user
+// can cancel the task with esc or quit with ctrl+c, which cancels the context passed to fn and returns
+// ui.CanceledError or ui.QuitError once fn returned.
+func (m *Model) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.ctx, m.cancel = context.WithCancel(ctx)
+	defer m.cancel()
+
+	m.fn, m.state, m.err, m.elapsed = fn, Running, nil, 0
+	m.canceled, m.quit = false, false
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return err
+	}
+	if m.state == Skipped {
+		return nil
+	}
+	return m.err
+}
+
+// RunAccessible runs the task, announcing its start and result as plain lines. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	if m.fn == nil {
+		return nil
+	}
+	if m.ctx == nil {
+		m.ctx, m.cancel = context.WithCancel(context.Background())
+	}
+	fmt.Fprintf(out, "%s...\n", m.label)
+	m.started = time.Now()
+	m.Finish(m.fn(m.ctx))
+
+	switch m.state {
+	case Failed:
+		fmt.Fprintf(out, "%s: failed: %v\n", m.label, m.err)
+	case Skipped:
+		fmt.Fprintf(out, "%s: %v\n", m.label, m.err)
+	default:
+		fmt.Fprintf(out, "%s: done\n", m.label)
+	}
+	return nil
+}
+
+// Run shows a spinner with the given label while fn runs. See Model.Run for details.
+func Run(label string, fn func(ctx context.Context) error) error {
+	return RunContext(context.Background(), label, fn)
+}
+
+// RunContext is like Run, but fn is passed a context derived from ctx, the spinner is stopped when ctx is done, and
+// the options are passed to the program. See Model.Run for details.
+func RunContext(ctx context.Context, label string, fn func(ctx context.Context) error, opts ...tea.ProgramOption) error {
+	return New(label).WithProgramOptions(opts...).Run(ctx, fn)
+}
+
+// Showcase demonstrates the Model component by running a successful, a skipped and a failing task.
+func Showcase() {
+	fmt.Println("=== Spinner Showcase ===")
+
+	sleep := func(ctx context.Context, err error) error {
+		select {
+		case <-time.After(2 * time.Second):
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	_ = Run("Downloading packages", func(ctx context.Context) error {
+		return sleep(ctx, nil)
+	})
+	_ = Run("Migrating database", func(ctx context.Context) error {
+		return sleep(ctx, fmt.Errorf("%w: already up to date", ErrSkip))
+	})
+	_ = Run("Restarting service", func(ctx context.Context) error {
+		return sleep(ctx, errors.New("connection refused"))
+	})
+}
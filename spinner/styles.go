@@ -0,0 +1,40 @@
+package spinner
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Spinner lipgloss.Style // Spinner is the style of the spinner shown while running.
+	Label   lipgloss.Style // Label is the style of the label.
+	Success lipgloss.Style // Success is the style of the glyph of a succeeded task.
+	Failure lipgloss.Style // Failure is the style of the glyph of a failed task.
+	Skipped lipgloss.Style // Skipped is the style of the glyph of a skipped task.
+	Elapsed lipgloss.Style // Elapsed is the style of the elapsed time.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Spinner: lipgloss.NewStyle().Foreground(t.Accent),
+		Label:   lipgloss.NewStyle(),
+		Success: lipgloss.NewStyle().Foreground(t.Success),
+		Failure: lipgloss.NewStyle().Foreground(t.Failure),
+		Skipped: lipgloss.NewStyle().Foreground(t.Text),
+		Elapsed: lipgloss.NewStyle().Faint(true),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
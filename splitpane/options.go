@@ -0,0 +1,79 @@
+package splitpane
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/layout"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy. The copy hosts the same panes.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Direction sets whether the panes are side by side (layout.Horizontal) or one above the other (layout.Vertical).
+func Direction(dir layout.Direction) Option {
+	return func(m *Model) {
+		m.dir = dir
+	}
+}
+
+// Ratio sets the share of the first pane in the available space, between 0 and 1. The reset key restores it.
+func Ratio(ratio float64) Option {
+	return func(m *Model) {
+		m.ratio = max(0, min(1, ratio))
+		m.initialRatio = m.ratio
+	}
+}
+
+// MinSizes sets the minimum sizes of the panes along the direction: columns if side by side, lines otherwise.
+func MinSizes(first, second int) Option {
+	return func(m *Model) {
+		m.minSizes = [2]int{max(first, 0), max(second, 0)}
+	}
+}
+
+// Step sets the number of cells the divider moves per key press.
+func Step(step int) Option {
+	return func(m *Model) {
+		m.step = max(step, 1)
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map. Only the quit binding is used by the
+// container itself; the panes have key maps of their own.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
@@ -0,0 +1,371 @@
+package splitpane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/layout"
+	"github.com/nmeilick/go-ui/pick"
+)
+
+var (
+	focusKey  = key.NewBinding(key.WithKeys("tab", "shift+tab"), key.WithHelp("tab", "switch pane"))
+	shrinkKey = key.NewBinding(key.WithKeys("alt+left", "alt+up", "alt+h", "alt+k"), key.WithHelp("alt+←", "move divider"))
+	growKey   = key.NewBinding(key.WithKeys("alt+right", "alt+down", "alt+l", "alt+j"), key.WithHelp("alt+→", "move divider"))
+	resetKey  = key.NewBinding(key.WithKeys("alt+="), key.WithHelp("alt+=", "reset divider"))
+)
+
+// focuser is implemented by models that can be focused, which includes all interactive components.
+type focuser interface {
+	Focus() tea.Cmd
+	Blur()
+}
+
+// Model represents a container showing two models, the panes, side by side or one above the other, separated by a
+// divider that can be moved with the keyboard. It is the common browse-left, detail-right pattern: tab switches the
+// focus between the panes, alt+arrows move the divider and key messages only reach the focused pane. Window size
+// messages are sliced, so that each pane receives the size of its area; all other messages are passed to both panes.
+//
+// The panes should be embedded components, so that they do not quit the program when they are finished.
+type Model struct {
+	panes          [2]tea.Model        // panes holds the first (left or top) and the second (right or bottom) model.
+	dir            layout.Direction    // dir determines if the panes are side by side or one above the other.
+	ratio          float64             // ratio is the share of the first pane in the available space.
+	initialRatio   float64             // initialRatio is the ratio restored by the reset key.
+	minSizes       [2]int              // minSizes holds the minimum sizes of the panes along the direction.
+	step           int                 // step is the number of cells the divider moves per key press.
+	focus          int                 // focus is the index of the focused pane.
+	width          int                 // width is the width of the container, updated from window size messages.
+	height         int                 // height is the height of the container, updated from window size messages.
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+
+	canceled bool // canceled indicates whether the container was canceled
+	quit     bool // quit indicates whether the container was quit
+}
+
+// New creates and returns a new Model showing the given panes side by side, configured by the given options. By
+// default, the first pane takes a third of the width and each pane is at least 10 cells wide.
+func New(first, second tea.Model, opts ...Option) *Model {
+	m := &Model{
+		panes:        [2]tea.Model{first, second},
+		dir:          layout.Horizontal,
+		ratio:        1.0 / 3,
+		initialRatio: 1.0 / 3,
+		minSizes:     [2]int{10, 10},
+		step:         2,
+		quitable:     true,
+		keymap:       ui.DefaultKeyMap(),
+		styles:       DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithDirection sets whether the panes are side by side (layout.Horizontal) or one above the other
+// (layout.Vertical) and returns a new Model with the updated direction.
+func (m *Model) WithDirection(dir layout.Direction) *Model {
+	return m.With(Direction(dir))
+}
+
+// WithRatio sets the share of the first pane in the available space and returns a new Model with the updated ratio.
+func (m *Model) WithRatio(ratio float64) *Model {
+	return m.With(Ratio(ratio))
+}
+
+// WithMinSizes sets the minimum sizes of the panes along the direction and returns a new Model with the updated
+// sizes.
+func (m *Model) WithMinSizes(first, second int) *Model {
+	return m.With(MinSizes(first, second))
+}
+
+// WithStep sets the number of cells the divider moves per key press and returns a new Model with the updated step.
+func (m *Model) WithStep(step int) *Model {
+	return m.With(Step(step))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// First returns the first pane, left or top, in its current state.
+func (m *Model) First() tea.Model {
+	return m.panes[0]
+}
+
+// Second returns the second pane, right or bottom, in its current state.
+func (m *Model) Second() tea.Model {
+	return m.panes[1]
+}
+
+// Focused returns the index of the focused pane: 0 for the first, 1 for the second.
+func (m *Model) Focused() int {
+	return m.focus
+}
+
+// SetFocus moves the focus to the pane with the given index, 0 or 1, and returns the command returned by its Focus
+// method, e.g. to start the cursor blink.
+func (m *Model) SetFocus(i int) tea.Cmd {
+	if i != 0 && i != 1 {
+		return nil
+	}
+	if f, ok := m.panes[m.focus].(focuser); ok {
+		f.Blur()
+	}
+	m.focus = i
+	if f, ok := m.panes[i].(focuser); ok {
+		return f.Focus()
+	}
+	return nil
+}
+
+// Ratio returns the share of the first pane in the available space.
+func (m *Model) Ratio() float64 {
+	return m.ratio
+}
+
+// SetRatio sets the share of the first pane in the available space and returns the command resizing the panes.
+func (m *Model) SetRatio(ratio float64) tea.Cmd {
+	m.ratio = max(0, min(1, ratio))
+	return m.resize()
+}
+
+// available returns the number of cells along the direction shared by the panes, excluding the divider.
+func (m *Model) available() int {
+	if m.dir == layout.Vertical {
+		return max(0, m.height-1)
+	}
+	return max(0, m.width-1)
+}
+
+// Position returns the size of the first pane along the direction, which is the position of the divider. It
+// respects the minimum sizes of the panes; if the space does not suffice for both, it is shared in proportion to
+// the minimum sizes.
+func (m *Model) Position() int {
+	avail := m.available()
+	if avail < m.minSizes[0]+m.minSizes[1] {
+		if m.minSizes[0]+m.minSizes[1] == 0 {
+			return 0
+		}
+		return avail * m.minSizes[0] / (m.minSizes[0] + m.minSizes[1])
+	}
+	pos := int(math.Round(m.ratio * float64(avail)))
+	return max(m.minSizes[0], min(pos, avail-m.minSizes[1]))
+}
+
+// moveDivider moves the divider by the given number of cells and returns the command resizing the panes.
+func (m *Model) moveDivider(delta int) tea.Cmd {
+	avail := m.available()
+	if avail == 0 {
+		return nil
+	}
+	pos := max(m.minSizes[0], min(m.Position()+delta, avail-m.minSizes[1]))
+	return m.SetRatio(float64(pos) / float64(avail))
+}
+
+// areas returns the width and height of the area of each pane.
+func (m *Model) areas() [2][2]int {
+	pos := m.Position()
+	if m.dir == layout.Vertical {
+		return [2][2]int{{m.width, pos}, {m.width, m.available() - pos}}
+	}
+	return [2][2]int{{pos, m.height}, {m.available() - pos, m.height}}
+}
+
+// resize passes the size of its area to each pane.
+func (m *Model) resize() tea.Cmd {
+	if m.width == 0 && m.height == 0 {
+		return nil
+	}
+	areas := m.areas()
+	return tea.Batch(
+		m.updatePane(0, tea.WindowSizeMsg{Width: areas[0][0], Height: areas[0][1]}),
+		m.updatePane(1, tea.WindowSizeMsg{Width: areas[1][0], Height: areas[1][1]}),
+	)
+}
+
+// updatePane passes the given message to the pane with the given index.
+func (m *Model) updatePane(i int, msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	m.panes[i], cmd = m.panes[i].Update(msg)
+	return cmd
+}
+
+// Canceled returns the canceled flag, which is set instead of the quit flag by the quit key binding if the quit
+// policy is ui.CancelPrompt.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes both panes and focuses the first one.
+func (m *Model) Init() tea.Cmd {
+	cmds := []tea.Cmd{m.panes[0].Init(), m.panes[1].Init()}
+	// Components start focused, so the second pane is blurred.
+	if f, ok := m.panes[1].(focuser); ok {
+		f.Blur()
+	}
+	m.focus = 1
+	return tea.Batch(append(cmds, m.SetFocus(0))...)
+}
+
+// Update slices window size messages between the panes, switches the focus, moves the divider and passes key
+// messages to the focused pane and all other messages to both panes.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+	}
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, m.resize()
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, tea.Quit
+			}
+			return m, nil
+		case key.Matches(msg, focusKey):
+			return m, m.SetFocus(1 - m.focus)
+		case key.Matches(msg, shrinkKey):
+			return m, m.moveDivider(-m.step)
+		case key.Matches(msg, growKey):
+			return m, m.moveDivider(m.step)
+		case key.Matches(msg, resetKey):
+			return m, m.SetRatio(m.initialRatio)
+		}
+		return m, m.updatePane(m.focus, msg)
+	}
+	return m, tea.Batch(m.updatePane(0, msg), m.updatePane(1, msg))
+}
+
+// box renders the view of the pane with the given index, cut and padded to its area if the size is known.
+func (m *Model) box(i int, area [2]int) string {
+	view := m.panes[i].View()
+	if m.width == 0 && m.height == 0 {
+		return view
+	}
+	view = lipgloss.NewStyle().MaxWidth(area[0]).MaxHeight(area[1]).Render(view)
+	return lipgloss.NewStyle().Width(area[0]).Height(area[1]).Render(view)
+}
+
+// View renders the panes with the divider between them. The half of the divider next to the focused pane is
+// highlighted.
+func (m *Model) View() string {
+	areas := m.areas()
+	first, second := m.box(0, areas[0]), m.box(1, areas[1])
+	g := ui.Glyphs()
+	style := m.styles.Divider
+	if m.focus == 1 {
+		style = m.styles.FocusedDivider
+	}
+
+	if m.dir == layout.Vertical {
+		width := m.width
+		if width == 0 {
+			width = max(lipgloss.Width(first), lipgloss.Width(second))
+		}
+		divider := style.Render(strings.Repeat(g.Separator, width))
+		return lipgloss.JoinVertical(lipgloss.Left, first, divider, second)
+	}
+
+	height := m.height
+	if height == 0 {
+		height = max(lipgloss.Height(first), lipgloss.Height(second))
+	}
+	lines := make([]string, height)
+	for i := range lines {
+		lines[i] = style.Render(g.Border.Left)
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, first, strings.Join(lines, "\n"), second)
+}
+
+// Run runs the container until the user quits it or a pane quits the program. Quitting the container with the quit
+// key binding is reported as ui.QuitError, or as ui.CanceledError depending on the quit policy.
+func (m *Model) Run(ctx context.Context) error {
+	return ui.RunContext(ctx, m, m.programOptions...)
+}
+
+// Showcase demonstrates the Model component with a list of files on the left and the selected file on the right.
+func Showcase() {
+	fmt.Println("=== Split Pane Showcase ===")
+
+	files := map[string]string{
+		"README.md":  "# Demo\n\nA small project showing the split pane.\n",
+		"main.go":    "package main\n\nfunc main() {\n\tprintln(\"hello\")\n}\n",
+		"go.mod":     "module example.com/demo\n\ngo 1.21\n",
+		"LICENSE":    "MIT License\n\nPermission is hereby granted, free of charge, ...\n",
+		"Makefile":   "build:\n\tgo build ./...\n",
+		".gitignore": "/bin\n*.out\n",
+	}
+	names := []string{"README.md", "main.go", "go.mod", "LICENSE", "Makefile", ".gitignore"}
+	list := pick.New(names).WithLabel("Files").WithEmbedded(true)
+	m := New(list, &preview{list: list, files: files}).
+		WithRatio(0.25).WithProgramOptions(tea.WithAltScreen())
+
+	// The container has no answer of its own; it is left with ctrl+c.
+	fmt.Println("tab switches the pane, alt+arrows move the divider, ctrl+c finishes")
+	if err := m.Run(context.Background()); err != nil && !errors.Is(err, ui.QuitError) {
+		_ = ui.Handle(err, ui.HandleOptions{})
+		return
+	}
+	fmt.Printf("Last file: %s\n", list.SelectedItem())
+}
+
+// preview shows the content of the file selected in the list of the showcase.
+type preview struct {
+	list  *pick.Model
+	files map[string]string
+}
+
+func (p *preview) Init() tea.Cmd                       { return nil }
+func (p *preview) Update(tea.Msg) (tea.Model, tea.Cmd) { return p, nil }
+
+func (p *preview) View() string {
+	name := p.list.SelectedItem()
+	return lipgloss.NewStyle().Bold(true).Render(name) + "\n\n" + p.files[name]
+}
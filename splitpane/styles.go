@@ -0,0 +1,32 @@
+package splitpane
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Divider        lipgloss.Style // Divider is the style of the divider while the first pane has the focus.
+	FocusedDivider lipgloss.Style // FocusedDivider is the style of the divider while the second pane has the focus.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Divider:        lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+		FocusedDivider: lipgloss.NewStyle().Foreground(t.Accent),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
@@ -0,0 +1,80 @@
+package statusbar
+
+import (
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	newModel.segments = append([]Segment(nil), m.segments...)
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	newModel.help.Styles = newModel.styles.Help
+	return &newModel
+}
+
+// Segments adds the given segments. Segments in the same area are shown in the order they were added.
+func Segments(segments ...Segment) Option {
+	return func(m *Model) {
+		m.segments = append(m.segments, segments...)
+	}
+}
+
+// LeftSegment adds a segment aligned to the left edge.
+func LeftSegment(id, text string) Option {
+	return Segments(Segment{ID: id, Text: text, Position: Left})
+}
+
+// CenterSegment adds a centered segment. Centered segments are dropped if the bar is too narrow.
+func CenterSegment(id, text string) Option {
+	return Segments(Segment{ID: id, Text: text, Position: Center})
+}
+
+// RightSegment adds a segment aligned to the right edge.
+func RightSegment(id, text string) Option {
+	return Segments(Segment{ID: id, Text: text, Position: Right})
+}
+
+// Hints sets the key bindings shown in the line below the bar. Without bindings, the line is omitted.
+func Hints(hints ...key.Binding) Option {
+	return func(m *Model) {
+		m.hints = hints
+	}
+}
+
+// Width sets a fixed width of the bar. By default, the bar spans the window.
+func Width(width int) Option {
+	return func(m *Model) {
+		m.fixedWidth = width
+	}
+}
+
+// Fill sets whether the content is padded to the window height, so that the bar is shown at the bottom of the
+// window. It is meant for programs using the alternate screen.
+func Fill(fill bool) Option {
+	return func(m *Model) {
+		m.fill = fill
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
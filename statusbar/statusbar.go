@@ -0,0 +1,375 @@
+package statusbar
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"  // Provides help view for key bindings
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/pick"
+	"github.com/nmeilick/go-ui/styles"
+)
+
+// defaultWidth is the width of the bar until the window size is known.
+const defaultWidth = 80
+
+// Position is the area of the bar a segment is shown in.
+type Position int
+
+const (
+	Left   Position = iota // Left segments are aligned to the left edge.
+	Center                 // Center segments are centered.
+	Right                  // Right segments are aligned to the right edge.
+)
+
+// String returns the name of the position.
+func (p Position) String() string {
+	switch p {
+	case Left:
+		return "left"
+	case Center:
+		return "center"
+	case Right:
+		return "right"
+	default:
+		return fmt.Sprintf("Position(%d)", int(p))
+	}
+}
+
+// Segment is a piece of text shown in the bar. Segments with an ID can be updated using SetMsg.
+type Segment struct {
+	ID       string   // ID identifies the segment for updates.
+	Text     string   // Text is the text shown; empty segments are omitted.
+	Position Position // Position is the area of the bar the segment is shown in.
+}
+
+// SetMsg sets the text of the segment with the given ID. Messages for unknown IDs are ignored, so several bars can
+// share a program. Send it using tea.Program.Send, or return it from a command using Set.
+type SetMsg struct {
+	ID   string // ID identifies the segment.
+	Text string // Text is the new text of the segment.
+}
+
+// HintsMsg replaces the key bindings shown in the hint line.
+type HintsMsg struct {
+	Hints []key.Binding // Hints are the key bindings shown; disabled bindings are omitted.
+}
+
+// Set returns a command setting the text of the segment with the given ID.
+func Set(id, text string) tea.Cmd {
+	return func() tea.Msg { return SetMsg{ID: id, Text: text} }
+}
+
+// SetHints returns a command replacing the key bindings shown in the hint line.
+func SetHints(hints ...key.Binding) tea.Cmd {
+	return func() tea.Msg { return HintsMsg{Hints: hints} }
+}
+
+// Model represents a status bar shown below the view of another model, the content. It consists of a line of left,
+// centered and right-aligned segments, and an optional line of key hints below. All messages are passed on to the
+// content; window size messages are reduced by the height of the bar, so the content can lay itself out as usual.
+type Model struct {
+	content        tea.Model           // content is the model shown above the bar, nil for none.
+	segments       []Segment           // segments holds the segments in the order they were added.
+	hints          []key.Binding       // hints are the key bindings shown in the hint line.
+	help           help.Model          // help renders the hint line.
+	fixedWidth     int                 // fixedWidth is the width of the bar, 0 to use the window width.
+	fill           bool                // fill determines if the content is padded to push the bar to the bottom.
+	width          int                 // width is the window width, updated from window size messages.
+	height         int                 // height is the window height, updated from window size messages.
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+}
+
+// New creates and returns a new Model showing the given content above the bar, configured by the given options. The
+// content may be nil, e.g. if the bar is rendered by an embedding model using Under.
+func New(content tea.Model, opts ...Option) *Model {
+	m := &Model{
+		content: content,
+		help:    help.New(),
+		styles:  DefaultStyles(),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.help.Styles = m.styles.Help
+	return m
+}
+
+// WithSegments adds the given segments and returns a new Model with the updated segments.
+func (m *Model) WithSegments(segments ...Segment) *Model {
+	return m.With(Segments(segments...))
+}
+
+// WithLeft adds a left segment and returns a new Model with the updated segments.
+func (m *Model) WithLeft(id, text string) *Model {
+	return m.With(LeftSegment(id, text))
+}
+
+// WithCenter adds a centered segment and returns a new Model with the updated segments.
+func (m *Model) WithCenter(id, text string) *Model {
+	return m.With(CenterSegment(id, text))
+}
+
+// WithRight adds a right segment and returns a new Model with the updated segments.
+func (m *Model) WithRight(id, text string) *Model {
+	return m.With(RightSegment(id, text))
+}
+
+// WithHints sets the key bindings shown in the hint line and returns a new Model with the updated bindings.
+func (m *Model) WithHints(hints ...key.Binding) *Model {
+	return m.With(Hints(hints...))
+}
+
+// WithWidth sets a fixed width of the bar and returns a new Model with the updated width.
+func (m *Model) WithWidth(width int) *Model {
+	return m.With(Width(width))
+}
+
+// WithFill sets whether the content is padded to the window height and returns a new Model with the updated flag.
+func (m *Model) WithFill(fill bool) *Model {
+	return m.With(Fill(fill))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Content returns the model shown above the bar.
+func (m *Model) Content() tea.Model {
+	return m.content
+}
+
+// Segment returns the text of the segment with the given ID and whether it exists.
+func (m *Model) Segment(id string) (string, bool) {
+	for _, s := range m.segments {
+		if s.ID == id {
+			return s.Text, true
+		}
+	}
+	return "", false
+}
+
+// SetSegment sets the text of the segment with the given ID and reports whether it exists.
+func (m *Model) SetSegment(id, text string) bool {
+	found := false
+	for i := range m.segments {
+		if m.segments[i].ID == id {
+			m.segments[i].Text = text
+			found = true
+		}
+	}
+	return found
+}
+
+// SetHints replaces the key bindings shown in the hint line.
+func (m *Model) SetHints(hints ...key.Binding) {
+	m.hints = hints
+}
+
+// Canceled returns whether the content was canceled, if it implements ui.StandardModel.
+func (m *Model) Canceled() bool {
+	if sm, ok := m.content.(ui.StandardModel); ok {
+		return sm.Canceled()
+	}
+	return false
+}
+
+// Quit returns whether the content was quit, if it implements ui.StandardModel.
+func (m *Model) Quit() bool {
+	if sm, ok := m.content.(ui.StandardModel); ok {
+		return sm.Quit()
+	}
+	return false
+}
+
+// Init initializes the content.
+func (m *Model) Init() tea.Cmd {
+	if m.content == nil {
+		return nil
+	}
+	return m.content.Init()
+}
+
+// Update handles segment and hint updates and passes all other messages on to the content. Window size messages are
+// passed on with the height reduced by the height of the bar.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+	}
+	switch msg := msg.(type) {
+	case SetMsg:
+		m.SetSegment(msg.ID, msg.Text)
+		return m, nil
+	case HintsMsg:
+		m.SetHints(msg.Hints...)
+		return m, nil
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		msg.Height = max(0, msg.Height-m.Height())
+		return m.updateContent(msg)
+	}
+	return m.updateContent(msg)
+}
+
+// updateContent passes the given message on to the content.
+func (m *Model) updateContent(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.content == nil {
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.content, cmd = m.content.Update(msg)
+	return m, cmd
+}
+
+// Height returns the number of lines of the bar: one, or two if key hints are shown.
+func (m *Model) Height() int {
+	if m.hintLine() != "" {
+		return 2
+	}
+	return 1
+}
+
+// barWidth returns the width of the bar.
+func (m *Model) barWidth() int {
+	switch {
+	case m.fixedWidth > 0:
+		return m.fixedWidth
+	case m.width > 0:
+		return m.width
+	default:
+		return defaultWidth
+	}
+}
+
+// area joins the texts of the non-empty segments at the given position.
+func (m *Model) area(pos Position) string {
+	var texts []string
+	for _, s := range m.segments {
+		if s.Position == pos && s.Text != "" {
+			texts = append(texts, s.Text)
+		}
+	}
+	return strings.Join(texts, " "+ui.Glyphs().Border.Left+" ")
+}
+
+// hintLine renders the key hints, or returns an empty string if there are none.
+func (m *Model) hintLine() string {
+	if len(m.hints) == 0 {
+		return ""
+	}
+	h := m.help
+	h.Width = m.barWidth()
+	return h.ShortHelpView(m.hints)
+}
+
+// Bar renders the bar and the hint line without content. If the areas do not fit, the center is dropped first, then
+// the left and finally the right area are truncated.
+func (m *Model) Bar() string {
+	width := m.barWidth()
+	inner := max(0, width-2) // padding
+	left, center, right := m.area(Left), m.area(Center), m.area(Right)
+	lw, cw, rw := styles.Width(left), styles.Width(center), styles.Width(right)
+
+	// The center is placed in the middle of the bar, so it needs as much space on both sides as the wider of the
+	// other areas.
+	if cw > 0 && cw+2*max(lw, rw)+4 > inner {
+		center, cw = "", 0
+	}
+	gap := 0
+	if rw > 0 {
+		gap = 1
+	}
+	if lw+gap+rw > inner {
+		left = styles.Truncate(left, max(0, inner-rw-gap))
+		lw = styles.Width(left)
+	}
+	if lw+gap+rw > inner {
+		right = styles.Truncate(right, inner)
+		rw, gap = styles.Width(right), 0
+	}
+
+	var b strings.Builder
+	b.WriteString(m.styles.Left.Render(left))
+	if cw > 0 {
+		pos := (inner - cw) / 2
+		b.WriteString(strings.Repeat(" ", pos-lw))
+		b.WriteString(m.styles.Center.Render(center))
+		lw = pos + cw
+	}
+	b.WriteString(strings.Repeat(" ", max(0, inner-lw-rw)))
+	b.WriteString(m.styles.Right.Render(right))
+	bar := m.styles.Bar.Padding(0, 1).Render(b.String())
+
+	if hints := m.hintLine(); hints != "" {
+		return bar + "\n" + hints
+	}
+	return bar
+}
+
+// Under renders the bar below the given view. Use it to show the bar below the view of an embedding model.
+func (m *Model) Under(view string) string {
+	view = strings.TrimSuffix(view, "\n")
+	if m.fill && m.height > 0 {
+		if pad := m.height - m.Height() - lipgloss.Height(view); pad > 0 {
+			view += strings.Repeat("\n", pad)
+		}
+	}
+	if view == "" {
+		return m.Bar()
+	}
+	return view + "\n" + m.Bar()
+}
+
+// View renders the content with the bar below.
+func (m *Model) View() string {
+	if m.content == nil {
+		return m.Under("")
+	}
+	return m.Under(m.content.View())
+}
+
+// Run runs the model until the content quits the program. Cancellation of the content is reported as an error like
+// for the content itself.
+func (m *Model) Run(ctx context.Context) error {
+	return ui.RunContext(ctx, m, m.programOptions...)
+}
+
+// Showcase demonstrates the Model component below a pick list, with segments in all areas and key hints.
+func Showcase() {
+	fmt.Println("=== Status Bar Showcase ===")
+
+	items := []string{"production", "staging", "development"}
+	list := pick.New(items).WithLabel("Environment")
+	keymap := ui.DefaultKeyMap()
+	m := New(list).
+		WithLeft("mode", "SELECT").
+		WithLeft("file", "deploy.yaml").
+		WithCenter("title", "go-ui").
+		WithRight("count", fmt.Sprintf("%d environments", len(items))).
+		WithHints(keymap.Prev, keymap.Next, keymap.Confirm, keymap.Cancel)
+
+	// Segments are usually updated from commands or using tea.Program.Send.
+	m.Update(SetMsg{ID: "mode", Text: "CHOOSE"})
+
+	if err := ui.Handle(m.Run(context.Background()), ui.HandleOptions{}); err == nil {
+		fmt.Printf("Chosen: %s\n", list.SelectedItem())
+	}
+}
@@ -0,0 +1,40 @@
+package statusbar
+
+import (
+	"github.com/charmbracelet/bubbles/help" // Provides help view for key bindings
+	"github.com/charmbracelet/lipgloss"     // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Bar    lipgloss.Style // Bar is the style of the whole bar, e.g. its background.
+	Left   lipgloss.Style // Left is the style of the left segments.
+	Center lipgloss.Style // Center is the style of the centered segments.
+	Right  lipgloss.Style // Right is the style of the right segments.
+	Help   help.Styles    // Help holds the styles of the hint line.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Bar:    lipgloss.NewStyle().Reverse(true),
+		Left:   lipgloss.NewStyle().Reverse(true).Bold(true),
+		Center: lipgloss.NewStyle().Reverse(true).Foreground(t.Label),
+		Right:  lipgloss.NewStyle().Reverse(true),
+		Help:   help.New().Styles,
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+		m.help.Styles = m.styles.Help
+	}
+}
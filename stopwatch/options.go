@@ -0,0 +1,102 @@
+package stopwatch
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Label sets the label shown before the elapsed time.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Precision sets the smallest unit shown, e.g. time.Millisecond (the default), 10*time.Millisecond or time.Second.
+func Precision(precision time.Duration) Option {
+	return func(m *Model) {
+		m.precision = max(time.Nanosecond, precision)
+	}
+}
+
+// AutoStart sets whether the stopwatch starts when the model is initialized. It is enabled by default; otherwise, the
+// stopwatch is started by the user or with Start.
+func AutoStart(autoStart bool) Option {
+	return func(m *Model) {
+		m.autoStart = autoStart
+	}
+}
+
+// Controls sets whether the user can start and stop the stopwatch, record laps and reset it with keys, and whether
+// laps and hints are shown. It is enabled by default; disable it to show the elapsed time next to other components.
+func Controls(controls bool) Option {
+	return func(m *Model) {
+		m.controls = controls
+	}
+}
+
+// MaxLaps sets the number of laps shown, the latest first. The default is 5.
+func MaxLaps(n int) Option {
+	return func(m *Model) {
+		m.maxLaps = max(0, n)
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the stopwatch.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
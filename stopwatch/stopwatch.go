@@ -0,0 +1,410 @@
+package stopwatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/plain"
+)
+
+var _ ui.Prompt[time.Duration] = (*Model)(nil)
+
+var (
+	toggleKey = key.NewBinding(key.WithKeys(" ", "s"), key.WithHelp("space", "start/stop"))
+	lapKey    = key.NewBinding(key.WithKeys("l"), key.WithHelp("l", "lap"))
+	resetKey  = key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "reset"))
+)
+
+// tickInterval is the interval in which the elapsed time is redrawn.
+const tickInterval = 50 * time.Millisecond
+
+// Model represents a stopwatch showing the elapsed time with up to millisecond precision. It can be started,
+// stopped, reset and record laps, either by the user or by the embedding model. Without controls, it is a plain
+// display of the elapsed time, e.g. next to a spinner or a task list.
+type Model struct {
+	label          string              // label is shown before the elapsed time.
+	precision      time.Duration       // precision is the smallest unit shown.
+	autoStart      bool                // autoStart determines if the stopwatch starts when the model is initialized.
+	controls       bool                // controls determines if the user can control the stopwatch with keys.
+	maxLaps        int                 // maxLaps is the number of laps shown, the latest first.
+	started        time.Time           // started is the time the stopwatch was started last, zero while stopped.
+	accumulated    time.Duration       // accumulated is the time elapsed before the stopwatch was started last.
+	laps           []time.Duration     // laps holds the elapsed time at the end of each lap.
+	ticking        bool                // ticking indicates whether a tick is scheduled.
+	cancelable     bool                // cancelable determines if the stopwatch can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+
+	canceled bool // canceled indicates whether the stopwatch was canceled
+	quit     bool // quit indicates whether the stopwatch was quit
+}
+
+// New creates and returns a new Model with the given label, configured by the given options. By default, the
+// stopwatch starts when the model is initialized and can be controlled by the user.
+func New(label string, opts ...Option) *Model {
+	m := &Model{
+		label:      label,
+		precision:  time.Millisecond,
+		autoStart:  true,
+		controls:   true,
+		maxLaps:    5,
+		cancelable: true,
+		quitable:   true,
+		keymap:     ui.DefaultKeyMap(),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithPrecision sets the smallest unit shown and returns a new Model with the updated precision.
+func (m *Model) WithPrecision(precision time.Duration) *Model {
+	return m.With(Precision(precision))
+}
+
+// WithAutoStart sets whether the stopwatch starts when the model is initialized and returns a new Model with the
+// updated flag.
+func (m *Model) WithAutoStart(autoStart bool) *Model {
+	return m.With(AutoStart(autoStart))
+}
+
+// WithControls sets whether the user can control the stopwatch with keys and returns a new Model with the updated
+// flag.
+func (m *Model) WithControls(controls bool) *Model {
+	return m.With(Controls(controls))
+}
+
+// WithMaxLaps sets the number of laps shown and returns a new Model with the updated number.
+func (m *Model) WithMaxLaps(n int) *Model {
+	return m.With(MaxLaps(n))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the stopwatch.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Elapsed returns the elapsed time.
+func (m *Model) Elapsed() time.Duration {
+	if m.started.IsZero() {
+		return m.accumulated
+	}
+	return m.accumulated + time.Since(m.started)
+}
+
+// Running returns whether the stopwatch is running.
+func (m *Model) Running() bool {
+	return !m.started.IsZero()
+}
+
+// Start starts the stopwatch and returns the command scheduling the redraws. Starting a running stopwatch has no
+// effect.
+func (m *Model) Start() tea.Cmd {
+	if m.Running() {
+		return nil
+	}
+	m.started = time.Now()
+	if m.ticking {
+		return nil
+	}
+	m.ticking = true
+	return m.tick()
+}
+
+// Stop stops the stopwatch, keeping the elapsed time.
+func (m *Model) Stop() {
+	if !m.Running() {
+		return
+	}
+	m.accumulated += time.Since(m.started)
+	m.started = time.Time{}
+}
+
+// Toggle starts the stopwatch if it is stopped and stops it otherwise.
+func (m *Model) Toggle() tea.Cmd {
+	if m.Running() {
+		m.Stop()
+		return nil
+	}
+	return m.Start()
+}
+
+// Reset stops the stopwatch and clears the elapsed time and the laps.
+func (m *Model) Reset() {
+	m.started, m.accumulated, m.laps = time.Time{}, 0, nil
+}
+
+// Lap ends the current lap and returns its duration.
+func (m *Model) Lap() time.Duration {
+	elapsed := m.Elapsed()
+	lap := elapsed
+	if n := len(m.laps); n > 0 {
+		lap -= m.laps[n-1]
+	}
+	m.laps = append(m.laps, elapsed)
+	return lap
+}
+
+// Laps returns the durations of the recorded laps.
+func (m *Model) Laps() []time.Duration {
+	laps := make([]time.Duration, len(m.laps))
+	for i, split := range m.laps {
+		laps[i] = split
+		if i > 0 {
+			laps[i] -= m.laps[i-1]
+		}
+	}
+	return laps
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the stopwatch. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// tickMsg triggers redrawing a Model.
+type tickMsg struct {
+	model *Model
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// tick returns the command scheduling the next redraw. The interval is coarser than the precision if that is
+// coarse, e.g. seconds.
+func (m *Model) tick() tea.Cmd {
+	return tea.Tick(max(tickInterval, m.precision/2), func(time.Time) tea.Msg {
+		return tickMsg{model: m}
+	})
+}
+
+// Init starts the stopwatch unless it is started manually.
+func (m *Model) Init() tea.Cmd {
+	if m.autoStart {
+		return m.Start()
+	}
+	return nil
+}
+
+// Update handles the ticks and, if the stopwatch has controls, the keys starting, stopping and resetting it and
+// recording laps. The confirm key stops the stopwatch and finishes the model.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if !m.controls {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, toggleKey):
+			return m, m.Toggle()
+		case key.Matches(msg, lapKey):
+			if m.Running() {
+				m.Lap()
+			}
+		case key.Matches(msg, resetKey):
+			m.Reset()
+		case key.Matches(msg, m.keymap.Confirm):
+			m.Stop()
+			m.canceled, m.quit = false, false
+			return m, m.done()
+		case key.Matches(msg, m.keymap.Cancel):
+			if m.cancelable {
+				m.Stop()
+				m.canceled, m.quit = true, false
+				return m, m.done()
+			}
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.Stop()
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, m.done()
+			}
+		}
+	case tickMsg:
+		if msg.model != m {
+			return m, nil
+		}
+		if !m.Running() {
+			m.ticking = false
+			return m, nil
+		}
+		return m, m.tick()
+	}
+	return m, nil
+}
+
+// Format formats the duration as minutes, seconds and fractions of seconds down to the given precision, e.g.
+// "01:02.345", with hours prepended if needed.
+func Format(d time.Duration, precision time.Duration) string {
+	d = max(0, d)
+	digits := 0
+	for p := precision; p < time.Second && digits < 9; p *= 10 {
+		digits++
+	}
+	unit := time.Second
+	for i := 0; i < digits; i++ {
+		unit /= 10
+	}
+	d = d.Truncate(unit)
+
+	h, mins, s := int(d/time.Hour), int(d/time.Minute%60), int(d/time.Second%60)
+	var out string
+	if h > 0 {
+		out = fmt.Sprintf("%d:%02d:%02d", h, mins, s)
+	} else {
+		out = fmt.Sprintf("%02d:%02d", mins, s)
+	}
+	if digits > 0 {
+		out += fmt.Sprintf(".%0*d", digits, int64(d%time.Second/unit))
+	}
+	return out
+}
+
+// View renders the label and the elapsed time and, if the stopwatch has controls, the latest laps and the available
+// keys. Without controls, the view is a single line without line break, so it can be placed next to other views.
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.label != "" {
+		fmt.Fprintf(&b, "%s ", m.styles.Label.Render(m.label))
+	}
+	b.WriteString(m.styles.Time.Render(Format(m.Elapsed(), m.precision)))
+	if !m.Running() && m.Elapsed() > 0 {
+		b.WriteString(" " + m.styles.Stopped.Render("(stopped)"))
+	}
+	if !m.controls {
+		return b.String()
+	}
+
+	laps := m.Laps()
+	for i := len(laps) - 1; i >= 0 && i >= len(laps)-m.maxLaps; i-- {
+		fmt.Fprintf(&b, "\n  %s %s %s", m.styles.Lap.Render(fmt.Sprintf("Lap %d", i+1)),
+			m.styles.Time.Render(Format(laps[i], m.precision)),
+			m.styles.Lap.Render("("+Format(m.laps[i], m.precision)+")"))
+	}
+
+	hints := []string{"space start", "l lap", "r reset", "enter done"}
+	if m.Running() {
+		hints[0] = "space stop"
+	}
+	b.WriteString("\n" + m.styles.Hint.Render(strings.Join(hints, " · ")))
+	return b.String() + "\n"
+}
+
+// Run runs the stopwatch until the user finishes it and returns the elapsed time. It implements
+// ui.Prompt[time.Duration].
+func (m *Model) Run(ctx context.Context) (time.Duration, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return m.Elapsed(), err
+	}
+	return m.Elapsed(), nil
+}
+
+// RunAccessible starts the stopwatch and stops it when a line is entered instead of using the terminal UI. It
+// implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	m.Start()
+	_, err := p.Line(fmt.Sprintf("%s started, press enter to stop ", m.label), "")
+	m.Stop()
+	switch {
+	case errors.Is(err, io.EOF):
+		m.canceled, m.quit = true, false
+		return nil
+	case err != nil:
+		return err
+	}
+	p.Println(fmt.Sprintf("%s %s", m.label, Format(m.Elapsed(), m.precision)))
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Showcase demonstrates the Model component with laps and as a display with a coarser precision.
+func Showcase() {
+	fmt.Println("=== Stopwatch Showcase ===")
+
+	m := New("Elapsed:")
+	if elapsed, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Stopped after %s with %d laps\n", Format(elapsed, time.Millisecond), len(m.Laps()))
+	}
+
+	coarse := New("Build time:").WithPrecision(100 * time.Millisecond)
+	if elapsed, err := coarse.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Build time: %s\n", Format(elapsed, time.Second))
+	}
+}
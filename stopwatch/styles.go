@@ -0,0 +1,38 @@
+package stopwatch
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Label   lipgloss.Style // Label is the style of the label.
+	Time    lipgloss.Style // Time is the style of the elapsed time and the lap durations.
+	Stopped lipgloss.Style // Stopped is the style of the note shown while the stopwatch is stopped.
+	Lap     lipgloss.Style // Lap is the style of the lap numbers and split times.
+	Hint    lipgloss.Style // Hint is the style of the hint on the available keys.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:   lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Time:    lipgloss.NewStyle().Foreground(t.Text).Bold(true),
+		Stopped: lipgloss.NewStyle().Foreground(t.Label),
+		Lap:     lipgloss.NewStyle().Faint(true),
+		Hint:    lipgloss.NewStyle().Faint(true),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
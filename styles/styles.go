@@ -0,0 +1,151 @@
+// Package styles provides the styling helpers shared by the components, e.g. for custom delegates and views that
+// should look like the built-in ones.
+package styles
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/charmbracelet/x/ansi"   // Measures and truncates text with escape sequences
+	"github.com/nmeilick/go-ui"
+	"github.com/rivo/uniseg" // Splits text into grapheme clusters
+)
+
+// Adaptive returns a color using light on light backgrounds and dark on dark backgrounds.
+func Adaptive(light, dark string) lipgloss.AdaptiveColor {
+	return lipgloss.AdaptiveColor{Light: light, Dark: dark}
+}
+
+// Fixed returns a color that is the same on all backgrounds, for use where an adaptive color is expected.
+func Fixed(color string) lipgloss.AdaptiveColor {
+	return lipgloss.AdaptiveColor{Light: color, Dark: color}
+}
+
+// Box returns a style drawing a border of the current glyph set in the given color around its content, with a
+// horizontal padding of one cell.
+func Box(color lipgloss.TerminalColor) lipgloss.Style {
+	return lipgloss.NewStyle().Border(ui.Glyphs().Border).BorderForeground(color).Padding(0, 1)
+}
+
+// Section renders content in a box of the given outer width, with the title on top in the label color. A width of
+// zero or less fits the box to its content.
+func Section(title, content string, width int) string {
+	t := ui.CurrentTheme()
+	box := Box(t.Accent)
+	if title != "" {
+		content = lipgloss.NewStyle().Foreground(t.Label).Bold(true).Render(title) + "\n" + content
+	}
+	if width > 0 {
+		box = box.Width(width - box.GetHorizontalBorderSize())
+	}
+	return box.Render(content)
+}
+
+// Badge renders text as a badge, i.e. padded by a space on each side and shown in the given foreground color on the
+// given background color. Without colors, the text is shown reversed, so that it still stands out.
+func Badge(text string, fg, bg lipgloss.TerminalColor) string {
+	style := lipgloss.NewStyle().Padding(0, 1).Bold(true)
+	if fg == nil && bg == nil {
+		return style.Reverse(true).Render(text)
+	}
+	if fg != nil {
+		style = style.Foreground(fg)
+	}
+	if bg != nil {
+		style = style.Background(bg)
+	}
+	return style.Render(text)
+}
+
+// Width returns the display width of s in terminal cells, like lipgloss measures it. Escape sequences are ignored,
+// and grapheme clusters like emoji sequences, flags and characters with combining marks are measured as a whole, with
+// East Asian wide characters taking two cells.
+func Width(s string) int {
+	return ansi.StringWidth(s)
+}
+
+// Truncate shortens the first line of s to the given display width, marking the truncation with the ellipsis of the
+// current glyph set. If the ellipsis does not fit, the text is cut without it. Grapheme clusters are never split, and
+// escape sequences are kept.
+func Truncate(s string, width int) string {
+	ellipsis := ui.Glyphs().Ellipsis
+	if i := strings.IndexAny(s, "\r\n"); i >= 0 {
+		s = s[:i] + ellipsis
+	}
+	if Width(s) <= width {
+		return s
+	}
+	if Width(ellipsis) >= width {
+		return Cut(s, width)
+	}
+	return ansi.Truncate(s, width, ellipsis)
+}
+
+// Cut shortens s to the given display width without marking the truncation. Grapheme clusters are never split, so the
+// result may be narrower than width, e.g. if a wide character does not fit. Escape sequences are kept.
+func Cut(s string, width int) string {
+	return ansi.Truncate(s, max(0, width), "")
+}
+
+// Wrap wraps s at the given display width, breaking lines between words where possible and within words that do not
+// fit on a line of their own. Grapheme clusters are never split, and escape sequences are kept. A width of zero or less
+// returns s unchanged.
+func Wrap(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+	return ansi.Wrap(s, width, "")
+}
+
+// Graphemes calls fn for each grapheme cluster of s, the user-perceived characters, with its byte offset in s and its
+// display width. s must not contain escape sequences. Iteration stops if fn returns false.
+func Graphemes(s string, fn func(offset int, cluster string, width int) bool) {
+	state := -1
+	for offset := 0; offset < len(s); {
+		cluster, rest, width, newState := uniseg.FirstGraphemeClusterInString(s[offset:], state)
+		if !fn(offset, cluster, width) {
+			return
+		}
+		offset, state = len(s)-len(rest), newState
+	}
+}
+
+// Pad pads s with spaces to the given display width according to the alignment. Text that is already wider is
+// returned unchanged. s may contain escape sequences.
+func Pad(s string, width int, align lipgloss.Position) string {
+	gap := width - lipgloss.Width(s)
+	if gap <= 0 {
+		return s
+	}
+	switch align {
+	case lipgloss.Right:
+		return strings.Repeat(" ", gap) + s
+	case lipgloss.Center:
+		return strings.Repeat(" ", gap/2) + s + strings.Repeat(" ", gap-gap/2)
+	}
+	return s + strings.Repeat(" ", gap)
+}
+
+// InnerWidth returns the width available for content when rendering with the style in the given outer width, i.e.
+// without its margins, borders and padding. The result is never negative.
+func InnerWidth(style lipgloss.Style, width int) int {
+	return max(0, width-style.GetHorizontalFrameSize())
+}
+
+// InnerHeight returns the height available for content when rendering with the style in the given outer height, i.e.
+// without its margins, borders and padding. The result is never negative.
+func InnerHeight(style lipgloss.Style, height int) int {
+	return max(0, height-style.GetVerticalFrameSize())
+}
+
+// OuterWidth returns the width of content of the given width when rendered with the style, i.e. including its
+// margins, borders and padding.
+func OuterWidth(style lipgloss.Style, width int) int {
+	return width + style.GetHorizontalFrameSize()
+}
+
+// OuterHeight returns the height of content of the given height when rendered with the style, i.e. including its
+// margins, borders and padding.
+func OuterHeight(style lipgloss.Style, height int) int {
+	return height + style.GetVerticalFrameSize()
+}
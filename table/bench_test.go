@@ -0,0 +1,22 @@
+package table
+
+import (
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui/internal/benchview"
+)
+
+func BenchmarkView1000x5(b *testing.B) {
+	benchview.Run(b, func() tea.Model {
+		columns := []Column{{Title: "ID"}, {Title: "Name"}, {Title: "Owner"}, {Title: "Size", Align: lipgloss.Right},
+			{Title: "State"}}
+		rows := make([]Row, 1000)
+		for i := range rows {
+			rows[i] = Row{i, fmt.Sprintf("name-%d", i), "owner", i * 1024, "running"}
+		}
+		return New("Table", columns, rows, Height(30))
+	})
+}
@@ -0,0 +1,118 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	newModel.layout()
+	return &newModel
+}
+
+// Label sets the label of the Model.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Height sets the number of visible rows.
+func Height(height int) Option {
+	return func(m *Model) {
+		m.height = max(1, height)
+	}
+}
+
+// MaxColumnWidth sets the maximum width of columns without a fixed width. Longer cells are truncated.
+func MaxColumnWidth(width int) Option {
+	return func(m *Model) {
+		m.maxColWidth = width
+	}
+}
+
+// SortBy sorts the rows by the column with the given key, or by the column with the given title if no column has the
+// key. Unknown keys leave the rows unsorted.
+func SortBy(key string, descending bool) Option {
+	return func(m *Model) {
+		m.sortCol, m.sortDesc = -1, descending
+		for i, c := range m.columns {
+			if c.Key == key || (c.Key == "" && c.Title == key) {
+				m.sortCol = i
+				break
+			}
+		}
+	}
+}
+
+// SelectedIndex sets the index of the initially selected row in the rows passed to New.
+func SelectedIndex(i int) Option {
+	return func(m *Model) {
+		m.layout()
+		for pos, idx := range m.order {
+			if idx == i {
+				m.cursor = pos
+			}
+		}
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
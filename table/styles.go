@@ -0,0 +1,42 @@
+package table
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Label       lipgloss.Style // Label is the style of the label.
+	Header      lipgloss.Style // Header is the style of the header row.
+	Row         lipgloss.Style // Row is the style of the unselected rows.
+	SelectedRow lipgloss.Style // SelectedRow is the style of the selected row.
+	Cursor      lipgloss.Style // Cursor is the style of the glyph marking the selected row.
+	Footer      lipgloss.Style // Footer is the style of the line indicating hidden rows and columns.
+	Error       lipgloss.Style // Error is the style of the error shown below the table.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:       lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Header:      lipgloss.NewStyle().Bold(true).Underline(true),
+		Row:         lipgloss.NewStyle().Foreground(t.Text),
+		SelectedRow: lipgloss.NewStyle().Foreground(t.Success),
+		Cursor:      lipgloss.NewStyle().Foreground(t.Accent),
+		Footer:      lipgloss.NewStyle().Faint(true),
+		Error:       t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
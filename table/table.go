@@ -0,0 +1,695 @@
+package table
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/answer"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/internal/render"
+	"github.com/nmeilick/go-ui/styles"
+)
+
+var _ ui.Prompt[Row] = (*Model)(nil)
+
+var (
+	scrollLeftKey  = key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "scroll left"))
+	scrollRightKey = key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "scroll right"))
+	pageUpKey      = key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "page up"))
+	pageDownKey    = key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdown", "page down"))
+	homeKey        = key.NewBinding(key.WithKeys("home", "g"), key.WithHelp("home/g", "first row"))
+	endKey         = key.NewBinding(key.WithKeys("end", "G"), key.WithHelp("end/G", "last row"))
+	sortKey        = key.NewBinding(key.WithKeys("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		key.WithHelp("1-9", "sort by column"))
+)
+
+// columnGap is the space between two columns.
+const columnGap = "  "
+
+// Row is a row of the table, holding a value per column. Values are formatted using the Format function of their
+// column, or a default format depending on their type.
+type Row []any
+
+// Column describes a column of the table.
+type Column struct {
+	Title   string             // Title is shown in the header.
+	Key     string             // Key identifies the column for SortBy; the title is used if empty.
+	Width   int                // Width is the fixed width of the column; 0 fits the content up to the maximum width.
+	Align   lipgloss.Position  // Align aligns the cells, e.g. lipgloss.Right for numbers.
+	Format  func(v any) string // Format formats the values of the column; nil for the default format.
+	Compare func(a, b any) int // Compare orders the values when sorting; nil to compare by type.
+}
+
+// Model represents a table of rows from which one row is selected.
+type Model struct {
+	label          string                // label is the label for the table.
+	columns        []Column              // columns describes the columns.
+	widths         []int                 // widths holds the resolved widths of the columns.
+	rows           []Row                 // rows holds the rows in their original order.
+	order          []int                 // order holds the indexes of the rows in display order.
+	cursor         int                   // cursor is the position of the selected row in display order.
+	offset         int                   // offset is the position of the first visible row in display order.
+	colOffset      int                   // colOffset is the index of the first visible column.
+	height         int                   // height is the number of visible rows.
+	width          int                   // width is the available width, updated from window size messages.
+	maxColWidth    int                   // maxColWidth is the maximum width of columns without a fixed width.
+	sortCol        int                   // sortCol is the index of the sort column, or -1 if unsorted.
+	sortDesc       bool                  // sortDesc indicates whether the rows are sorted in descending order.
+	cancelable     bool                  // cancelable determines if selection can be canceled with escape key
+	quitable       bool                  // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption   // programOptions are passed to the program running the model
+	id             string                // id identifies the prompt, e.g. for preset answers
+	embedded       bool                  // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                  // focused determines if the model handles key messages
+	keymap         ui.KeyMap             // keymap holds the key bindings of the model.
+	layoutID       int64                 // layoutID identifies the last layout, so rows cached for others are not used.
+	cache          *render.Cache[rowKey] // cache holds the rendered rows.
+	styles         Styles                // styles holds the styles of the model.
+	customStyles   bool                  // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error                 // err is shown below the model, e.g. why the previous answer was rejected
+
+	canceled bool // canceled indicates whether the selection was canceled
+	quit     bool // quit indicates whether the selection was quit
+}
+
+// New creates and returns a new Model showing the given rows in the given columns, configured by the given options.
+func New(label string, columns []Column, rows []Row, opts ...Option) *Model {
+	m := &Model{
+		label:       label,
+		columns:     append([]Column(nil), columns...),
+		rows:        rows,
+		height:      10,
+		width:       80,
+		maxColWidth: 30,
+		sortCol:     -1,
+		cancelable:  true,
+		quitable:    true,
+		focused:     true,
+		keymap:      ui.DefaultKeyMap(),
+		cache:       render.New[rowKey](),
+		styles:      DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.layout()
+	return m
+}
+
+// layouts counts the layouts of all tables, identifying the rows and widths the rows are cached for.
+var layouts atomic.Int64
+
+// layout resolves the column widths and the display order after the columns, rows or sorting changed. The selected row
+// stays selected.
+func (m *Model) layout() {
+	selected := m.SelectedIndex()
+	m.layoutID = layouts.Add(1)
+
+	m.widths = make([]int, len(m.columns))
+	for i, c := range m.columns {
+		if c.Width > 0 {
+			m.widths[i] = c.Width
+			continue
+		}
+		w := styles.Width(c.Title) + 2 // room for the sort indicator
+		for _, row := range m.rows {
+			w = max(w, styles.Width(m.cell(row, i)))
+		}
+		m.widths[i] = min(w, max(m.maxColWidth, 1))
+	}
+
+	m.order = make([]int, len(m.rows))
+	for i := range m.order {
+		m.order[i] = i
+	}
+	if m.sortCol >= 0 && m.sortCol < len(m.columns) {
+		c := m.columns[m.sortCol]
+		cmp := c.Compare
+		if cmp == nil {
+			cmp = compare
+		}
+		sort.SliceStable(m.order, func(i, j int) bool {
+			a, b := value(m.rows[m.order[i]], m.sortCol), value(m.rows[m.order[j]], m.sortCol)
+			if m.sortDesc {
+				return cmp(b, a) < 0
+			}
+			return cmp(a, b) < 0
+		})
+	}
+	m.cursor = 0
+	for pos, i := range m.order {
+		if i == selected {
+			m.cursor = pos
+		}
+	}
+	m.scroll()
+}
+
+// scroll adjusts the offset so that the cursor is visible.
+func (m *Model) scroll() {
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	} else if m.cursor >= m.offset+m.height {
+		m.offset = m.cursor - m.height + 1
+	}
+	m.offset = max(0, min(m.offset, len(m.order)-m.height))
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithHeight sets the number of visible rows and returns a new Model with the updated height.
+func (m *Model) WithHeight(height int) *Model {
+	return m.With(Height(height))
+}
+
+// WithMaxColumnWidth sets the maximum width of columns without a fixed width and returns a new Model with the updated
+// width. Longer cells are truncated.
+func (m *Model) WithMaxColumnWidth(width int) *Model {
+	return m.With(MaxColumnWidth(width))
+}
+
+// WithSortBy sorts the rows by the column with the given key and returns a new Model with the updated sorting.
+func (m *Model) WithSortBy(key string, descending bool) *Model {
+	return m.With(SortBy(key, descending))
+}
+
+// WithSelectedIndex sets the index of the initially selected row and returns a new Model with the updated selection.
+func (m *Model) WithSelectedIndex(i int) *Model {
+	return m.With(SelectedIndex(i))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// SelectedIndex returns the index of the selected row in the rows passed to New, or -1 if the table is empty.
+func (m *Model) SelectedIndex() int {
+	if m.cursor < 0 || m.cursor >= len(m.order) {
+		return -1
+	}
+	return m.order[m.cursor]
+}
+
+// SelectedRow returns the selected row, or nil if the table is empty.
+func (m *Model) SelectedRow() Row {
+	if i := m.SelectedIndex(); i >= 0 {
+		return m.rows[i]
+	}
+	return nil
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is the index of the row or the value of its first column. It implements
+// ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	idx, err := answer.Index(v, m.Choices())
+	if err != nil {
+		return err
+	}
+	for pos, i := range m.order {
+		if i == idx {
+			m.cursor = pos
+		}
+	}
+	m.scroll()
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the value of the first column of the selected row. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	if row := m.SelectedRow(); row != nil {
+		return m.cell(row, 0)
+	}
+	return nil
+}
+
+// Choices returns the values of the first column of all rows. It implements ui.ChoiceModel.
+func (m *Model) Choices() []string {
+	choices := make([]string, len(m.rows))
+	for i, row := range m.rows {
+		choices[i] = m.cell(row, 0)
+	}
+	return choices
+}
+
+// Focus focuses the model, so that it handles key messages. It returns no command and exists for compatibility with
+// other focusable models.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns a nil command.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// Update handles window size and key messages, moving the selection, scrolling and sorting the table.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		return m, nil
+	case tea.KeyMsg:
+		if !m.focused {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, scrollLeftKey):
+			m.colOffset = max(0, m.colOffset-1)
+		case key.Matches(msg, scrollRightKey):
+			if m.hiddenRight() {
+				m.colOffset++
+			}
+		case key.Matches(msg, m.keymap.Prev):
+			m.cursor = max(0, m.cursor-1)
+		case key.Matches(msg, m.keymap.Next):
+			m.cursor = min(len(m.order)-1, m.cursor+1)
+		case key.Matches(msg, pageUpKey):
+			m.cursor = max(0, m.cursor-m.height)
+		case key.Matches(msg, pageDownKey):
+			m.cursor = max(0, min(len(m.order)-1, m.cursor+m.height))
+		case key.Matches(msg, homeKey):
+			m.cursor = 0
+		case key.Matches(msg, endKey):
+			m.cursor = max(0, len(m.order)-1)
+		case key.Matches(msg, sortKey):
+			m.toggleSort(int(msg.Runes[0] - '1'))
+		case key.Matches(msg, m.keymap.Confirm):
+			if len(m.order) == 0 {
+				return m, nil
+			}
+			m.canceled, m.quit = false, false
+			return m, m.done()
+		case key.Matches(msg, m.keymap.Cancel):
+			if m.cancelable {
+				m.canceled, m.quit = true, false
+				return m, m.done()
+			}
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, m.done()
+			}
+		}
+		m.scroll()
+	}
+	return m, nil
+}
+
+// toggleSort sorts by the column with the given index, reversing the order if the table is already sorted by it. The
+// selected row stays selected.
+func (m *Model) toggleSort(col int) {
+	if col < 0 || col >= len(m.columns) {
+		return
+	}
+	if m.sortCol == col {
+		m.sortDesc = !m.sortDesc
+	} else {
+		m.sortCol, m.sortDesc = col, false
+	}
+	m.layout()
+}
+
+// visibleColumns returns the indexes of the columns fitting into the available width, starting at colOffset.
+func (m *Model) visibleColumns() []int {
+	avail := m.width - 2 // room for the cursor
+	var cols []int
+	for i := m.colOffset; i < len(m.columns); i++ {
+		w := m.widths[i]
+		if len(cols) > 0 {
+			w += len(columnGap)
+		}
+		if len(cols) > 0 && w > avail {
+			break
+		}
+		cols = append(cols, i)
+		avail -= w
+	}
+	return cols
+}
+
+// hiddenRight returns whether columns right of the visible columns are hidden.
+func (m *Model) hiddenRight() bool {
+	cols := m.visibleColumns()
+	return len(cols) > 0 && cols[len(cols)-1] < len(m.columns)-1
+}
+
+// View renders the label, the header, the visible rows and a footer indicating hidden columns.
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.label != "" {
+		fmt.Fprintf(&b, "%s\n", m.styles.Label.Render(m.label))
+	}
+
+	g := ui.Glyphs()
+	cols := m.visibleColumns()
+	header := make([]string, len(cols))
+	for i, col := range cols {
+		c := m.columns[col]
+		title := c.Title
+		if col == m.sortCol {
+			if m.sortDesc {
+				title += " " + g.Descending
+			} else {
+				title += " " + g.Ascending
+			}
+		}
+		header[i] = styles.Pad(styles.Truncate(title, m.widths[col]), m.widths[col], c.Align)
+	}
+	fmt.Fprintf(&b, "  %s\n", m.styles.Header.Render(strings.Join(header, columnGap)))
+
+	version := render.Fingerprint(m.styles.Cursor, m.styles.SelectedRow, m.styles.Row) + g.SelectedLeft + "\x00" +
+		g.Ellipsis + "\x00" + strconv.FormatInt(m.layoutID, 10) + "\x00" + fmt.Sprint(cols)
+	end := min(len(m.order), m.offset+m.height)
+	for pos := m.offset; pos < end; pos++ {
+		key := rowKey{row: m.order[pos], cursor: pos == m.cursor}
+		b.WriteString(m.cache.Get(version, key, func() string {
+			return m.renderRow(key, cols, g)
+		}))
+		b.WriteByte('\n')
+	}
+	if len(m.order) == 0 {
+		fmt.Fprintf(&b, "  %s\n", m.styles.Footer.Render("(no rows)"))
+	}
+
+	var footer []string
+	if len(m.order) > m.height {
+		footer = append(footer, fmt.Sprintf("rows %d-%d of %d", m.offset+1, end, len(m.order)))
+	}
+	if len(cols) > 0 && len(cols) < len(m.columns) {
+		footer = append(footer, fmt.Sprintf("columns %d-%d of %d (←/→ to scroll)", cols[0]+1, cols[len(cols)-1]+1,
+			len(m.columns)))
+	}
+	if len(footer) > 0 {
+		fmt.Fprintf(&b, "  %s\n", m.styles.Footer.Render(strings.Join(footer, " · ")))
+	}
+	if m.err != nil {
+		fmt.Fprintf(&b, "%s\n", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// rowKey identifies a rendered row in the cache.
+type rowKey struct {
+	row    int  // row is the index of the row.
+	cursor bool // cursor determines if the cursor is on the row.
+}
+
+// renderRow renders the visible columns of a row.
+func (m *Model) renderRow(key rowKey, cols []int, g ui.GlyphSet) string {
+	row := m.rows[key.row]
+	cells := make([]string, len(cols))
+	for i, col := range cols {
+		cells[i] = styles.Pad(styles.Truncate(m.cell(row, col), m.widths[col]), m.widths[col], m.columns[col].Align)
+	}
+	line := strings.Join(cells, columnGap)
+	if key.cursor {
+		return m.styles.Cursor.Render(g.SelectedLeft) + " " + m.styles.SelectedRow.Render(line)
+	}
+	return "  " + m.styles.Row.Render(line)
+}
+
+// cell returns the formatted value of the given column of the row.
+func (m *Model) cell(row Row, col int) string {
+	v := value(row, col)
+	if col < len(m.columns) && m.columns[col].Format != nil {
+		return m.columns[col].Format(v)
+	}
+	return format(v)
+}
+
+// value returns the value of the given column of the row, or nil if the row is too short.
+func value(row Row, col int) any {
+	if col < len(row) {
+		return row[col]
+	}
+	return nil
+}
+
+// format formats a value depending on its type.
+func format(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		return v.Format("2006-01-02 15:04")
+	}
+	return fmt.Sprint(v)
+}
+
+// compare orders two values by their type: numbers numerically, times chronologically, and all other values by their
+// formatted text, ignoring case. Nil sorts first.
+func compare(a, b any) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	if x, ok := number(a); ok {
+		if y, ok := number(b); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	if x, ok := a.(time.Time); ok {
+		if y, ok := b.(time.Time); ok {
+			return x.Compare(y)
+		}
+	}
+	if x, ok := a.(bool); ok {
+		if y, ok := b.(bool); ok && x != y {
+			if !x {
+				return -1
+			}
+			return 1
+		}
+	}
+	x, y := format(a), format(b)
+	if c := strings.Compare(strings.ToLower(x), strings.ToLower(y)); c != 0 {
+		return c
+	}
+	return strings.Compare(x, y)
+}
+
+// number returns a numeric value as float64.
+func number(v any) (float64, bool) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case time.Duration:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// Run runs the model and returns the selected row. It implements ui.Prompt[Row].
+func (m *Model) Run(ctx context.Context) (Row, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return nil, err
+	}
+	return m.SelectedRow(), nil
+}
+
+// RunAccessible lists the rows as numbered lines and asks for the number of the row to select instead of using the
+// terminal UI. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	if len(m.order) == 0 {
+		return errors.New("no rows to select from")
+	}
+
+	items := make([]string, len(m.order))
+	for pos, i := range m.order {
+		cells := make([]string, len(m.columns))
+		for col, c := range m.columns {
+			cells[col] = fmt.Sprintf("%s: %s", c.Title, m.cell(m.rows[i], col))
+		}
+		items[pos] = strings.Join(cells, ", ")
+	}
+
+	pos, err := plain.New(in, out).Choice(m.label, items, m.cursor)
+	switch {
+	case errors.Is(err, io.EOF):
+		m.canceled, m.quit = true, false
+		return nil
+	case err != nil:
+		return err
+	}
+	m.cursor = pos
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Showcase demonstrates the Model component with a sortable table of files.
+func Showcase() {
+	fmt.Println("=== Table Showcase ===")
+
+	now := time.Now()
+	columns := []Column{
+		{Title: "Name", Key: "name"},
+		{Title: "Size", Key: "size", Align: lipgloss.Right},
+		{Title: "Modified", Key: "modified"},
+		{Title: "Owner", Key: "owner"},
+		{Title: "Description", Key: "description", Width: 40},
+	}
+	rows := []Row{
+		{"README.md", 4096, now.Add(-2 * time.Hour), "alice", "Project overview and usage examples"},
+		{"main.go", 1280, now.Add(-30 * time.Minute), "bob", "Entry point of the command line interface"},
+		{"go.sum", 18432, now.Add(-72 * time.Hour), "alice", "Checksums of all module dependencies, " +
+			"which are verified when downloading"},
+		{"LICENSE", 1067, now.Add(-365 * 24 * time.Hour), "carol", "MIT license"},
+	}
+
+	m := New("Select a file (1-5 sorts by column):", columns, rows, SortBy("name", false))
+	row, err := m.Run(context.Background())
+	if ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Selected: %v (%v bytes)\n", row[0], row[1])
+	}
+}
@@ -0,0 +1,136 @@
+package tagselect
+
+import (
+	"maps"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	newModel.selected = maps.Clone(m.selected)
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Label sets the label shown above the tags.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Selected selects the given tags. Tags that are not known are added, even if new tags cannot be added by the user.
+func Selected(tags ...string) Option {
+	return func(m *Model) {
+		creatable := m.creatable
+		m.creatable = true
+		for _, tag := range tags {
+			if i, _, err := m.add(tag); err == nil {
+				m.selected[m.tags[i]] = true
+			}
+		}
+		m.creatable = creatable
+	}
+}
+
+// Creatable sets whether new tags can be added.
+func Creatable(creatable bool) Option {
+	return func(m *Model) {
+		m.creatable = creatable
+	}
+}
+
+// Normalize sets the function transforming added tags, e.g. strings.ToLower.
+func Normalize(fn func(string) string) Option {
+	return func(m *Model) {
+		m.normalize = fn
+	}
+}
+
+// Placeholder sets the placeholder of the text field for new tags.
+func Placeholder(placeholder string) Option {
+	return func(m *Model) {
+		m.textInput.Placeholder = placeholder
+	}
+}
+
+// Min sets the minimum number of selected tags.
+func Min(n int) Option {
+	return func(m *Model) {
+		m.min = n
+	}
+}
+
+// Max sets the maximum number of selected tags, 0 for no limit.
+func Max(n int) Option {
+	return func(m *Model) {
+		m.max = n
+	}
+}
+
+// Width sets the width the chips are wrapped at, 0 to use the width of the terminal.
+func Width(width int) Option {
+	return func(m *Model) {
+		m.fixedWidth = width
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
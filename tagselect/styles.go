@@ -0,0 +1,44 @@
+package tagselect
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Label        lipgloss.Style // Label is the style of the label above the tags.
+	Chip         lipgloss.Style // Chip is the style of unselected tags.
+	SelectedChip lipgloss.Style // SelectedChip is the style of selected tags.
+	Cursor       lipgloss.Style // Cursor is applied on top of the style of the focused tag and marks the text field.
+	Unmatched    lipgloss.Style // Unmatched is applied on top of the style of tags not matching the entered text.
+	Prompt       lipgloss.Style // Prompt is the style of the prompt of the text field.
+	Hint         lipgloss.Style // Hint is the style of the number of selected tags and of notes.
+	Error        lipgloss.Style // Error is the style of the error shown below the tags.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:        lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Chip:         lipgloss.NewStyle().Foreground(t.Text).Padding(0, 1),
+		SelectedChip: lipgloss.NewStyle().Foreground(t.Success).Bold(true).Padding(0, 1),
+		Cursor:       lipgloss.NewStyle().Foreground(t.Accent).Reverse(true),
+		Unmatched:    lipgloss.NewStyle().Faint(true),
+		Prompt:       lipgloss.NewStyle().Faint(true),
+		Hint:         lipgloss.NewStyle().Faint(true),
+		Error:        t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
@@ -0,0 +1,657 @@
+package tagselect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"       // Manages key bindings
+	"github.com/charmbracelet/bubbles/textinput" // Provides text input model
+	tea "github.com/charmbracelet/bubbletea"     // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"          // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/plain"
+)
+
+var _ ui.Prompt[[]string] = (*Model)(nil)
+
+var (
+	toggleKey    = key.NewBinding(key.WithKeys(" ", "x"), key.WithHelp("space", "toggle"))
+	switchKey    = key.NewBinding(key.WithKeys("tab", "shift+tab"), key.WithHelp("tab", "add tag"))
+	backspaceKey = key.NewBinding(key.WithKeys("backspace"), key.WithHelp("backspace", "deselect last"))
+	homeKey      = key.NewBinding(key.WithKeys("home", "g"), key.WithHelp("home", "first"))
+	endKey       = key.NewBinding(key.WithKeys("end", "G"), key.WithHelp("end", "last"))
+)
+
+// Model represents a tag selector: the known tags are shown as chips that can be toggled, and new tags can be added
+// in an inline text field. Unlike free-text input of several values, it surfaces the existing vocabulary, so that
+// the same tag is not entered in different spellings.
+type Model struct {
+	label          string              // label is shown above the tags.
+	tags           []string            // tags holds the known tags, followed by the added ones.
+	selected       map[string]bool     // selected holds the selected tags.
+	cursor         int                 // cursor is the index of the focused tag, len(tags) if the text field is focused.
+	creatable      bool                // creatable determines if new tags can be added.
+	normalize      func(string) string // normalize transforms added tags, nil to keep them as entered.
+	min            int                 // min is the minimum number of selected tags.
+	max            int                 // max is the maximum number of selected tags, 0 if unlimited.
+	fixedWidth     int                 // fixedWidth is the width the chips are wrapped at, 0 to use the window width.
+	width          int                 // width is the window width, updated from window size messages.
+	textInput      textinput.Model     // textInput reads new tags.
+	note           string              // note is shown below the tags, e.g. that an entered tag already exists.
+	cancelable     bool                // cancelable determines if selection can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	id             string              // id identifies the prompt, e.g. for preset answers
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                // focused determines if the model handles key messages
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error               // err is shown below the model, e.g. why the previous answer was rejected
+
+	canceled bool // canceled indicates whether the selection was canceled
+	quit     bool // quit indicates whether the selection was quit
+}
+
+// New creates and returns a new Model with the given label and known tags, configured by the given options. By
+// default, no tag is selected and new tags can be added.
+func New(label string, tags []string, opts ...Option) *Model {
+	ti := textinput.New()
+	ti.KeyMap = ui.DefaultTextKeyMap()
+	ti.Prompt = ""
+	ti.Placeholder = "new tag"
+	ti.Width = 30
+
+	m := &Model{
+		label:      label,
+		tags:       tags,
+		selected:   map[string]bool{},
+		creatable:  true,
+		textInput:  ti,
+		cancelable: true,
+		quitable:   true,
+		focused:    true,
+		keymap:     ui.DefaultKeyMap(),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	if len(m.tags) == 0 {
+		m.textInput.Focus()
+	}
+	return m
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithSelected selects the given tags and returns a new Model with the updated selection. Tags that are not known
+// are added.
+func (m *Model) WithSelected(tags ...string) *Model {
+	return m.With(Selected(tags...))
+}
+
+// WithCreatable sets whether new tags can be added and returns a new Model with the updated flag.
+func (m *Model) WithCreatable(creatable bool) *Model {
+	return m.With(Creatable(creatable))
+}
+
+// WithNormalize sets the function transforming added tags, e.g. strings.ToLower, and returns a new Model with the
+// updated function.
+func (m *Model) WithNormalize(fn func(string) string) *Model {
+	return m.With(Normalize(fn))
+}
+
+// WithPlaceholder sets the placeholder of the text field for new tags and returns a new Model with the updated
+// placeholder.
+func (m *Model) WithPlaceholder(placeholder string) *Model {
+	return m.With(Placeholder(placeholder))
+}
+
+// WithMin sets the minimum number of selected tags and returns a new Model with the updated constraint.
+func (m *Model) WithMin(n int) *Model {
+	return m.With(Min(n))
+}
+
+// WithMax sets the maximum number of selected tags and returns a new Model with the updated constraint.
+func (m *Model) WithMax(n int) *Model {
+	return m.With(Max(n))
+}
+
+// WithWidth sets the width the chips are wrapped at and returns a new Model with the updated width.
+func (m *Model) WithWidth(width int) *Model {
+	return m.With(Width(width))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Tags returns all tags: the known ones followed by the added ones.
+func (m *Model) Tags() []string {
+	return m.tags
+}
+
+// Selected returns the selected tags in the order of Tags.
+func (m *Model) Selected() []string {
+	selected := []string{}
+	for _, tag := range m.tags {
+		if m.selected[tag] {
+			selected = append(selected, tag)
+		}
+	}
+	return selected
+}
+
+// index returns the index of the given tag, ignoring case, or -1 if it is not known.
+func (m *Model) index(tag string) int {
+	for i, t := range m.tags {
+		if strings.EqualFold(t, tag) {
+			return i
+		}
+	}
+	return -1
+}
+
+// add normalizes the given tag and adds it unless it is known already. It returns the index of the tag, and whether
+// it was known.
+func (m *Model) add(tag string) (int, bool, error) {
+	tag = strings.TrimSpace(tag)
+	if m.normalize != nil {
+		tag = m.normalize(tag)
+	}
+	if tag == "" {
+		return -1, false, errors.New("tag is empty")
+	}
+	if i := m.index(tag); i >= 0 {
+		return i, true, nil
+	}
+	if !m.creatable {
+		return -1, false, fmt.Errorf("unknown tag: %s", tag)
+	}
+	// Copy on append, so that the tags of copies made with With are not changed.
+	m.tags = append(m.tags[:len(m.tags):len(m.tags)], tag)
+	if m.cursor >= len(m.tags)-1 {
+		m.cursor = len(m.tags)
+	}
+	return len(m.tags) - 1, false, nil
+}
+
+// toggle switches the selection of the tag at index i, unless selecting it would exceed the maximum.
+func (m *Model) toggle(i int) error {
+	if i < 0 || i >= len(m.tags) {
+		return nil
+	}
+	tag := m.tags[i]
+	if !m.selected[tag] && m.max > 0 && len(m.Selected()) >= m.max {
+		return fmt.Errorf("select at most %d", m.max)
+	}
+	m.selected[tag] = !m.selected[tag]
+	return nil
+}
+
+// submit adds and selects the entered tag, or selects it if it is known already. Nothing is added if the tag cannot
+// be selected.
+func (m *Model) submit() error {
+	c := *m
+	i, known, err := c.add(m.textInput.Value())
+	if err != nil {
+		return err
+	}
+	tag := c.tags[i]
+	if !m.selected[tag] && m.max > 0 && len(m.Selected()) >= m.max {
+		return fmt.Errorf("select at most %d", m.max)
+	}
+	m.tags, m.cursor = c.tags, c.cursor
+	switch {
+	case known && m.selected[tag]:
+		m.note = fmt.Sprintf("%s is already selected", tag)
+	case known:
+		m.note = fmt.Sprintf("%s already exists and was selected", tag)
+	}
+	m.selected[tag] = true
+	m.textInput.SetValue("")
+	return nil
+}
+
+// validate returns an error if the number of selected tags violates the constraints.
+func (m *Model) validate() error {
+	if n := len(m.Selected()); n < m.min || (m.max > 0 && n > m.max) {
+		return m.rangeError()
+	}
+	return nil
+}
+
+// rangeError returns an error describing the number of tags to select.
+func (m *Model) rangeError() error {
+	switch {
+	case m.max > 0 && m.min == m.max:
+		return fmt.Errorf("select exactly %d", m.min)
+	case m.max > 0:
+		return fmt.Errorf("select between %d and %d", m.min, m.max)
+	}
+	return fmt.Errorf("select at least %d", m.min)
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is a list of tags as []string, []any or a comma-separated string. Unknown
+// tags are added if new tags can be added. It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	var tags []string
+	switch v := v.(type) {
+	case []string:
+		tags = v
+	case []any:
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return fmt.Errorf("invalid answer: %v", v)
+			}
+			tags = append(tags, s)
+		}
+	case string:
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				tags = append(tags, s)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+
+	// Add the tags to a copy, so that a rejected answer leaves the model unchanged.
+	c := *m
+	selected := map[string]bool{}
+	for _, tag := range tags {
+		i, _, err := c.add(tag)
+		if err != nil {
+			return err
+		}
+		selected[c.tags[i]] = true
+	}
+	if n := len(selected); n < m.min || (m.max > 0 && n > m.max) {
+		return m.rangeError()
+	}
+
+	m.tags, m.selected = c.tags, selected
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the selected tags as []string. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	return m.Selected()
+}
+
+// Choices returns all tags. It implements ui.ChoiceModel.
+func (m *Model) Choices() []string {
+	return m.tags
+}
+
+// Focus focuses the model, so that it handles key messages, and returns the command starting the cursor blink if
+// the text field is focused.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	if m.cursor == len(m.tags) {
+		return m.textInput.Focus()
+	}
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+	m.textInput.Blur()
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns a nil command.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// move moves the cursor to index i, focusing the text field if i is len(tags).
+func (m *Model) move(i int) tea.Cmd {
+	last := len(m.tags) - 1
+	if m.creatable {
+		last++
+	}
+	m.cursor = max(0, min(i, last))
+	if m.cursor == len(m.tags) {
+		return m.textInput.Focus()
+	}
+	m.textInput.Blur()
+	return nil
+}
+
+// UsesKey reports whether the prompt handles the key itself, like tab and shift+tab moving between the tags and the
+// text field. It implements ui.KeyModel.
+func (m *Model) UsesKey(msg tea.KeyMsg) bool {
+	return key.Matches(msg, switchKey)
+}
+
+// Update handles key messages, moving between the tags and the text field, toggling tags, adding new ones and
+// confirming the selection.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	if msg, ok := msg.(tea.WindowSizeMsg); ok {
+		m.width = msg.Width
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !m.focused {
+		return m, nil
+	}
+	inInput := m.cursor == len(m.tags)
+	if !key.Matches(keyMsg, m.keymap.Quit) {
+		m.note = ""
+	}
+
+	switch {
+	case key.Matches(keyMsg, switchKey):
+		if inInput {
+			return m, m.move(len(m.tags) - 1)
+		}
+		return m, m.move(len(m.tags))
+	case inInput && key.Matches(keyMsg, m.keymap.Confirm) && m.textInput.Value() != "":
+		m.err = m.submit()
+		return m, nil
+	case inInput && key.Matches(keyMsg, backspaceKey) && m.textInput.Value() == "":
+		selected := m.Selected()
+		if len(selected) > 0 {
+			m.selected[selected[len(selected)-1]] = false
+		}
+		m.err = nil
+		return m, nil
+	case !inInput && key.Matches(keyMsg, toggleKey):
+		m.err = m.toggle(m.cursor)
+		return m, nil
+	case !inInput && key.Matches(keyMsg, homeKey):
+		return m, m.move(0)
+	case !inInput && key.Matches(keyMsg, endKey):
+		return m, m.move(len(m.tags))
+	case !inInput && key.Matches(keyMsg, m.keymap.Prev):
+		return m, m.move(m.cursor - 1)
+	case !inInput && key.Matches(keyMsg, m.keymap.Next):
+		return m, m.move(m.cursor + 1)
+	case key.Matches(keyMsg, m.keymap.Confirm):
+		if err := m.validate(); err != nil {
+			ui.RecordValidationFailure(m.Key(), err)
+			m.err = err
+			return m, nil
+		}
+		m.err = nil
+		m.canceled, m.quit = false, false
+		return m, m.done()
+	case key.Matches(keyMsg, m.keymap.Cancel):
+		if m.cancelable {
+			m.canceled, m.quit = true, false
+			return m, m.done()
+		}
+		return m, nil
+	case key.Matches(keyMsg, m.keymap.Quit):
+		if m.quitable {
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m, m.done()
+		}
+		return m, nil
+	}
+
+	if !inInput {
+		return m, nil
+	}
+	var cmd tea.Cmd
+	value := m.textInput.Value()
+	m.textInput, cmd = m.textInput.Update(msg)
+	if m.textInput.Value() != value {
+		m.err = nil
+	}
+	return m, cmd
+}
+
+// chip renders the tag at index i, highlighting it if it matches the entered text.
+func (m *Model) chip(i int) string {
+	g := ui.Glyphs()
+	tag := m.tags[i]
+	style := m.styles.Chip
+	if m.selected[tag] {
+		tag, style = g.Success+" "+tag, m.styles.SelectedChip
+	}
+	if i == m.cursor && m.focused {
+		style = style.Inherit(m.styles.Cursor)
+	} else if text := strings.TrimSpace(m.textInput.Value()); text != "" && !strings.Contains(
+		strings.ToLower(m.tags[i]), strings.ToLower(text)) {
+		style = style.Inherit(m.styles.Unmatched)
+	}
+	return style.Render(tag)
+}
+
+// View renders the label, the tags as chips wrapped at the width, the text field for new tags and the number of
+// selected tags if constrained.
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.label != "" {
+		fmt.Fprintf(&b, "%s\n", m.styles.Label.Render(m.label))
+	}
+
+	width := m.fixedWidth
+	if width <= 0 {
+		width = m.width
+	}
+	if width <= 0 {
+		width = 80
+	}
+	line, lineWidth := "", 0
+	for i := range m.tags {
+		chip := m.chip(i)
+		w := lipgloss.Width(chip)
+		if lineWidth > 0 && lineWidth+1+w > width {
+			fmt.Fprintf(&b, "%s\n", line)
+			line, lineWidth = "", 0
+		}
+		if lineWidth > 0 {
+			line += " "
+			lineWidth++
+		}
+		line += chip
+		lineWidth += w
+	}
+	if line != "" {
+		fmt.Fprintf(&b, "%s\n", line)
+	}
+
+	if m.creatable {
+		prompt := m.styles.Prompt.Render("+ ")
+		if m.cursor == len(m.tags) && m.focused {
+			prompt = m.styles.Cursor.Render("+") + " "
+		}
+		fmt.Fprintf(&b, "%s%s\n", prompt, m.textInput.View())
+	}
+
+	if m.min > 0 || m.max > 0 {
+		status := fmt.Sprintf("%d selected", len(m.Selected()))
+		switch {
+		case m.max > 0 && m.min == m.max:
+			status += fmt.Sprintf(" (select %d)", m.max)
+		case m.max > 0:
+			status += fmt.Sprintf(" (select %d-%d)", m.min, m.max)
+		default:
+			status += fmt.Sprintf(" (select at least %d)", m.min)
+		}
+		fmt.Fprintf(&b, "%s\n", m.styles.Hint.Render(status))
+	}
+	if m.note != "" {
+		fmt.Fprintf(&b, "%s\n", m.styles.Hint.Render(m.note))
+	}
+	if m.err != nil {
+		fmt.Fprintf(&b, "%s\n", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// Run runs the model and returns the selected tags. It implements ui.Prompt[[]string].
+func (m *Model) Run(ctx context.Context) ([]string, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return nil, err
+	}
+	return m.Selected(), nil
+}
+
+// RunAccessible lists the tags and asks for the numbers or names of the tags to select, adding unknown names as new
+// tags, instead of using the terminal UI. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	if m.label != "" {
+		p.Println(m.label)
+	}
+	var def []string
+	for i, tag := range m.tags {
+		p.Println(fmt.Sprintf("%3d) %s", i+1, tag))
+		if m.selected[tag] {
+			def = append(def, strconv.Itoa(i+1))
+		}
+	}
+
+	prompt := "Select (numbers or names, separated by commas, - for none): "
+	if m.creatable {
+		prompt = "Select (numbers or names, new names are added, separated by commas, - for none): "
+	}
+	for {
+		s, err := p.Line(prompt, strings.Join(def, ","))
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+
+		tags := []string{}
+		if strings.TrimSpace(s) != "-" {
+			for _, e := range strings.Split(s, ",") {
+				e = strings.TrimSpace(e)
+				if n, err := strconv.Atoi(e); err == nil && n >= 1 && n <= len(m.tags) {
+					e = m.tags[n-1]
+				}
+				if e != "" {
+					tags = append(tags, e)
+				}
+			}
+		}
+		if err := m.SetAnswer(tags); err != nil {
+			ui.RecordValidationFailure(m.Key(), err)
+			p.Println(fmt.Sprintf("Error: %v", err))
+			continue
+		}
+		return nil
+	}
+}
+
+// Showcase demonstrates the Model component with a known vocabulary, normalized new tags and a maximum.
+func Showcase() {
+	fmt.Println("=== Tag Select Showcase ===")
+
+	fmt.Println("\nLabel the issue (space toggles, tab adds a new tag, backspace deselects):")
+	m := New("Labels:", []string{"bug", "enhancement", "documentation", "question", "good first issue"}).
+		WithSelected("bug").WithNormalize(strings.ToLower).WithMax(3)
+	tags, err := m.Run(context.Background())
+	if ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Labels: %v\n", tags)
+	}
+}
@@ -0,0 +1,46 @@
+package tasklist
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Pending lipgloss.Style // Pending is the style of the glyph and label of pending steps.
+	Spinner lipgloss.Style // Spinner is the style of the spinner of running steps.
+	Running lipgloss.Style // Running is the style of the label of running steps.
+	Label   lipgloss.Style // Label is the style of the labels of succeeded and failed steps.
+	Detail  lipgloss.Style // Detail is the style of the details of running steps and the reasons of skipped steps.
+	Elapsed lipgloss.Style // Elapsed is the style of the elapsed time of started steps.
+	Success lipgloss.Style // Success is the style of the glyph of succeeded steps.
+	Failure lipgloss.Style // Failure is the style of the glyph and error of failed steps.
+	Skipped lipgloss.Style // Skipped is the style of the glyph and label of skipped steps.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Pending: lipgloss.NewStyle().Faint(true),
+		Spinner: lipgloss.NewStyle().Foreground(t.Accent),
+		Running: lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Label:   lipgloss.NewStyle().Foreground(t.Text),
+		Detail:  lipgloss.NewStyle().Faint(true),
+		Elapsed: lipgloss.NewStyle().Faint(true),
+		Success: lipgloss.NewStyle().Foreground(t.Success),
+		Failure: lipgloss.NewStyle().Foreground(t.Failure),
+		Skipped: lipgloss.NewStyle().Faint(true),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
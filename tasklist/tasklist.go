@@ -0,0 +1,583 @@
+package tasklist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"              // Manages key bindings
+	bspinner "github.com/charmbracelet/bubbles/spinner" // Provides spinner animations
+	tea "github.com/charmbracelet/bubbletea"            // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"                 // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/spinner"
+)
+
+// State is the state of a step.
+type State int
+
+const (
+	Pending   State = iota // Pending means the step has not started yet.
+	Running                // Running means the step has started but not finished yet.
+	Succeeded              // Succeeded means the step finished without error.
+	Failed                 // Failed means the step finished with an error.
+	Skipped                // Skipped means the step was skipped.
+)
+
+// String returns the name of the state.
+func (s State) String() string {
+	switch s {
+	case Pending:
+		return "pending"
+	case Running:
+		return "running"
+	case Succeeded:
+		return "succeeded"
+	case Failed:
+		return "failed"
+	case Skipped:
+		return "skipped"
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// finished returns whether the state is final.
+func (s State) finished() bool {
+	return s >= Succeeded
+}
+
+// list holds the steps shared by a Model and its step handles.
+type list struct {
+	mu     sync.Mutex
+	steps  []*Step
+	closed bool  // closed is set once the function started with Go returned.
+	err    error // err is the error returned by the function started with Go, unless a step failed with it.
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// Step is a handle to a step of the list. Its methods are safe for concurrent use, so a step can be updated from any
+// goroutine.
+type Step struct {
+	l       *list
+	label   string        // label identifies the step.
+	detail  string        // detail is a short description of what the step is doing.
+	state   State         // state is the state of the step.
+	err     error         // err is the error the step finished with.
+	started time.Time     // started is the time the step started.
+	elapsed time.Duration // elapsed is the duration of the finished step.
+}
+
+// Label returns the label of the step.
+func (s *Step) Label() string {
+	s.l.mu.Lock()
+	defer s.l.mu.Unlock()
+	return s.label
+}
+
+// SetLabel changes the label of the step.
+func (s *Step) SetLabel(label string) {
+	s.l.mu.Lock()
+	defer s.l.mu.Unlock()
+	s.label = label
+}
+
+// SetDetail sets a short description of what the step is doing, shown next to its label while it runs.
+func (s *Step) SetDetail(detail string) {
+	s.l.mu.Lock()
+	defer s.l.mu.Unlock()
+	s.detail = detail
+}
+
+// Start marks a pending step as running and starts measuring its elapsed time. It has no effect on other steps.
+func (s *Step) Start() {
+	s.l.mu.Lock()
+	defer s.l.mu.Unlock()
+	if s.state == Pending {
+		s.state, s.started = Running, time.Now()
+	}
+}
+
+// Done marks the step as succeeded.
+func (s *Step) Done() {
+	s.Finish(nil)
+}
+
+// Fail marks the step as failed with the given error.
+func (s *Step) Fail(err error) {
+	if err == nil {
+		err = errors.New("failed")
+	}
+	s.Finish(err)
+}
+
+// Skip marks the step as skipped, giving the reason if it is not empty.
+func (s *Step) Skip(reason string) {
+	if reason == "" {
+		s.Finish(spinner.ErrSkip)
+		return
+	}
+	s.Finish(fmt.Errorf("%w: %s", spinner.ErrSkip, reason))
+}
+
+// Finish finishes the step with the given error: nil marks it as succeeded, spinner.ErrSkip (possibly wrapped) as
+// skipped and any other error as failed. Pending steps are finished without elapsed time. Calling Finish on a finished
+// step has no effect.
+func (s *Step) Finish(err error) {
+	s.l.mu.Lock()
+	defer s.l.mu.Unlock()
+	s.finish(err)
+}
+
+// finish finishes the step like Finish; the caller must hold the lock.
+func (s *Step) finish(err error) {
+	if s.state.finished() {
+		return
+	}
+	if s.state == Running {
+		s.elapsed = time.Since(s.started)
+	}
+	s.err = err
+	switch {
+	case err == nil:
+		s.state = Succeeded
+	case errors.Is(err, spinner.ErrSkip):
+		s.state = Skipped
+	default:
+		s.state = Failed
+	}
+}
+
+// Run starts the step, runs fn and finishes the step with the error returned by fn, which it returns.
+func (s *Step) Run(fn func() error) error {
+	s.Start()
+	err := fn()
+	s.Finish(err)
+	return err
+}
+
+// State returns the state of the step.
+func (s *Step) State() State {
+	s.l.mu.Lock()
+	defer s.l.mu.Unlock()
+	return s.state
+}
+
+// Err returns the error the step finished with.
+func (s *Step) Err() error {
+	s.l.mu.Lock()
+	defer s.l.mu.Unlock()
+	return s.err
+}
+
+// Elapsed returns the time the step has been running, or the duration of the finished step.
+func (s *Step) Elapsed() time.Duration {
+	s.l.mu.Lock()
+	defer s.l.mu.Unlock()
+	return s.elapsedLocked()
+}
+
+// elapsedLocked returns the elapsed time like Elapsed; the caller must hold the lock.
+func (s *Step) elapsedLocked() time.Duration {
+	if s.state == Running {
+		return time.Since(s.started)
+	}
+	return s.elapsed
+}
+
+// Model represents a checklist of named steps, e.g. the steps of an installer. Each step is pending until it starts,
+// shows a spinner while it runs and turns into a success, failure or skip glyph with its elapsed time when it
+// finished. The steps are updated through *Step handles from any goroutine.
+type Model struct {
+	list           *list               // list holds the steps; shared by copies of the model
+	frames         bspinner.Spinner    // frames is the animation of the spinners.
+	frame          int                 // frame is the current animation frame.
+	showElapsed    bool                // showElapsed determines if the elapsed time of started steps is shown.
+	cancelable     bool                // cancelable determines if the steps can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	finished       bool                // finished indicates whether all steps finished.
+
+	canceled bool // canceled indicates whether the steps were canceled
+	quit     bool // quit indicates whether the steps were quit
+}
+
+// New creates and returns a new Model with a pending step for each of the given labels, configured by the given
+// options.
+func New(labels []string, opts ...Option) *Model {
+	frames := bspinner.Dot
+	if ui.LegacyConsole() {
+		frames = bspinner.Line
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Model{
+		list:        &list{ctx: ctx, cancel: cancel},
+		frames:      frames,
+		showElapsed: true,
+		cancelable:  true,
+		quitable:    true,
+		keymap:      ui.DefaultKeyMap(),
+		styles:      DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, label := range labels {
+		m.Add(label)
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithElapsed sets whether the elapsed time of started steps is shown and returns a new Model with the updated flag.
+func (m *Model) WithElapsed(show bool) *Model {
+	return m.With(Elapsed(show))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when all steps finished.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Add appends a pending step with the given label and returns its handle. It is safe for concurrent use.
+func (m *Model) Add(label string) *Step {
+	s := &Step{l: m.list, label: label}
+	m.list.mu.Lock()
+	defer m.list.mu.Unlock()
+	m.list.steps = append(m.list.steps, s)
+	return s
+}
+
+// Step returns the handle of the step with the given index, or nil if the index is out of range.
+func (m *Model) Step(i int) *Step {
+	m.list.mu.Lock()
+	defer m.list.mu.Unlock()
+	if i < 0 || i >= len(m.list.steps) {
+		return nil
+	}
+	return m.list.steps[i]
+}
+
+// Steps returns the handles of all steps in the order they were added.
+func (m *Model) Steps() []*Step {
+	m.list.mu.Lock()
+	defer m.list.mu.Unlock()
+	return append([]*Step(nil), m.list.steps...)
+}
+
+// Go runs fn in a new goroutine, which is expected to work through the steps. When fn returns, a running step is
+// finished with the returned error, the remaining pending steps are skipped and the list finishes. If no step was
+// running, a returned error is reported by Err. The context passed to fn is canceled when the user cancels the list.
+func (m *Model) Go(fn func(ctx context.Context, m *Model) error) {
+	go func() {
+		err := fn(m.list.ctx, m)
+		m.list.mu.Lock()
+		defer m.list.mu.Unlock()
+		reported := false
+		for _, s := range m.list.steps {
+			if s.state == Running {
+				s.finish(err)
+				reported = true
+			}
+		}
+		for _, s := range m.list.steps {
+			s.finish(spinner.ErrSkip)
+		}
+		if !reported && err != nil && !errors.Is(err, spinner.ErrSkip) {
+			m.list.err = err
+		}
+		m.list.closed = true
+	}()
+}
+
+// Err returns the errors of all failed steps joined, each prefixed with the label of the step, together with an
+// error returned by the function started with Go, or nil if nothing failed.
+func (m *Model) Err() error {
+	m.list.mu.Lock()
+	defer m.list.mu.Unlock()
+	var errs []error
+	for _, s := range m.list.steps {
+		if s.state == Failed {
+			errs = append(errs, fmt.Errorf("%s: %w", s.label, s.err))
+		}
+	}
+	if m.list.err != nil {
+		errs = append(errs, m.list.err)
+	}
+	return errors.Join(errs...)
+}
+
+// complete returns whether the list is complete: all steps finished, or, after the user canceled the list, no step
+// is running anymore. Pending steps of a canceled list are skipped.
+func (m *Model) complete() bool {
+	m.list.mu.Lock()
+	defer m.list.mu.Unlock()
+	if m.list.closed {
+		return true
+	}
+	canceled := m.list.ctx.Err() != nil
+	for _, s := range m.list.steps {
+		if s.state == Running || s.state == Pending && !canceled {
+			return false
+		}
+	}
+	for _, s := range m.list.steps {
+		s.finish(fmt.Errorf("%w: canceled", spinner.ErrSkip))
+	}
+	return true
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when all steps finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// tickMsg triggers redrawing a Model.
+type tickMsg struct {
+	model *Model
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// tick returns the command scheduling the next redraw.
+func (m *Model) tick() tea.Cmd {
+	return tea.Tick(m.frames.FPS, func(time.Time) tea.Msg {
+		return tickMsg{model: m}
+	})
+}
+
+// Init starts redrawing the list.
+func (m *Model) Init() tea.Cmd {
+	return m.tick()
+}
+
+// Update redraws the list, finishing it once all steps finished, and handles cancellation.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.finished {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, m.keymap.Cancel):
+			if m.cancelable {
+				m.canceled, m.quit = true, false
+				m.list.cancel()
+			}
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				m.list.cancel()
+			}
+		}
+	case tickMsg:
+		if msg.model != m || m.finished {
+			return m, nil
+		}
+		m.frame++
+		if m.complete() {
+			m.finished = true
+			return m, m.done()
+		}
+		return m, m.tick()
+	}
+	return m, nil
+}
+
+// View renders a line per step with its state glyph, label and, once started, its elapsed time.
+func (m *Model) View() string {
+	m.list.mu.Lock()
+	defer m.list.mu.Unlock()
+
+	width := 0
+	for _, s := range m.list.steps {
+		width = max(width, lipgloss.Width(s.label))
+	}
+
+	var b strings.Builder
+	g := ui.Glyphs()
+	for _, s := range m.list.steps {
+		label := s.label + strings.Repeat(" ", width-lipgloss.Width(s.label))
+		switch s.state {
+		case Pending:
+			fmt.Fprintf(&b, "%s %s", m.styles.Pending.Render(g.Bullet), m.styles.Pending.Render(label))
+		case Running:
+			frame := strings.TrimSpace(m.frames.Frames[m.frame%len(m.frames.Frames)])
+			fmt.Fprintf(&b, "%s %s", m.styles.Spinner.Render(frame), m.styles.Running.Render(label))
+		case Succeeded:
+			fmt.Fprintf(&b, "%s %s", m.styles.Success.Render(g.Success), m.styles.Label.Render(label))
+		case Failed:
+			fmt.Fprintf(&b, "%s %s", m.styles.Failure.Render(g.Failure), m.styles.Label.Render(label))
+		case Skipped:
+			fmt.Fprintf(&b, "%s %s", m.styles.Skipped.Render(g.Skipped), m.styles.Skipped.Render(label))
+		}
+
+		switch {
+		case s.state == Running && s.detail != "":
+			fmt.Fprintf(&b, " %s", m.styles.Detail.Render(s.detail))
+		case s.state == Failed:
+			fmt.Fprintf(&b, " %s", m.styles.Failure.Render(s.err.Error()))
+		case s.state == Skipped && s.err != spinner.ErrSkip:
+			fmt.Fprintf(&b, " %s", m.styles.Detail.Render(strings.TrimPrefix(s.err.Error(), spinner.ErrSkip.Error()+": ")))
+		}
+		if m.showElapsed && !s.started.IsZero() {
+			fmt.Fprintf(&b, " %s", m.styles.Elapsed.Render(fmt.Sprintf("(%s)", s.elapsedLocked().Round(100*time.Millisecond))))
+		}
+		b.WriteString("\n")
+	}
+	if m.list.ctx.Err() != nil && !m.finished {
+		fmt.Fprintf(&b, "%s\n", m.styles.Detail.Render("canceling..."))
+	}
+	return b.String()
+}
+
+// Run shows the list until all steps finished, or until the function started with Go returned. It returns
+// ui.CanceledError or ui.QuitError if the user canceled the list, once no step is running anymore, and otherwise the
+// joined errors of all failed steps. Canceling ctx cancels the context passed to the function started with Go.
+func (m *Model) Run(ctx context.Context) error {
+	stop := context.AfterFunc(ctx, m.list.cancel)
+	defer stop()
+
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return err
+	}
+	return m.Err()
+}
+
+// RunAccessible waits for all steps to finish, reporting each step as a plain line when it starts and when it
+// finished. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	reported := map[*Step]State{}
+	for {
+		for _, s := range m.Steps() {
+			label, state, err := s.Label(), s.State(), s.Err()
+			if reported[s] == state {
+				continue
+			}
+			switch state {
+			case Running:
+				fmt.Fprintf(out, "%s...\n", label)
+			case Succeeded:
+				fmt.Fprintf(out, "%s: done\n", label)
+			case Failed:
+				fmt.Fprintf(out, "%s: failed: %v\n", label, err)
+			case Skipped:
+				fmt.Fprintf(out, "%s: %v\n", label, err)
+			}
+			reported[s] = state
+		}
+		if m.complete() {
+			m.finished = true
+			return m.Err()
+		}
+		time.Sleep(m.frames.FPS)
+	}
+}
+
+// Showcase demonstrates the Model component with a simulated installer.
+func Showcase() {
+	fmt.Println("=== Task List Showcase ===")
+
+	m := New([]string{"Check requirements", "Download packages", "Verify checksums", "Install files",
+		"Configure service", "Start service"})
+	m.Go(func(ctx context.Context, m *Model) error {
+		sleep := func(d time.Duration) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(d):
+				return nil
+			}
+		}
+		for i, s := range m.Steps() {
+			s.Start()
+			switch i {
+			case 1:
+				for n := 1; n <= 5; n++ {
+					s.SetDetail(fmt.Sprintf("package %d/5", n))
+					if err := sleep(200 * time.Millisecond); err != nil {
+						return err
+					}
+				}
+			case 4:
+				s.Skip("already configured")
+				continue
+			default:
+				if err := sleep(500 * time.Millisecond); err != nil {
+					return err
+				}
+			}
+			s.Done()
+		}
+		return nil
+	})
+	if err := m.Run(context.Background()); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+}
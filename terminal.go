@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+)
+
+// BusyError is returned by TryRun if another prompt is using the terminal.
+var BusyError = errors.New("another prompt is active")
+
+// terminal is held while a program or accessible prompt uses the terminal, so that prompts run from multiple
+// goroutines are serialized instead of corrupting the terminal. Prompts writing to other outputs, e.g. SSH sessions,
+// do not share the terminal and run concurrently.
+var terminal = make(chan struct{}, 1)
+
+// TryRun runs the model like Run, but returns BusyError right away if another prompt is using the terminal instead of
+// waiting for it to finish.
+func TryRun(m tea.Model, opts ...tea.ProgramOption) error {
+	return Run(m, append(opts, option(func(s *settings) {
+		s.noWait = true
+	}))...)
+}
+
+// sharesTerminal reports whether the run writes to os.Stdout or os.Stderr, the terminal shared by all prompts of the
+// process.
+func (s *settings) sharesTerminal() bool {
+	f, ok := s.output.(*os.File)
+	return ok && (f.Fd() == os.Stdout.Fd() || f.Fd() == os.Stderr.Fd())
+}
+
+// acquireTerminal waits until the terminal is free and takes it over. It fails if the context of the run is done
+// before, or right away if waiting was disabled by TryRun. Runs not sharing the terminal do not wait.
+func acquireTerminal(s *settings) error {
+	if !s.sharesTerminal() {
+		return nil
+	}
+	select {
+	case terminal <- struct{}{}:
+		return nil
+	default:
+	}
+	if s.noWait {
+		return BusyError
+	}
+	ctx := s.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	select {
+	case terminal <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// releaseTerminal frees the terminal taken over by acquireTerminal.
+func releaseTerminal(s *settings) {
+	if s.sharesTerminal() {
+		<-terminal
+	}
+}
+
+// forwardWindowSize sends the size reported by the window size function of the settings, if set, to the program when
+// it starts and whenever it changes. The returned function stops forwarding.
+func forwardWindowSize(p *tea.Program, s *settings) (stop func()) {
+	if s.windowSize == nil {
+		return func() {}
+	}
+	done := make(chan struct{})
+	go func() {
+		for {
+			w, h := s.windowSize()
+			// Send blocks until the program reads the message or has finished.
+			p.Send(tea.WindowSizeMsg{Width: w, Height: h})
+			select {
+			case <-s.resized:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() { close(done) }
+}
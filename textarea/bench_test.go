@@ -0,0 +1,13 @@
+package textarea
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui/internal/benchview"
+)
+
+func BenchmarkView200(b *testing.B) {
+	benchview.Run(b, func() tea.Model { return New("Text", strings.Join(benchview.Items(200), "\n")) })
+}
@@ -0,0 +1,126 @@
+package textarea
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.setStyles(styles)
+	}
+}
+
+// Prompt sets the prompt for the text textarea model, which is shown in front of each line. All but the last line of
+// a multi-line prompt are shown above the textarea instead.
+func Prompt(s string) Option {
+	return func(m *Model) {
+		m.prompt = s
+		m.layout()
+	}
+}
+
+// Placeholder sets the placeholder for the text textarea model.
+func Placeholder(s string) Option {
+	return func(m *Model) {
+		m.textInput.Placeholder = s
+	}
+}
+
+// CharLimit sets the maximum allowed number of textarea characters.
+func CharLimit(n int) Option {
+	return func(m *Model) {
+		m.textInput.CharLimit = n
+	}
+}
+
+// MaxWidth sets the width of the text textarea model. It is reduced if the terminal is too narrow.
+func MaxWidth(n int) Option {
+	return func(m *Model) {
+		m.textInput.MaxWidth = n
+		m.layout()
+	}
+}
+
+// MaxHeight sets the height of the text textarea model. It is reduced if the terminal is too short.
+func MaxHeight(n int) Option {
+	return func(m *Model) {
+		m.textInput.MaxHeight = n
+		if n > 0 {
+			m.textInput.SetHeight(n)
+		}
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// ShowHelp sets whether the help footer is shown, overriding the package-wide setting of ui.ShowHelp.
+func ShowHelp(show bool) Option {
+	return func(m *Model) {
+		m.showHelp = show
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = keymap{km}
+		m.errview = *m.errview.WithKeyMap(km)
+	}
+}
+
+// Validator sets the validator the value must pass before it is accepted.
+func Validator(v ui.Validator[string]) Option {
+	return func(m *Model) {
+		m.validator = v
+	}
+}
@@ -0,0 +1,65 @@
+package textarea
+
+import (
+	"github.com/charmbracelet/bubbles/help" // Provides help view for key bindings
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/errorview"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Focused textarea.Style // Focused holds the styles of the textarea while it has the focus.
+	Blurred textarea.Style // Blurred holds the styles of the textarea while it does not have the focus.
+	Cursor  lipgloss.Style // Cursor is the style of the cursor.
+	Help    help.Styles    // Help holds the styles of the help view.
+	Error   lipgloss.Style // Error is the style of the error shown below the textarea.
+}
+
+// DefaultStyles returns the default styles of the textarea and help models, with errors styled like ui.RenderError.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the styles of the model in the given theme: those of DefaultStyles with errors in the color of
+// the theme, and, if the theme sets its styles, the prompt, cursor and help styled by them.
+func ThemedStyles(t ui.Theme) Styles {
+	focused, blurred := textarea.DefaultStyles()
+	styles := Styles{
+		Focused: focused,
+		Blurred: blurred,
+		Cursor:  lipgloss.NewStyle(),
+		Help:    help.New().Styles,
+		Error:   t.ErrorStyle(),
+	}
+	if t.Styles != nil {
+		styles.Focused.Prompt = t.Styles.Prompt
+		styles.Cursor = t.Styles.Cursor
+		styles.Help = t.Styles.Help
+	}
+	return styles
+}
+
+// setStyles sets the styles of the model and applies them to the textarea and help models.
+func (m *Model) setStyles(styles Styles) {
+	m.styles = styles
+	m.textInput.FocusedStyle = styles.Focused
+	m.textInput.BlurredStyle = styles.Blurred
+	m.textInput.Cursor.Style = styles.Cursor
+	// The textarea refers to the style of its current state, which must be updated after changing the styles.
+	if m.textInput.Focused() {
+		m.textInput.Focus()
+	} else {
+		m.textInput.Blur()
+	}
+	m.help.Styles = styles.Help
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.setStyles(ThemedStyles(t))
+	}
+	m.errview = *m.errview.WithStyles(errorview.ThemedStyles(t))
+}
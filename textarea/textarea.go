@@ -1,9 +1,10 @@
 package textarea
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"os"
+	"io"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/help" // Provides help view for key bindings
@@ -14,6 +15,11 @@ import (
 	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
 	// Styles terminal UI components
 	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/clipboard"
+	"github.com/nmeilick/go-ui/errorview"
+	"github.com/nmeilick/go-ui/internal/answer"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/styles"
 )
 
 var (
@@ -22,25 +28,41 @@ var (
 
 type errMsg error
 
+var _ ui.Prompt[string] = (*Model)(nil)
+
 // Model is the model handling user textarea.
 type Model struct {
-	textInput  textarea.Model // textInput is the text textarea model.
-	help       help.Model     // help is the help model for displaying key bindings.
-	keymap     keymap         // keymap is for managing key bindings.
-	cancelable bool           // cancelable determines if selection can be canceled with escape key
-	quitable   bool           // quitable determines if execution can be quit via ctrl+c
+	textInput      textarea.Model       // textInput is the text textarea model.
+	help           help.Model           // help is the help model for displaying key bindings.
+	styles         Styles               // styles holds the styles of the model.
+	customStyles   bool                 // customStyles is set if styles were set explicitly, which a theme switch keeps
+	keymap         keymap               // keymap is for managing key bindings.
+	err            error                // err is shown below the model, e.g. why the previous answer was rejected
+	errview        errorview.Model      // errview renders the error.
+	validator      ui.Validator[string] // validator validates the value before it is accepted
+	cancelable     bool                 // cancelable determines if selection can be canceled with escape key
+	quitable       bool                 // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption  // programOptions are passed to the program running the model
+	id             string               // id identifies the prompt, e.g. for preset answers
+	embedded       bool                 // embedded determines if a DoneMsg is emitted instead of quitting the program
+	showHelp       bool                 // showHelp determines if the help footer is shown
+	prompt         string               // prompt is the prompt, which may span multiple lines
+	header         string               // header is the part of the prompt shown above the textarea
+	width          int                  // width is the width of the terminal, 0 if unknown
+	height         int                  // height is the height of the terminal, 0 if unknown
 
 	canceled bool // canceled indicates whether the selection was canceled
 	quit     bool // quit indicates whether the selection was quit
 }
 
-type keymap struct{}
+// keymap holds the key bindings of the model.
+type keymap struct {
+	ui.KeyMap
+}
 
 // ShortHelp returns a list of key bindings for short help.
 func (k keymap) ShortHelp() []key.Binding {
-	return []key.Binding{
-		key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "quit")),
-	}
+	return []key.Binding{k.Cancel}
 }
 
 // FullHelp returns a list of key bindings for full help.
@@ -48,10 +70,10 @@ func (k keymap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{k.ShortHelp()}
 }
 
-// New creates and returns a new Model with default settings.
-func New(prompt, value string) *Model {
+// New creates and returns a new Model with default settings, configured by the given options.
+func New(prompt, value string, opts ...Option) *Model {
 	ti := textarea.New()
-	ti.Prompt = prompt
+	ti.KeyMap = textKeyMap()
 	ti.SetValue(value)
 	//ti.FocusedStyle = defaultTextareaStyle
 	//ti.BlurredStyle = defaultTextareaStyle
@@ -61,75 +83,171 @@ func New(prompt, value string) *Model {
 	ti.MaxHeight = 10
 	ti.ShowLineNumbers = true
 	h := help.New()
-	km := keymap{}
+	km := keymap{ui.DefaultKeyMap()}
 
-	return &Model{
+	m := &Model{
 		textInput:  ti,
 		help:       h,
 		keymap:     km,
+		errview:    *errorview.New(nil),
 		cancelable: true,
 		quitable:   true,
+		showHelp:   ui.HelpShown(),
+		prompt:     prompt,
 
 		canceled: false,
 		quit:     false,
 	}
+	m.setStyles(DefaultStyles())
+	m.layout()
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
 }
 
 // WithPrompt sets the prompt for the text textarea model and returns a new Model with the updated prompt.
 func (m *Model) WithPrompt(s string) *Model {
-	newModel := *m
-	newModel.textInput.Prompt = s
-	return &newModel
+	return m.With(Prompt(s))
 }
 
 // WithPlaceholder sets the placeholder for the text textarea model and returns a new Model with the updated placeholder.
 func (m *Model) WithPlaceholder(s string) *Model {
-	newModel := *m
-	newModel.textInput.Placeholder = s
-	return &newModel
+	return m.With(Placeholder(s))
 }
 
 // WithCharLimit sets the maximum allowed number of textarea characters and returns a new Model with the updated
 // character limit.
 func (m *Model) WithCharLimit(n int) *Model {
-	newModel := *m
-	newModel.textInput.CharLimit = n
-	return &newModel
+	return m.With(CharLimit(n))
 }
 
 // WithMaxWidth sets the width of the text textarea model and returns a new Model with the updated width.
 func (m *Model) WithMaxWidth(n int) *Model {
-	newModel := *m
-	newModel.textInput.MaxWidth = n
-	return &newModel
+	return m.With(MaxWidth(n))
 }
 
 // WithMaxHeight sets the height of the text textarea model and returns a new Model with the updated height.
 func (m *Model) WithMaxHeight(n int) *Model {
-	newModel := *m
-	newModel.textInput.MaxHeight = n
-	return &newModel
+	return m.With(MaxHeight(n))
 }
 
 // WithCancel sets the cancelable flag and returns a new Model with the updated flag.
 func (m *Model) WithCancel(cancelable bool) *Model {
-	newModel := *m
-	newModel.cancelable = cancelable
-	return &newModel
+	return m.With(Cancel(cancelable))
 }
 
 // WithQuit sets the quitable flag and returns a new Model with the updated flag.
 func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
 	newModel := *m
-	newModel.quitable = quitable
+	newModel.embedded = embedded
 	return &newModel
 }
 
+// WithShowHelp sets whether the help footer is shown, overriding the package-wide setting of ui.ShowHelp, and
+// returns a new Model with the updated flag.
+func (m *Model) WithShowHelp(show bool) *Model {
+	return m.With(ShowHelp(show))
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithValidator sets the validator the value must pass before it is accepted and returns a new Model with the updated
+// validator.
+func (m *Model) WithValidator(v ui.Validator[string]) *Model {
+	return m.With(Validator(v))
+}
+
 // Value returns the current textarea.
 func (m *Model) Value() string {
 	return m.textInput.Value()
 }
 
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.prompt
+}
+
+// SetAnswer applies a preset answer, which is the text. It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	s := answer.String(v)
+	if err := m.validate(s); err != nil {
+		return err
+	}
+	m.textInput.SetValue(s)
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the entered text. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	return m.Value()
+}
+
+// Focus focuses the model, so that it handles key messages, and returns the command starting the cursor blink.
+func (m *Model) Focus() tea.Cmd {
+	return m.textInput.Focus()
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.textInput.Blur()
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.textInput.Focused()
+}
+
+// validate validates the value, if a validator is set.
+func (m *Model) validate(v string) error {
+	if m.validator == nil {
+		return nil
+	}
+	return m.validator.Validate(v)
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
 // Canceled returns the canceled flag.
 func (m *Model) Canceled() bool {
 	return m.canceled
@@ -140,43 +258,136 @@ func (m *Model) Quit() bool {
 	return m.quit
 }
 
+// Run runs the model and returns the entered text. It implements ui.Prompt[string].
+func (m *Model) Run(ctx context.Context) (string, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return "", err
+	}
+	return m.Value(), nil
+}
+
+// RunAccessible asks for lines of text instead of using the terminal UI. If no text is entered, the current value is
+// kept. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	if v := m.textInput.Value(); v != "" {
+		p.Println("Current text:")
+		p.Println(v)
+	}
+	for {
+		s, err := p.Lines(m.prompt)
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+		if s == "" {
+			s = m.textInput.Value()
+		}
+		if err := m.validate(s); err != nil {
+			ui.RecordValidationFailure(m.Key(), err)
+			p.Println("Error:", err)
+			continue
+		}
+		m.textInput.SetValue(s)
+		break
+	}
+	m.canceled, m.quit = false, false
+	return nil
+}
+
 // Init initializes the Model.
 func (m *Model) Init() tea.Cmd {
 	return textarea.Blink
 }
 
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
 // Update handles user textarea and updates the textarea state by processing key messages and updating the text textarea model
-// accordingly.
+// accordingly. Window size messages fit the textarea into the terminal, up to its maximum width and height.
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 	var cmd tea.Cmd
 
 	switch msg := msg.(type) {
+	case ui.ThemeMsg:
+		m.restyle(msg.Theme)
+		return m, nil
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.help.Width = msg.Width
+		m.errview.Update(msg)
+		m.layout()
+		return m, nil
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "enter":
+		if !m.Focused() || m.errview.Toggle(msg, m.err) {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, m.keymap.Confirm):
 			lines := strings.Split(m.textInput.Value(), "\n")
 			for i := range lines {
 				lines[i] = strings.TrimSpace(lines[i])
 			}
 			m.textInput.SetValue(strings.Join(lines, "\n"))
 			if len(lines) > 0 && lines[len(lines)-1] == "" {
+				if m.err = m.validate(m.Value()); m.err != nil {
+					ui.RecordValidationFailure(m.Key(), m.err)
+					return m, nil
+				}
 				m.canceled, m.quit = false, false
-				return m, tea.Quit
+				return m, m.done()
 			}
-		case "esc":
-			if m.textInput.Focused() {
-				m.textInput.Blur()
+		case key.Matches(msg, m.keymap.Cancel):
+			if m.cancelable {
+				if m.textInput.Focused() {
+					m.textInput.Blur()
+				}
+				m.canceled, m.quit = true, false
+				return m, m.done()
 			}
-			m.canceled, m.quit = true, false
-			return m, tea.Quit
-		case "ctrl+c":
-			m.canceled, m.quit = true, true
-			return m, tea.Quit
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, m.done()
+			}
+		case key.Matches(msg, m.keymap.Copy):
+			return m, clipboard.Copy(m.Value())
+		case key.Matches(msg, m.keymap.Paste):
+			return m, clipboard.Paste()
+		}
+	case clipboard.CopiedMsg:
+		if m.Focused() && msg.Err != nil {
+			m.err = msg.Err
+		}
+		return m, nil
+	case clipboard.PastedMsg:
+		if !m.Focused() {
+			return m, nil
+		}
+		if msg.Err != nil {
+			m.err = msg.Err
+		} else {
+			m.textInput.InsertString(strings.ReplaceAll(msg.Text, "\r\n", "\n"))
 		}
+		return m, nil
 	// We handle errors just like any other message
 	case errMsg:
-		//m.err = msg
+		m.err = msg
 		return m, nil
 	}
 
@@ -206,13 +417,66 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	*/
 }
 
+// layout splits the prompt into the header shown above the textarea and the last line shown in front of each line,
+// and fits the textarea into the terminal, up to its maximum width and height, leaving room for the header, an error
+// and the help. If the last line of the prompt takes more than half of the terminal, it is moved to the header.
+func (m *Model) layout() {
+	m.header, m.textInput.Prompt = "", m.prompt
+	if i := strings.LastIndex(m.prompt, "\n"); i >= 0 {
+		m.header, m.textInput.Prompt = m.prompt[:i], m.prompt[i+1:]
+	}
+	if m.width <= 0 {
+		if m.textInput.MaxWidth > 0 {
+			m.textInput.SetWidth(m.textInput.MaxWidth)
+		}
+		return
+	}
+	if styles.Width(m.textInput.Prompt) > m.width/2 {
+		m.header = strings.TrimPrefix(m.header+"\n"+strings.TrimRight(m.textInput.Prompt, " "), "\n")
+		m.textInput.Prompt = ""
+	}
+	reserved := 1
+	if m.showHelp {
+		reserved++
+	}
+	if m.header != "" {
+		reserved += strings.Count(styles.Wrap(m.header, m.width), "\n") + 1
+	}
+	m.textInput.SetWidth(m.width)
+	m.textInput.SetHeight(max(1, m.height-reserved))
+}
+
 // View renders the textarea widget as a string, displaying the prompt, text textarea, and help view for key bindings.
 func (m *Model) View() string {
-	return fmt.Sprintf(
-		"%s\n%s",
-		m.textInput.View(),
-		m.help.View(m.keymap),
-	)
+	view := m.textInput.View()
+	if m.header != "" {
+		style := m.styles.Blurred.Prompt
+		if m.textInput.Focused() {
+			style = m.styles.Focused.Prompt
+		}
+		view = style.Render(styles.Wrap(m.header, m.width)) + "\n" + view
+	}
+	if m.err != nil {
+		view += "\n" + m.errview.RenderWith(m.styles.Error, m.err)
+	}
+	if m.showHelp {
+		view += "\n" + m.help.View(m.keymap)
+	}
+	return view
+}
+
+// Ask asks for multi-line text using the given prompt and initial value and returns the entered text or an error. The
+// options are passed to the program running the model.
+// Use errors.Is(ui.CanceledError) or errors.Is(ui.QuitError) to determine if the input was canceled or aborting of
+// the program was requested.
+func Ask(prompt, value string, opts ...tea.ProgramOption) (string, error) {
+	return AskContext(context.Background(), prompt, value, opts...)
+}
+
+// AskContext is like Ask, but aborts the input when the context is done. ui.TimeoutError is returned if its deadline
+// expired.
+func AskContext(ctx context.Context, prompt, value string, opts ...tea.ProgramOption) (string, error) {
+	return New(prompt, value).WithProgramOptions(opts...).Run(ctx)
 }
 
 // Showcase demonstrates all features of the Model component by creating an textarea model with autocomplete
@@ -223,16 +487,21 @@ func Showcase() {
 	fmt.Println("=== Model Showcase ===")
 
 	fmt.Println("\nDefault Style Input (Type to see suggestions, Enter to select):")
-	err := ui.Run(m)
-	switch {
-	case errors.Is(err, ui.QuitError):
-		fmt.Println("Quit")
-		os.Exit(0)
-	case errors.Is(err, ui.CanceledError):
-		fmt.Println("Canceled")
-	case err != nil:
-		fmt.Printf("Error running program: %v", err)
-	default:
+	if err := ui.Handle(ui.Run(m), ui.HandleOptions{}); err == nil {
 		fmt.Printf("Final textarea: %s\n", m.textInput.Value())
 	}
 }
+
+// textKeyMap returns the key map of the text area using the editing keys of the default text key map.
+func textKeyMap() textarea.KeyMap {
+	km, text := textarea.DefaultKeyMap, ui.DefaultTextKeyMap()
+	km.CharacterForward, km.CharacterBackward = text.CharacterForward, text.CharacterBackward
+	km.WordForward, km.WordBackward = text.WordForward, text.WordBackward
+	km.DeleteWordForward, km.DeleteWordBackward = text.DeleteWordForward, text.DeleteWordBackward
+	km.DeleteAfterCursor, km.DeleteBeforeCursor = text.DeleteAfterCursor, text.DeleteBeforeCursor
+	km.DeleteCharacterForward = text.DeleteCharacterForward
+	km.DeleteCharacterBackward = text.DeleteCharacterBackward
+	km.LineStart, km.LineEnd = text.LineStart, text.LineEnd
+	km.Paste = text.Paste
+	return km
+}
@@ -0,0 +1,200 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/charmbracelet/bubbles/help"  // Provides help view for key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+)
+
+// Theme holds the colors the default styles of all components are built from. Colors with an empty value for a
+// background render without color.
+type Theme struct {
+	Name    string                 // Name identifies the theme, e.g. for ThemeByName.
+	Accent  lipgloss.AdaptiveColor // Accent is used for cursors, spinners and other highlights.
+	Label   lipgloss.AdaptiveColor // Label is used for labels, titles and warnings.
+	Text    lipgloss.AdaptiveColor // Text is used for regular text such as items and values.
+	Success lipgloss.AdaptiveColor // Success is used for selected items and successful operations.
+	Failure lipgloss.AdaptiveColor // Failure is used for errors and failed operations.
+	Glyphs  *GlyphSet              // Glyphs replaces UnicodeGlyphs if not nil; limited terminals keep ASCIIGlyphs.
+	Styles  *ThemeStyles           // Styles replaces the styles prompts derive from the colors if not nil.
+}
+
+// ThemeStyles holds the styles prompts share, so that an application can style pick, input, textarea and list
+// consistently instead of configuring each of them. Styles set explicitly on a component take precedence. textarea and
+// list keep the look of their bubbles counterparts unless the theme sets its Styles.
+type ThemeStyles struct {
+	Label    lipgloss.Style // Label is the style of labels and titles.
+	Selected lipgloss.Style // Selected is the style of the selected item.
+	Normal   lipgloss.Style // Normal is the style of the items that are not selected.
+	Prompt   lipgloss.Style // Prompt is the style of the prompt in front of text fields.
+	Cursor   lipgloss.Style // Cursor is the style of the text cursor.
+	Help     help.Styles    // Help holds the styles of the help footer.
+}
+
+// PromptStyles returns the styles of the theme: its Styles if set, or styles derived from its colors otherwise.
+func (t Theme) PromptStyles() ThemeStyles {
+	if t.Styles != nil {
+		return *t.Styles
+	}
+	return ThemeStyles{
+		Label:    lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Selected: lipgloss.NewStyle().Foreground(t.Success),
+		Normal:   lipgloss.NewStyle().Foreground(t.Text),
+		Prompt:   lipgloss.NewStyle().Foreground(t.Accent),
+		Cursor:   lipgloss.NewStyle().Foreground(t.Accent),
+		Help:     help.New().Styles,
+	}
+}
+
+// ErrorStyle returns the style of errors in the theme.
+func (t Theme) ErrorStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(t.Failure)
+}
+
+var (
+	// ThemeDefault is the theme used unless another one is set, with colors for light and dark backgrounds.
+	ThemeDefault = Theme{
+		Name:    "default",
+		Accent:  lipgloss.AdaptiveColor{Light: "57", Dark: "63"},
+		Label:   lipgloss.AdaptiveColor{Light: "#B8860B", Dark: "#FFD700"},
+		Text:    lipgloss.AdaptiveColor{Light: "#1A1A1A", Dark: "#FFFFFF"},
+		Success: lipgloss.AdaptiveColor{Light: "#008700", Dark: "#00FF00"},
+		Failure: lipgloss.AdaptiveColor{Light: "#D03000", Dark: "#FF4500"},
+	}
+
+	// ThemeDracula uses the Dracula palette, which is designed for dark backgrounds.
+	ThemeDracula = Theme{
+		Name:    "dracula",
+		Accent:  lipgloss.AdaptiveColor{Light: "#BD93F9", Dark: "#BD93F9"},
+		Label:   lipgloss.AdaptiveColor{Light: "#FFB86C", Dark: "#F1FA8C"},
+		Text:    lipgloss.AdaptiveColor{Light: "#282A36", Dark: "#F8F8F2"},
+		Success: lipgloss.AdaptiveColor{Light: "#50FA7B", Dark: "#50FA7B"},
+		Failure: lipgloss.AdaptiveColor{Light: "#FF5555", Dark: "#FF5555"},
+	}
+
+	// ThemeNord uses the Nord palette, with the polar night colors as text on light backgrounds.
+	ThemeNord = Theme{
+		Name:    "nord",
+		Accent:  lipgloss.AdaptiveColor{Light: "#5E81AC", Dark: "#88C0D0"},
+		Label:   lipgloss.AdaptiveColor{Light: "#D08770", Dark: "#EBCB8B"},
+		Text:    lipgloss.AdaptiveColor{Light: "#2E3440", Dark: "#ECEFF4"},
+		Success: lipgloss.AdaptiveColor{Light: "#A3BE8C", Dark: "#A3BE8C"},
+		Failure: lipgloss.AdaptiveColor{Light: "#BF616A", Dark: "#BF616A"},
+	}
+
+	// ThemeSolarizedLight uses the Solarized palette with the content tones for light backgrounds.
+	ThemeSolarizedLight = Theme{
+		Name:    "solarized-light",
+		Accent:  lipgloss.AdaptiveColor{Light: "#268BD2", Dark: "#268BD2"},
+		Label:   lipgloss.AdaptiveColor{Light: "#B58900", Dark: "#B58900"},
+		Text:    lipgloss.AdaptiveColor{Light: "#586E75", Dark: "#586E75"},
+		Success: lipgloss.AdaptiveColor{Light: "#859900", Dark: "#859900"},
+		Failure: lipgloss.AdaptiveColor{Light: "#DC322F", Dark: "#DC322F"},
+	}
+
+	// ThemeSolarizedDark uses the Solarized palette with the content tones for dark backgrounds.
+	ThemeSolarizedDark = Theme{
+		Name:    "solarized-dark",
+		Accent:  lipgloss.AdaptiveColor{Light: "#268BD2", Dark: "#268BD2"},
+		Label:   lipgloss.AdaptiveColor{Light: "#B58900", Dark: "#B58900"},
+		Text:    lipgloss.AdaptiveColor{Light: "#93A1A1", Dark: "#93A1A1"},
+		Success: lipgloss.AdaptiveColor{Light: "#859900", Dark: "#859900"},
+		Failure: lipgloss.AdaptiveColor{Light: "#DC322F", Dark: "#DC322F"},
+	}
+
+	// ThemeMonochrome uses no colors at all; components are distinguished by bold, faint and reverse text and by
+	// glyphs only.
+	ThemeMonochrome = Theme{
+		Name: "monochrome",
+	}
+)
+
+// Themes returns the built-in themes.
+func Themes() []Theme {
+	return []Theme{ThemeDefault, ThemeDracula, ThemeNord, ThemeSolarizedLight, ThemeSolarizedDark, ThemeMonochrome}
+}
+
+// ThemeByName returns the built-in theme with the given name, ignoring case, and whether it exists.
+func ThemeByName(name string) (Theme, bool) {
+	for _, t := range Themes() {
+		if strings.EqualFold(t.Name, name) {
+			return t, true
+		}
+	}
+	return Theme{}, false
+}
+
+// theme holds the theme set last by SetTheme or ApplyTheme, or nil for ThemeDefault. It is read by prompts while the
+// theme may be switched from another goroutine, e.g. by WatchTheme.
+var theme atomic.Pointer[Theme]
+
+// CurrentTheme returns the theme set last, which the default styles of all components are built from. It is safe for
+// concurrent use.
+func CurrentTheme() Theme {
+	if t := theme.Load(); t != nil {
+		return *t
+	}
+	return ThemeDefault
+}
+
+// SetTheme sets the theme the default styles of all components, and the style of RenderError, are built from, e.g.
+// ui.SetTheme(ui.ThemeDracula), including its glyphs, if any. Like the default key map, it applies to components
+// created afterwards; ApplyTheme also restyles running ones. SetTheme also sets the default colors like AccentColor,
+// which are not synchronized, so it must not be called while prompts run; use ApplyTheme then.
+func SetTheme(t Theme) {
+	theme.Store(&t)
+	AccentColor, LabelColor, TextColor = t.Accent, t.Label, t.Text
+	SuccessColor, FailureColor = t.Success, t.Failure
+}
+
+// SetDefaultTheme is like SetTheme, named like the other package defaults, e.g. SetDefaultKeyMap.
+func SetDefaultTheme(t Theme) {
+	SetTheme(t)
+}
+
+// WithTheme styles the prompts run with the options using the given theme instead of the current one, see SetTheme.
+// Only the styles are applied, derived from the colors of the theme unless it sets them; the glyphs stay those of the
+// current theme.
+func WithTheme(t Theme) tea.ProgramOption {
+	return option(func(s *settings) {
+		s.theme = &t
+	})
+}
+
+// applyTheme styles the model using the theme set by WithTheme, if any, by passing it a ThemeMsg.
+func applyTheme(m tea.Model, s *settings) {
+	if s.theme != nil {
+		m.Update(ThemeMsg{Theme: *s.theme})
+	}
+}
+
+// ThemeMsg is sent to all running programs when the theme is switched using SwitchTheme or ApplyTheme, and to prompts
+// run with WithTheme before they are shown. All components using their default styles rebuild them from the theme;
+// styles set explicitly are kept. Containers like form, wizard, layout and Sequence pass it on to all their children.
+// Custom models can handle it the same way.
+type ThemeMsg struct {
+	Theme Theme // Theme is the new theme.
+}
+
+// SwitchTheme switches to the built-in theme with the given name, ignoring case, like ApplyTheme.
+func SwitchTheme(name string) error {
+	t, ok := ThemeByName(name)
+	if !ok {
+		return fmt.Errorf("unknown theme %q", name)
+	}
+	ApplyTheme(t)
+	return nil
+}
+
+// ApplyTheme sets the theme returned by CurrentTheme and sends a ThemeMsg to all running programs, so that it takes
+// effect immediately instead of only for components created afterwards, e.g. to flip between light and dark themes in
+// a long-running application. In contrast to SetTheme, it is safe to call while prompts run, from any goroutine; the
+// default colors like AccentColor are left unchanged.
+func ApplyTheme(t Theme) {
+	theme.Store(&t)
+	broadcast(ThemeMsg{Theme: t})
+}
@@ -0,0 +1,293 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/BurntSushi/toml"
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"gopkg.in/yaml.v3"                  // Parses YAML theme files
+)
+
+// LoadTheme reads a theme from the given TOML, JSON or YAML file, depending on its extension. Files with other
+// extensions are read as TOML. A theme file looks like this:
+//
+//	name = "custom"
+//	base = "nord"       # built-in theme providing everything not set in the file, "default" if empty
+//	border = "double"   # normal, rounded, thick, double, hidden, block or ascii
+//
+//	[colors]
+//	accent = "#88C0D0"                             # the same color on all backgrounds
+//	label = { light = "#D08770", dark = "#EBCB8B" } # different colors for light and dark backgrounds
+//
+//	[glyphs]
+//	selected_left = "→"
+//	checked = "[x]"
+//
+// Colors are hex values (#RGB or #RRGGBB), ANSI color numbers (0-255) or empty for no color; the keys are accent,
+// label, text, success and failure. Glyphs are named after the fields of GlyphSet in snake case. Unknown keys and
+// invalid values are reported as errors, so that typos do not go unnoticed. The result can be passed to SetTheme.
+func LoadTheme(path string) (Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Theme{}, err
+	}
+	raw := make(map[string]any)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(data, &raw)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &raw)
+	default:
+		err = toml.Unmarshal(data, &raw)
+	}
+	if err != nil {
+		return Theme{}, fmt.Errorf("%s: %w", path, err)
+	}
+	t, err := parseTheme(raw)
+	if err != nil {
+		return Theme{}, fmt.Errorf("%s: %w", path, err)
+	}
+	if t.Name == "" {
+		t.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return t, nil
+}
+
+// UserThemeFile returns the path of the theme file of the given application in the user's config directory, e.g.
+// ~/.config/<app>/theme.toml on Linux.
+func UserThemeFile(app string) (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, app, "theme.toml"), nil
+}
+
+// borders maps the border names accepted in theme files to the borders.
+var borders = map[string]lipgloss.Border{
+	"normal":  lipgloss.NormalBorder(),
+	"rounded": lipgloss.RoundedBorder(),
+	"thick":   lipgloss.ThickBorder(),
+	"double":  lipgloss.DoubleBorder(),
+	"hidden":  lipgloss.HiddenBorder(),
+	"block":   lipgloss.BlockBorder(),
+	"ascii":   ASCIIGlyphs.Border,
+}
+
+// parseTheme builds a theme from the decoded contents of a theme file, collecting all problems found.
+func parseTheme(raw map[string]any) (Theme, error) {
+	var errs []error
+	t := ThemeDefault
+	if v, ok := raw["base"]; ok {
+		name, ok := v.(string)
+		switch base, found := ThemeByName(name); {
+		case !ok:
+			errs = append(errs, fmt.Errorf("base: expected a string, got %v", v))
+		case !found:
+			errs = append(errs, fmt.Errorf("base: unknown theme %q", name))
+		default:
+			t = base
+		}
+	}
+	t.Name = ""
+	if v, ok := raw["name"]; ok {
+		if name, ok := v.(string); ok {
+			t.Name = name
+		} else {
+			errs = append(errs, fmt.Errorf("name: expected a string, got %v", v))
+		}
+	}
+
+	if v, ok := raw["colors"]; ok {
+		errs = append(errs, parseColors(&t, v)...)
+	}
+
+	var glyphs GlyphSet
+	if t.Glyphs != nil {
+		glyphs = *t.Glyphs
+	} else {
+		glyphs = UnicodeGlyphs
+	}
+	customGlyphs := false
+	if v, ok := raw["glyphs"]; ok {
+		errs = append(errs, parseGlyphs(&glyphs, v)...)
+		customGlyphs = true
+	}
+	if v, ok := raw["border"]; ok {
+		name, _ := v.(string)
+		if b, found := borders[strings.ToLower(name)]; found {
+			glyphs.Border = b
+			customGlyphs = true
+		} else {
+			errs = append(errs, fmt.Errorf("border: unknown border %v, expected one of %s", v,
+				strings.Join(sortedKeys(borders), ", ")))
+		}
+	}
+	if customGlyphs {
+		t.Glyphs = &glyphs
+	}
+
+	for _, k := range sortedKeys(raw) {
+		switch k {
+		case "name", "base", "colors", "glyphs", "border":
+		default:
+			errs = append(errs, fmt.Errorf("unknown key %q", k))
+		}
+	}
+	return t, errors.Join(errs...)
+}
+
+// parseColors sets the colors of the theme from the colors section of a theme file.
+func parseColors(t *Theme, v any) []error {
+	section, ok := v.(map[string]any)
+	if !ok {
+		return []error{fmt.Errorf("colors: expected a table, got %v", v)}
+	}
+	colors := map[string]*lipgloss.AdaptiveColor{
+		"accent":  &t.Accent,
+		"label":   &t.Label,
+		"text":    &t.Text,
+		"success": &t.Success,
+		"failure": &t.Failure,
+	}
+	var errs []error
+	for _, k := range sortedKeys(section) {
+		v := section[k]
+		c, found := colors[k]
+		if !found {
+			errs = append(errs, fmt.Errorf("colors: unknown color %q", k))
+			continue
+		}
+		var err error
+		switch v := v.(type) {
+		case string:
+			if err = validateColor(v); err == nil {
+				*c = lipgloss.AdaptiveColor{Light: v, Dark: v}
+			}
+		case map[string]any:
+			var ac lipgloss.AdaptiveColor
+			for bg, v := range v {
+				s, ok := v.(string)
+				switch {
+				case bg != "light" && bg != "dark":
+					err = fmt.Errorf("unknown background %q, expected light or dark", bg)
+				case !ok:
+					err = fmt.Errorf("%s: expected a string, got %v", bg, v)
+				default:
+					err = validateColor(s)
+				}
+				if err != nil {
+					break
+				}
+				if bg == "light" {
+					ac.Light = s
+				} else {
+					ac.Dark = s
+				}
+			}
+			if err == nil {
+				// A missing background keeps the color of the base theme.
+				if ac.Light == "" {
+					ac.Light = c.Light
+				}
+				if ac.Dark == "" {
+					ac.Dark = c.Dark
+				}
+				*c = ac
+			}
+		default:
+			err = fmt.Errorf("expected a string or a table with light and dark, got %v", v)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("colors.%s: %w", k, err))
+		}
+	}
+	return errs
+}
+
+// hexColor matches the hex colors accepted by lipgloss.
+var hexColor = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// validateColor returns an error if s is neither empty, a hex color nor an ANSI color number.
+func validateColor(s string) error {
+	if s == "" || hexColor.MatchString(s) {
+		return nil
+	}
+	if n, err := strconv.Atoi(s); err == nil && n >= 0 && n <= 255 {
+		return nil
+	}
+	return fmt.Errorf("invalid color %q, expected #RGB, #RRGGBB or 0-255", s)
+}
+
+// parseGlyphs sets the glyphs from the glyphs section of a theme file.
+func parseGlyphs(g *GlyphSet, v any) []error {
+	section, ok := v.(map[string]any)
+	if !ok {
+		return []error{fmt.Errorf("glyphs: expected a table, got %v", v)}
+	}
+	fields := glyphFields(g)
+	var errs []error
+	for _, k := range sortedKeys(section) {
+		v := section[k]
+		f, found := fields[k]
+		s, ok := v.(string)
+		switch {
+		case !found:
+			errs = append(errs, fmt.Errorf("glyphs: unknown glyph %q", k))
+		case !ok:
+			errs = append(errs, fmt.Errorf("glyphs.%s: expected a string, got %v", k, v))
+		case s == "":
+			errs = append(errs, fmt.Errorf("glyphs.%s: must not be empty", k))
+		default:
+			f.SetString(s)
+		}
+	}
+	return errs
+}
+
+// glyphFields returns the string fields of the glyph set keyed by their names in snake case.
+func glyphFields(g *GlyphSet) map[string]reflect.Value {
+	fields := make(map[string]reflect.Value)
+	v := reflect.ValueOf(g).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Field(i); f.Kind() == reflect.String {
+			fields[snakeCase(v.Type().Field(i).Name)] = f
+		}
+	}
+	return fields
+}
+
+// snakeCase converts a Go identifier like SelectedLeft to selected_left.
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// sortedKeys returns the keys of the map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
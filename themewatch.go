@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"time"
+)
+
+// themeWatchInterval is the interval WatchTheme checks the theme file for changes in.
+const themeWatchInterval = time.Second
+
+// WatchTheme applies the theme in the given file using ApplyTheme when called and whenever the file changes, until the
+// context is done, so that users can edit the theme of a running application. The file is read by LoadTheme; errors,
+// e.g. from a file that is being edited, are passed to onError, which may be nil, and keep the current theme. A
+// missing file is not an error. WatchTheme returns right away and watches in the background.
+func WatchTheme(ctx context.Context, path string, onError func(error)) {
+	var last os.FileInfo
+	check := func() {
+		fi, err := os.Stat(path)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			last = nil
+			return
+		case err != nil:
+			if onError != nil {
+				onError(err)
+			}
+			return
+		case last != nil && fi.ModTime().Equal(last.ModTime()) && fi.Size() == last.Size():
+			return
+		}
+		last = fi
+		t, err := LoadTheme(path)
+		if err != nil {
+			if onError != nil {
+				onError(err)
+			}
+			return
+		}
+		ApplyTheme(t)
+	}
+	check()
+	go func() {
+		ticker := time.NewTicker(themeWatchInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				check()
+			}
+		}
+	}()
+}
@@ -0,0 +1,122 @@
+package timepicker
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	return &newModel
+}
+
+// Label sets the label of the picker.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Time sets the initially selected time of day to the clock of the given time.
+func Time(t time.Time) Option {
+	return func(m *Model) {
+		m.hour, m.minute, m.second = t.Clock()
+	}
+}
+
+// Clock sets the initially selected hour (0-23), minute and second. Values out of range are wrapped around.
+func Clock(hour, minute, second int) Option {
+	return func(m *Model) {
+		m.hour, m.minute, m.second = wrap(hour, 24), wrap(minute, 60), wrap(second, 60)
+	}
+}
+
+// Seconds sets whether the second segment is shown. If it is hidden, the selected second is always 0.
+func Seconds(show bool) Option {
+	return func(m *Model) {
+		m.seconds = show
+		if !show && m.segment == secondSegment {
+			m.segment = minuteSegment
+		}
+	}
+}
+
+// TwelveHour sets whether the hour is shown in 12-hour format with an AM/PM segment.
+func TwelveHour(twelveHour bool) Option {
+	return func(m *Model) {
+		m.twelveHour = twelveHour
+		if !twelveHour && m.segment == periodSegment {
+			m.segment = hourSegment
+		}
+	}
+}
+
+// MinuteStep sets the amount by which the minute is incremented and decremented. Values below 1 are ignored.
+func MinuteStep(step int) Option {
+	return func(m *Model) {
+		if step >= 1 {
+			m.minuteStep = step
+		}
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
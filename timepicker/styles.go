@@ -0,0 +1,38 @@
+package timepicker
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Label           lipgloss.Style // Label is the style of the label.
+	Segment         lipgloss.Style // Segment is the style of the unfocused segments.
+	SelectedSegment lipgloss.Style // SelectedSegment is the style of the focused segment.
+	Separator       lipgloss.Style // Separator is the style of the colons between the segments.
+	Error           lipgloss.Style // Error is the style of the error shown below the picker.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:           lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Segment:         lipgloss.NewStyle().Foreground(t.Text),
+		SelectedSegment: lipgloss.NewStyle().Foreground(t.Accent).Reverse(true),
+		Separator:       lipgloss.NewStyle().Foreground(t.Text),
+		Error:           t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
@@ -0,0 +1,579 @@
+package timepicker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/plain"
+)
+
+var _ ui.Prompt[time.Time] = (*Model)(nil)
+
+var (
+	incKey       = key.NewBinding(key.WithKeys("up", "k", "+"), key.WithHelp("↑/k", "increment"))
+	decKey       = key.NewBinding(key.WithKeys("down", "j", "-"), key.WithHelp("↓/j", "decrement"))
+	prevSegKey   = key.NewBinding(key.WithKeys("left", "h", "shift+tab"), key.WithHelp("←/h", "previous segment"))
+	nextSegKey   = key.NewBinding(key.WithKeys("right", "l", "tab", ":"), key.WithHelp("→/l", "next segment"))
+	amKey        = key.NewBinding(key.WithKeys("a", "A"), key.WithHelp("a", "AM"))
+	pmKey        = key.NewBinding(key.WithKeys("p", "P"), key.WithHelp("p", "PM"))
+	backspaceKey = key.NewBinding(key.WithKeys("backspace"), key.WithHelp("backspace", "clear input"))
+)
+
+// segment identifies a part of the time.
+type segment int
+
+const (
+	hourSegment segment = iota
+	minuteSegment
+	secondSegment
+	periodSegment
+)
+
+// Model represents a time-of-day picker with hour, minute and second segments.
+type Model struct {
+	label          string              // label is the label for the picker.
+	hour           int                 // hour is the selected hour, 0-23.
+	minute         int                 // minute is the selected minute, 0-59.
+	second         int                 // second is the selected second, 0-59.
+	seconds        bool                // seconds determines if the second segment is shown.
+	twelveHour     bool                // twelveHour determines if the hour is shown in 12-hour format with AM/PM.
+	minuteStep     int                 // minuteStep is the amount by which the minute is incremented.
+	segment        segment             // segment is the focused segment.
+	digits         string              // digits holds the digits typed into the focused segment.
+	cancelable     bool                // cancelable determines if selection can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	id             string              // id identifies the prompt, e.g. for preset answers
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                // focused determines if the model handles key messages
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error               // err is shown below the model, e.g. why the previous answer was rejected
+
+	canceled bool // canceled indicates whether the selection was canceled
+	quit     bool // quit indicates whether the selection was quit
+}
+
+// New creates and returns a new Model with the given label, configured by the given options. The current time is
+// selected initially.
+func New(label string, opts ...Option) *Model {
+	now := time.Now()
+	m := &Model{
+		label:      label,
+		hour:       now.Hour(),
+		minute:     now.Minute(),
+		second:     now.Second(),
+		minuteStep: 1,
+		cancelable: true,
+		quitable:   true,
+		focused:    true,
+		keymap:     ui.DefaultKeyMap(),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithTime sets the initially selected time of day and returns a new Model with the updated time.
+func (m *Model) WithTime(t time.Time) *Model {
+	return m.With(Time(t))
+}
+
+// WithClock sets the initially selected hour, minute and second and returns a new Model with the updated time.
+func (m *Model) WithClock(hour, minute, second int) *Model {
+	return m.With(Clock(hour, minute, second))
+}
+
+// WithSeconds sets whether the second segment is shown and returns a new Model with the updated flag.
+func (m *Model) WithSeconds(show bool) *Model {
+	return m.With(Seconds(show))
+}
+
+// WithTwelveHour sets whether the hour is shown in 12-hour format with AM/PM and returns a new Model with the updated
+// flag.
+func (m *Model) WithTwelveHour(twelveHour bool) *Model {
+	return m.With(TwelveHour(twelveHour))
+}
+
+// WithMinuteStep sets the amount by which the minute is incremented and returns a new Model with the updated step.
+func (m *Model) WithMinuteStep(step int) *Model {
+	return m.With(MinuteStep(step))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Clock returns the selected hour (0-23), minute and second. The second is 0 if the second segment is hidden.
+func (m *Model) Clock() (hour, minute, second int) {
+	if !m.seconds {
+		return m.hour, m.minute, 0
+	}
+	return m.hour, m.minute, m.second
+}
+
+// On returns the selected time of day on the date of the given time, in its location.
+func (m *Model) On(day time.Time) time.Time {
+	hour, minute, second := m.Clock()
+	y, mo, d := day.Date()
+	return time.Date(y, mo, d, hour, minute, second, 0, day.Location())
+}
+
+// Value returns the selected time of day on the current date in the local time zone.
+func (m *Model) Value() time.Time {
+	return m.On(time.Now())
+}
+
+// String returns the selected time of day formatted like it is shown, e.g. "14:30" or "2:30:00 PM".
+func (m *Model) String() string {
+	hour, minute, second := m.Clock()
+	return format(hour, minute, second, m.seconds, m.twelveHour)
+}
+
+// format formats a time of day.
+func format(hour, minute, second int, seconds, twelveHour bool) string {
+	var s string
+	if twelveHour {
+		h := hour % 12
+		if h == 0 {
+			h = 12
+		}
+		s = fmt.Sprintf("%d:%02d", h, minute)
+	} else {
+		s = fmt.Sprintf("%02d:%02d", hour, minute)
+	}
+	if seconds {
+		s += fmt.Sprintf(":%02d", second)
+	}
+	if twelveHour {
+		if hour < 12 {
+			s += " AM"
+		} else {
+			s += " PM"
+		}
+	}
+	return s
+}
+
+// Parse parses a time of day like "14:30", "14:30:15", "2:30 PM" or "2:30:15pm" and returns its hour (0-23), minute
+// and second.
+func Parse(s string) (hour, minute, second int, err error) {
+	text := strings.ToLower(strings.TrimSpace(s))
+	period := ""
+	for _, p := range []string{"am", "pm", "a", "p"} {
+		if strings.HasSuffix(text, p) {
+			period, text = p[:1], strings.TrimSpace(strings.TrimSuffix(text, p))
+			break
+		}
+	}
+
+	parts := strings.Split(text, ":")
+	if len(parts) < 1 || len(parts) > 3 {
+		return 0, 0, 0, fmt.Errorf("invalid time: %s", s)
+	}
+	values := make([]int, 3)
+	for i, part := range parts {
+		v, err := strconv.Atoi(part)
+		if err != nil || v < 0 {
+			return 0, 0, 0, fmt.Errorf("invalid time: %s", s)
+		}
+		values[i] = v
+	}
+	hour, minute, second = values[0], values[1], values[2]
+
+	switch {
+	case period != "" && (hour < 1 || hour > 12):
+		return 0, 0, 0, fmt.Errorf("invalid hour: %s", s)
+	case period == "a" && hour == 12:
+		hour = 0
+	case period == "p" && hour < 12:
+		hour += 12
+	}
+	if hour > 23 || minute > 59 || second > 59 {
+		return 0, 0, 0, fmt.Errorf("invalid time: %s", s)
+	}
+	return hour, minute, second, nil
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is a time.Time or a string accepted by Parse. It implements
+// ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	switch v := v.(type) {
+	case time.Time:
+		m.hour, m.minute, m.second = v.Clock()
+	case string:
+		hour, minute, second, err := Parse(v)
+		if err != nil {
+			return err
+		}
+		m.hour, m.minute, m.second = hour, minute, second
+	default:
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the selected time of day as string, e.g. "14:30". It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	return m.String()
+}
+
+// Focus focuses the model, so that it handles key messages. It returns no command and exists for compatibility with
+// other focusable models.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+	m.digits = ""
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns a nil command.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// segments returns the visible segments.
+func (m *Model) segments() []segment {
+	segs := []segment{hourSegment, minuteSegment}
+	if m.seconds {
+		segs = append(segs, secondSegment)
+	}
+	if m.twelveHour {
+		segs = append(segs, periodSegment)
+	}
+	return segs
+}
+
+// move focuses the segment at the given offset from the focused one, staying within the visible segments.
+func (m *Model) move(offset int) {
+	segs := m.segments()
+	pos := 0
+	for i, s := range segs {
+		if s == m.segment {
+			pos = i
+		}
+	}
+	pos = min(max(pos+offset, 0), len(segs)-1)
+	m.segment, m.digits = segs[pos], ""
+}
+
+// add adds delta to the focused segment, wrapping around at its bounds.
+func (m *Model) add(delta int) {
+	m.digits = ""
+	switch m.segment {
+	case hourSegment:
+		m.hour = wrap(m.hour+delta, 24)
+	case minuteSegment:
+		m.minute = wrap(m.minute+delta*m.minuteStep, 60)
+	case secondSegment:
+		m.second = wrap(m.second+delta, 60)
+	case periodSegment:
+		m.hour = wrap(m.hour+12, 24)
+	}
+}
+
+// wrap returns v modulo n in the range 0 to n-1.
+func wrap(v, n int) int {
+	return ((v % n) + n) % n
+}
+
+// typeDigit enters a digit into the focused segment. The segment is complete after two digits, or after one digit
+// that cannot start a valid two-digit value, and the next segment is focused.
+func (m *Model) typeDigit(d rune) {
+	if m.segment == periodSegment {
+		return
+	}
+	m.digits += string(d)
+	v, _ := strconv.Atoi(m.digits)
+
+	limit := 59
+	if m.segment == hourSegment {
+		limit = 23
+		if m.twelveHour {
+			limit = 12
+		}
+	}
+	if v > limit {
+		m.digits = string(d)
+		v = int(d - '0')
+	}
+
+	switch m.segment {
+	case hourSegment:
+		if m.twelveHour {
+			pm := m.hour >= 12
+			m.hour = v % 12
+			if pm {
+				m.hour += 12
+			}
+		} else {
+			m.hour = v
+		}
+	case minuteSegment:
+		m.minute = v
+	case secondSegment:
+		m.second = v
+	}
+
+	if len(m.digits) == 2 || v*10 > limit {
+		m.move(1)
+	}
+}
+
+// UsesKey reports whether the picker handles the key itself, like shift+tab moving to the previous segment. It
+// implements ui.KeyModel.
+func (m *Model) UsesKey(msg tea.KeyMsg) bool {
+	return key.Matches(msg, nextSegKey, prevSegKey)
+}
+
+// Update handles key messages, moving between segments, changing values and entering digits.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !m.focused {
+		return m, nil
+	}
+
+	switch {
+	case keyMsg.Type == tea.KeyRunes && len(keyMsg.Runes) == 1 && keyMsg.Runes[0] >= '0' && keyMsg.Runes[0] <= '9':
+		m.typeDigit(keyMsg.Runes[0])
+	case key.Matches(keyMsg, incKey):
+		m.add(1)
+	case key.Matches(keyMsg, decKey):
+		m.add(-1)
+	case key.Matches(keyMsg, prevSegKey):
+		m.move(-1)
+	case key.Matches(keyMsg, nextSegKey):
+		m.move(1)
+	case key.Matches(keyMsg, backspaceKey):
+		m.digits = ""
+	case m.twelveHour && key.Matches(keyMsg, amKey):
+		if m.hour >= 12 {
+			m.hour -= 12
+		}
+	case m.twelveHour && key.Matches(keyMsg, pmKey):
+		if m.hour < 12 {
+			m.hour += 12
+		}
+	case key.Matches(keyMsg, m.keymap.Confirm):
+		m.canceled, m.quit = false, false
+		return m, m.done()
+	case key.Matches(keyMsg, m.keymap.Cancel):
+		if m.cancelable {
+			m.canceled, m.quit = true, false
+			return m, m.done()
+		}
+	case key.Matches(keyMsg, m.keymap.Quit):
+		if m.quitable {
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m, m.done()
+		}
+	}
+	return m, nil
+}
+
+// View renders the label and the segments, highlighting the focused one.
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.label != "" {
+		fmt.Fprintf(&b, "%s ", m.styles.Label.Render(m.label))
+	}
+
+	for i, seg := range m.segments() {
+		var text string
+		switch seg {
+		case hourSegment:
+			h := m.hour
+			if m.twelveHour {
+				h %= 12
+				if h == 0 {
+					h = 12
+				}
+			}
+			text = fmt.Sprintf("%02d", h)
+		case minuteSegment:
+			text = fmt.Sprintf("%02d", m.minute)
+		case secondSegment:
+			text = fmt.Sprintf("%02d", m.second)
+		case periodSegment:
+			text = "AM"
+			if m.hour >= 12 {
+				text = "PM"
+			}
+		}
+
+		switch {
+		case seg == periodSegment:
+			b.WriteString(" ")
+		case i > 0:
+			b.WriteString(m.styles.Separator.Render(":"))
+		}
+		if seg == m.segment && m.focused {
+			b.WriteString(m.styles.SelectedSegment.Render(text))
+		} else {
+			b.WriteString(m.styles.Segment.Render(text))
+		}
+	}
+	if m.err != nil {
+		fmt.Fprintf(&b, "\n%s", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return b.String()
+}
+
+// Run runs the model and returns the selected time of day on the current date. It implements ui.Prompt[time.Time].
+func (m *Model) Run(ctx context.Context) (time.Time, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return time.Time{}, err
+	}
+	return m.Value(), nil
+}
+
+// RunAccessible asks for the time as a line of text instead of using the terminal UI. It implements
+// ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	for {
+		s, err := p.Line(m.label+" ", m.String())
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+		if err := m.SetAnswer(s); err != nil {
+			p.Println(fmt.Sprintf("Error: %v", err))
+			continue
+		}
+		return nil
+	}
+}
+
+// Showcase demonstrates the Model component in 24-hour and 12-hour mode.
+func Showcase() {
+	fmt.Println("=== Time Picker Showcase ===")
+
+	fmt.Println("\n24-hour mode (arrows change and move between segments, digits are entered directly):")
+	m := New("Start time:").WithClock(9, 0, 0).WithMinuteStep(15)
+	if t, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Selected: %s\n", t.Format("15:04"))
+	}
+
+	fmt.Println("\n12-hour mode with seconds (a/p switches between AM and PM):")
+	m = New("Alarm:").WithTwelveHour(true).WithSeconds(true)
+	if t, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Selected: %s\n", t.Format("3:04:05 PM"))
+	}
+}
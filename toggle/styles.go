@@ -0,0 +1,38 @@
+package toggle
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Label    lipgloss.Style // Label is the style of the label.
+	On       lipgloss.Style // On is the style of the state and switch when the toggle is on.
+	Off      lipgloss.Style // Off is the style of the state and switch when the toggle is off.
+	Brackets lipgloss.Style // Brackets is the style of the brackets around the state of the focused toggle.
+	Error    lipgloss.Style // Error is the style of the error shown below the toggle.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:    lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		On:       lipgloss.NewStyle().Foreground(t.Success).Bold(true),
+		Off:      lipgloss.NewStyle().Foreground(t.Text).Faint(true),
+		Brackets: lipgloss.NewStyle().Foreground(t.Accent),
+		Error:    t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
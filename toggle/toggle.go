@@ -0,0 +1,373 @@
+package toggle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/plain"
+)
+
+var _ ui.Prompt[bool] = (*Model)(nil)
+
+var (
+	toggleKey = key.NewBinding(key.WithKeys(" ", "left", "right", "h", "l"), key.WithHelp("space", "toggle"))
+	onKey     = key.NewBinding(key.WithKeys("y", "Y", "1"), key.WithHelp("y", "on"))
+	offKey    = key.NewBinding(key.WithKeys("n", "N", "0"), key.WithHelp("n", "off"))
+)
+
+// Model represents an on/off toggle. It is a lightweight alternative to a two-item pick for boolean settings.
+type Model struct {
+	label          string              // label is the label for the toggle.
+	value          bool                // value is the state of the toggle.
+	onText         string              // onText is shown when the toggle is on.
+	offText        string              // offText is shown when the toggle is off.
+	switchGlyph    bool                // switchGlyph determines if a switch glyph is shown instead of the brackets.
+	cancelable     bool                // cancelable determines if selection can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	id             string              // id identifies the prompt, e.g. for preset answers
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                // focused determines if the model handles key messages
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error               // err is shown below the model, e.g. why the previous answer was rejected
+
+	canceled bool // canceled indicates whether the selection was canceled
+	quit     bool // quit indicates whether the selection was quit
+}
+
+// New creates and returns a new Model with the given label, configured by the given options. The toggle is off
+// initially.
+func New(label string, opts ...Option) *Model {
+	m := &Model{
+		label:      label,
+		onText:     "ON",
+		offText:    "OFF",
+		cancelable: true,
+		quitable:   true,
+		focused:    true,
+		keymap:     ui.DefaultKeyMap(),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithDefault sets the initial state and returns a new Model with the updated state.
+func (m *Model) WithDefault(on bool) *Model {
+	return m.With(Default(on))
+}
+
+// WithOn sets the text shown when the toggle is on and returns a new Model with the updated text.
+func (m *Model) WithOn(text string) *Model {
+	return m.With(On(text))
+}
+
+// WithOff sets the text shown when the toggle is off and returns a new Model with the updated text.
+func (m *Model) WithOff(text string) *Model {
+	return m.With(Off(text))
+}
+
+// WithSwitch sets whether a switch glyph is shown instead of the brackets and returns a new Model with the updated
+// flag.
+func (m *Model) WithSwitch(show bool) *Model {
+	return m.With(Switch(show))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Value returns whether the toggle is on.
+func (m *Model) Value() bool {
+	return m.value
+}
+
+// SetValue sets the state of the toggle.
+func (m *Model) SetValue(on bool) {
+	m.value = on
+}
+
+// Toggle switches the state of the toggle.
+func (m *Model) Toggle() {
+	m.value = !m.value
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is a bool or a string like "on", "off", "yes" or "false". It implements
+// ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	switch v := v.(type) {
+	case bool:
+		m.value = v
+	case string:
+		on, ok := m.parse(v)
+		if !ok {
+			return fmt.Errorf("invalid answer: %v", v)
+		}
+		m.value = on
+	default:
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// parse returns the state described by s and whether s describes a state.
+func (m *Model) parse(s string) (on, ok bool) {
+	s = strings.TrimSpace(s)
+	for _, t := range []string{m.onText, "on", "y", "yes", "true", "1"} {
+		if strings.EqualFold(s, t) {
+			return true, true
+		}
+	}
+	for _, t := range []string{m.offText, "off", "n", "no", "false", "0"} {
+		if strings.EqualFold(s, t) {
+			return false, true
+		}
+	}
+	return false, false
+}
+
+// Answer returns the state of the toggle. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	return m.value
+}
+
+// Choices returns the texts of both states. It implements ui.ChoiceModel.
+func (m *Model) Choices() []string {
+	return []string{m.onText, m.offText}
+}
+
+// Focus focuses the model, so that it handles key messages. It returns no command and exists for compatibility with
+// other focusable models.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns a nil command.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// Update handles key messages, switching the state and confirming it.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !m.focused {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, toggleKey):
+		m.Toggle()
+	case key.Matches(keyMsg, onKey):
+		m.value = true
+	case key.Matches(keyMsg, offKey):
+		m.value = false
+	case key.Matches(keyMsg, m.keymap.Confirm):
+		m.canceled, m.quit = false, false
+		return m, m.done()
+	case key.Matches(keyMsg, m.keymap.Cancel):
+		if m.cancelable {
+			m.canceled, m.quit = true, false
+			return m, m.done()
+		}
+	case key.Matches(keyMsg, m.keymap.Quit):
+		if m.quitable {
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m, m.done()
+		}
+	}
+	return m, nil
+}
+
+// View renders the label and the state, either like "‹ ON ›" or as a switch glyph followed by the state.
+func (m *Model) View() string {
+	var b strings.Builder
+	g := ui.Glyphs()
+	if m.label != "" {
+		fmt.Fprintf(&b, "%s ", m.styles.Label.Render(m.label))
+	}
+
+	style, text, glyph := m.styles.Off, m.offText, g.SwitchOff
+	if m.value {
+		style, text, glyph = m.styles.On, m.onText, g.SwitchOn
+	}
+	switch {
+	case m.switchGlyph:
+		fmt.Fprintf(&b, "%s %s", style.Render(glyph), style.Render(text))
+	case m.focused:
+		b.WriteString(m.styles.Brackets.Render(g.ToggleLeft+" ") + style.Render(text) +
+			m.styles.Brackets.Render(" "+g.ToggleRight))
+	default:
+		b.WriteString("  " + style.Render(text))
+	}
+	if m.err != nil {
+		fmt.Fprintf(&b, "\n%s", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return b.String()
+}
+
+// Run runs the model and returns whether the toggle is on. It implements ui.Prompt[bool].
+func (m *Model) Run(ctx context.Context) (bool, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return false, err
+	}
+	return m.value, nil
+}
+
+// RunAccessible asks for the state as a line of text instead of using the terminal UI. It implements
+// ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	def := m.offText
+	if m.value {
+		def = m.onText
+	}
+	for {
+		s, err := p.Line(fmt.Sprintf("%s (%s/%s) ", m.label, m.onText, m.offText), def)
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+		on, ok := m.parse(s)
+		if !ok {
+			p.Println(fmt.Sprintf("Error: answer %s or %s", m.onText, m.offText))
+			continue
+		}
+		m.value = on
+		m.canceled, m.quit = false, false
+		return nil
+	}
+}
+
+// Showcase demonstrates the Model component with brackets and with a switch glyph.
+func Showcase() {
+	fmt.Println("=== Toggle Showcase ===")
+
+	fmt.Println("\nToggle with brackets (space or arrow keys toggle):")
+	m := New("Notifications:").WithDefault(true)
+	if on, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Notifications: %t\n", on)
+	}
+
+	fmt.Println("\nSwitch with custom texts:")
+	m = New("Mode:").WithSwitch(true).WithOn("dark").WithOff("light")
+	if on, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Dark mode: %t\n", on)
+	}
+}
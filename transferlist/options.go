@@ -0,0 +1,128 @@
+package transferlist
+
+import (
+	"maps"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nmeilick/go-ui"
+)
+
+// Option configures a Model. Options are an alternative to the With* methods: they can be passed to New or applied
+// to an existing model using With, which copies the model only once for any number of options.
+type Option func(*Model)
+
+// With applies the given options to a copy of the model and returns the copy.
+func (m *Model) With(opts ...Option) *Model {
+	newModel := *m
+	newModel.selected = maps.Clone(m.selected)
+	for _, opt := range opts {
+		opt(&newModel)
+	}
+	newModel.clamp(Available)
+	newModel.clamp(Selected)
+	return &newModel
+}
+
+// Label sets the label shown above the panes.
+func Label(label string) Option {
+	return func(m *Model) {
+		m.label = label
+	}
+}
+
+// Titles sets the titles of the available and the selected pane.
+func Titles(available, selected string) Option {
+	return func(m *Model) {
+		m.titles = [2]string{available, selected}
+	}
+}
+
+// SelectedItems selects the items with the given labels. Labels of unknown items are ignored.
+func SelectedItems(labels ...string) Option {
+	return func(m *Model) {
+		for _, label := range labels {
+			if i := m.index(label); i >= 0 {
+				m.selected[i] = true
+			}
+		}
+	}
+}
+
+// Min sets the minimum number of selected items.
+func Min(n int) Option {
+	return func(m *Model) {
+		m.min = n
+	}
+}
+
+// Max sets the maximum number of selected items, 0 for no limit.
+func Max(n int) Option {
+	return func(m *Model) {
+		m.max = n
+	}
+}
+
+// Width sets the width of each pane.
+func Width(width int) Option {
+	return func(m *Model) {
+		m.paneWidth = max(width, 8)
+	}
+}
+
+// Height sets the number of items shown in each pane.
+func Height(height int) Option {
+	return func(m *Model) {
+		m.height = max(height, 1)
+	}
+}
+
+// Cancel sets the cancelable flag.
+func Cancel(cancelable bool) Option {
+	return func(m *Model) {
+		m.cancelable = cancelable
+	}
+}
+
+// Quit sets the quitable flag.
+func Quit(quitable bool) Option {
+	return func(m *Model) {
+		m.quitable = quitable
+	}
+}
+
+// ProgramOptions sets the options passed to the program running the model.
+func ProgramOptions(opts ...tea.ProgramOption) Option {
+	return func(m *Model) {
+		m.programOptions = opts
+	}
+}
+
+// ID sets the ID identifying the prompt, e.g. for preset answers. If no ID is set, the label is used instead.
+func ID(id string) Option {
+	return func(m *Model) {
+		m.id = id
+	}
+}
+
+// Embedded embeds the model in another model. In embedded mode, a DoneMsg is emitted instead of quitting the program
+// when the user finished the model.
+func Embedded() Option {
+	return func(m *Model) {
+		m.embedded = true
+	}
+}
+
+// KeyMap sets the key bindings of the model, overriding the default key map.
+func KeyMap(km ui.KeyMap) Option {
+	return func(m *Model) {
+		m.keymap = km
+	}
+}
+
+// Styled sets all styles of the model.
+func Styled(styles Styles) Option {
+	return func(m *Model) {
+		m.customStyles = true
+		m.styles = styles
+	}
+}
@@ -0,0 +1,50 @@
+package transferlist
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Label          lipgloss.Style // Label is the style of the label above the panes.
+	Title          lipgloss.Style // Title is the style of the title of the inactive pane.
+	ActiveTitle    lipgloss.Style // ActiveTitle is the style of the title of the active pane.
+	Item           lipgloss.Style // Item is the style of the items.
+	SelectedItem   lipgloss.Style // SelectedItem is the style of the item under the cursor of the active pane.
+	InactiveCursor lipgloss.Style // InactiveCursor is the style of the item under the cursor of the inactive pane.
+	Cursor         lipgloss.Style // Cursor is the style of the cursor left of the item.
+	Divider        lipgloss.Style // Divider is the style of the line between the panes.
+	Prompt         lipgloss.Style // Prompt is the style of the prompt and cursor of the filter being entered.
+	Hint           lipgloss.Style // Hint is the style of the key hints, the filters and the number of selected items.
+	Error          lipgloss.Style // Error is the style of the error shown below the panes.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:          lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Title:          lipgloss.NewStyle().Foreground(t.Text).Bold(true),
+		ActiveTitle:    lipgloss.NewStyle().Foreground(t.Accent).Bold(true).Underline(true),
+		Item:           lipgloss.NewStyle().Foreground(t.Text),
+		SelectedItem:   lipgloss.NewStyle().Foreground(t.Success),
+		InactiveCursor: lipgloss.NewStyle().Foreground(t.Text).Bold(true),
+		Cursor:         lipgloss.NewStyle().Foreground(t.Accent),
+		Divider:        lipgloss.NewStyle().Faint(true),
+		Prompt:         lipgloss.NewStyle().Foreground(t.Accent),
+		Hint:           lipgloss.NewStyle().Faint(true),
+		Error:          t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
@@ -0,0 +1,656 @@
+package transferlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/lipgloss"      // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/styles"
+)
+
+var _ ui.Prompt[[]string] = (*Model)(nil)
+
+var (
+	moveKey    = key.NewBinding(key.WithKeys(" ", "enter"), key.WithHelp("space", "move"))
+	moveAllKey = key.NewBinding(key.WithKeys(">", "<"), key.WithHelp(">/<", "move all"))
+	doneKey    = key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "done"))
+	paneKey    = key.NewBinding(key.WithKeys("tab", "shift+tab"), key.WithHelp("tab", "switch pane"))
+	leftKey    = key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←", "available"))
+	rightKey   = key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→", "selected"))
+	filterKey  = key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter"))
+	homeKey    = key.NewBinding(key.WithKeys("home", "g"), key.WithHelp("home", "first"))
+	endKey     = key.NewBinding(key.WithKeys("end", "G"), key.WithHelp("end", "last"))
+)
+
+// Pane identifies one of the two panes.
+type Pane int
+
+const (
+	Available Pane = iota // Available is the pane listing the items that are not selected.
+	Selected              // Selected is the pane listing the selected items.
+)
+
+// pane holds the state of one pane.
+type pane struct {
+	filter string // filter restricts the pane to the items containing it, ignoring case.
+	cursor int    // cursor is the position of the highlighted item among the visible items.
+	offset int    // offset is the position of the first shown item among the visible items.
+}
+
+// Model represents two panes, the available and the selected items, between which items are moved. For large
+// assignments, it is easier to survey than a long list of checkboxes. Each pane can be filtered.
+type Model struct {
+	label          string              // label is shown above the panes.
+	titles         [2]string           // titles holds the titles of the panes.
+	items          []string            // items holds all items in their original order.
+	selected       map[int]bool        // selected holds the indexes of the selected items.
+	panes          [2]pane             // panes holds the state of the panes.
+	active         Pane                // active is the pane handling the keys.
+	filtering      bool                // filtering determines if the filter of the active pane is being entered.
+	min            int                 // min is the minimum number of selected items.
+	max            int                 // max is the maximum number of selected items, 0 if unlimited.
+	paneWidth      int                 // paneWidth is the width of each pane.
+	height         int                 // height is the number of items shown in each pane.
+	cancelable     bool                // cancelable determines if selection can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	id             string              // id identifies the prompt, e.g. for preset answers
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                // focused determines if the model handles key messages
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error               // err is shown below the model, e.g. why the previous answer was rejected
+
+	canceled bool // canceled indicates whether the selection was canceled
+	quit     bool // quit indicates whether the selection was quit
+}
+
+// New creates and returns a new Model with the given label and items, configured by the given options. By default,
+// no item is selected.
+func New(label string, items []string, opts ...Option) *Model {
+	m := &Model{
+		label:      label,
+		titles:     [2]string{"Available", "Selected"},
+		items:      items,
+		selected:   map[int]bool{},
+		paneWidth:  30,
+		height:     10,
+		cancelable: true,
+		quitable:   true,
+		focused:    true,
+		keymap:     ui.DefaultKeyMap(),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithTitles sets the titles of the available and the selected pane and returns a new Model with the updated titles.
+func (m *Model) WithTitles(available, selected string) *Model {
+	return m.With(Titles(available, selected))
+}
+
+// WithSelected selects the items with the given labels and returns a new Model with the updated selection.
+func (m *Model) WithSelected(labels ...string) *Model {
+	return m.With(SelectedItems(labels...))
+}
+
+// WithMin sets the minimum number of selected items and returns a new Model with the updated constraint.
+func (m *Model) WithMin(n int) *Model {
+	return m.With(Min(n))
+}
+
+// WithMax sets the maximum number of selected items and returns a new Model with the updated constraint.
+func (m *Model) WithMax(n int) *Model {
+	return m.With(Max(n))
+}
+
+// WithWidth sets the width of each pane and returns a new Model with the updated width.
+func (m *Model) WithWidth(width int) *Model {
+	return m.With(Width(width))
+}
+
+// WithHeight sets the number of items shown in each pane and returns a new Model with the updated height.
+func (m *Model) WithHeight(height int) *Model {
+	return m.With(Height(height))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Items returns all items.
+func (m *Model) Items() []string {
+	return m.items
+}
+
+// Selected returns the selected items in their original order.
+func (m *Model) Selected() []string {
+	selected := []string{}
+	for i, item := range m.items {
+		if m.selected[i] {
+			selected = append(selected, item)
+		}
+	}
+	return selected
+}
+
+// Active returns the pane handling the keys.
+func (m *Model) Active() Pane {
+	return m.active
+}
+
+// SetFilter sets the filter of the given pane, which restricts it to the items containing the filter, ignoring case.
+func (m *Model) SetFilter(p Pane, filter string) {
+	m.panes[p].filter = filter
+	m.clamp(p)
+}
+
+// visible returns the indexes of the items shown in the given pane, in their original order.
+func (m *Model) visible(p Pane) []int {
+	filter := strings.ToLower(m.panes[p].filter)
+	var indexes []int
+	for i, item := range m.items {
+		if m.selected[i] == (p == Selected) && strings.Contains(strings.ToLower(item), filter) {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// clamp keeps the cursor of the given pane within its visible items and scrolls it into view.
+func (m *Model) clamp(p Pane) {
+	n := len(m.visible(p))
+	ps := &m.panes[p]
+	ps.cursor = max(0, min(ps.cursor, n-1))
+	if ps.cursor < ps.offset {
+		ps.offset = ps.cursor
+	} else if ps.cursor >= ps.offset+m.height {
+		ps.offset = ps.cursor - m.height + 1
+	}
+	ps.offset = max(0, min(ps.offset, n-m.height))
+}
+
+// move moves the given items to the other pane. Selecting more items than the maximum is rejected.
+func (m *Model) move(indexes []int, selected bool) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+	if selected && m.max > 0 && len(m.Selected())+len(indexes) > m.max {
+		return fmt.Errorf("select at most %d", m.max)
+	}
+	for _, i := range indexes {
+		m.selected[i] = selected
+	}
+	m.clamp(Available)
+	m.clamp(Selected)
+	return nil
+}
+
+// validate returns an error if the number of selected items violates the constraints.
+func (m *Model) validate() error {
+	if n := len(m.Selected()); n < m.min || (m.max > 0 && n > m.max) {
+		return m.rangeError()
+	}
+	return nil
+}
+
+// rangeError returns an error describing the number of items to select.
+func (m *Model) rangeError() error {
+	switch {
+	case m.max > 0 && m.min == m.max:
+		return fmt.Errorf("select exactly %d", m.min)
+	case m.max > 0:
+		return fmt.Errorf("select between %d and %d", m.min, m.max)
+	}
+	return fmt.Errorf("select at least %d", m.min)
+}
+
+// index returns the index of the item with the given label, ignoring case, or -1 if there is none.
+func (m *Model) index(label string) int {
+	for i, item := range m.items {
+		if strings.EqualFold(item, strings.TrimSpace(label)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is a list of item labels as []string, []any or a comma-separated string.
+// It implements ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	var labels []string
+	switch v := v.(type) {
+	case []string:
+		labels = v
+	case []any:
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return fmt.Errorf("invalid answer: %v", v)
+			}
+			labels = append(labels, s)
+		}
+	case string:
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				labels = append(labels, s)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+
+	selected := map[int]bool{}
+	for _, label := range labels {
+		i := m.index(label)
+		if i < 0 {
+			return fmt.Errorf("invalid answer: %s", label)
+		}
+		selected[i] = true
+	}
+	if n := len(selected); n < m.min || (m.max > 0 && n > m.max) {
+		return m.rangeError()
+	}
+
+	m.selected = selected
+	m.clamp(Available)
+	m.clamp(Selected)
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the selected items as []string. It implements ui.AnswerableModel.
+func (m *Model) Answer() any {
+	return m.Selected()
+}
+
+// Choices returns all items. It implements ui.ChoiceModel.
+func (m *Model) Choices() []string {
+	return m.items
+}
+
+// Focus focuses the model, so that it handles key messages. It returns no command and exists for compatibility with
+// other focusable models.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns a nil command.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// updateFilter handles key messages while the filter of the active pane is entered. The pane is filtered as the
+// filter is typed.
+func (m *Model) updateFilter(msg tea.KeyMsg) {
+	ps := &m.panes[m.active]
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.filtering = false
+	case tea.KeyEsc:
+		m.filtering = false
+		ps.filter = ""
+	case tea.KeyBackspace:
+		if ps.filter == "" {
+			m.filtering = false
+		} else {
+			r := []rune(ps.filter)
+			ps.filter = string(r[:len(r)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		ps.filter += string(msg.Runes)
+	}
+	m.clamp(m.active)
+}
+
+// UsesKey reports whether the list handles the key itself, like tab and shift+tab switching the panes. It implements
+// ui.KeyModel.
+func (m *Model) UsesKey(msg tea.KeyMsg) bool {
+	return key.Matches(msg, paneKey)
+}
+
+// Update handles key messages, moving the cursor, switching and filtering the panes, moving items between them and
+// confirming the selection. Since enter moves items, the selection is confirmed with ctrl+s, or with the confirm key
+// of the key map if it is bound to another key.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !m.focused {
+		return m, nil
+	}
+	if m.filtering && !key.Matches(keyMsg, m.keymap.Quit) {
+		m.updateFilter(keyMsg)
+		return m, nil
+	}
+
+	ps := &m.panes[m.active]
+	visible := m.visible(m.active)
+	switch {
+	case key.Matches(keyMsg, doneKey):
+		return m, m.confirm()
+	case key.Matches(keyMsg, moveKey):
+		if len(visible) > 0 {
+			m.err = m.move(visible[ps.cursor:ps.cursor+1], m.active == Available)
+		}
+	case key.Matches(keyMsg, moveAllKey):
+		// ">" always moves to the selected pane and "<" back, independent of the active pane.
+		if keyMsg.String() == ">" {
+			m.err = m.move(m.visible(Available), true)
+		} else {
+			m.err = m.move(m.visible(Selected), false)
+		}
+	case key.Matches(keyMsg, paneKey):
+		m.active = 1 - m.active
+	case key.Matches(keyMsg, leftKey):
+		m.active = Available
+	case key.Matches(keyMsg, rightKey):
+		m.active = Selected
+	case key.Matches(keyMsg, filterKey):
+		m.filtering = true
+	case key.Matches(keyMsg, homeKey):
+		ps.cursor = 0
+		m.clamp(m.active)
+	case key.Matches(keyMsg, endKey):
+		ps.cursor = len(visible) - 1
+		m.clamp(m.active)
+	case key.Matches(keyMsg, m.keymap.Prev):
+		ps.cursor--
+		m.clamp(m.active)
+	case key.Matches(keyMsg, m.keymap.Next):
+		ps.cursor++
+		m.clamp(m.active)
+	case key.Matches(keyMsg, m.keymap.Confirm):
+		return m, m.confirm()
+	case key.Matches(keyMsg, m.keymap.Cancel):
+		if ps.filter != "" {
+			m.SetFilter(m.active, "")
+			return m, nil
+		}
+		if m.cancelable {
+			m.canceled, m.quit = true, false
+			return m, m.done()
+		}
+	case key.Matches(keyMsg, m.keymap.Quit):
+		if m.quitable {
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m, m.done()
+		}
+	}
+	return m, nil
+}
+
+// confirm finishes the model if the selection satisfies the constraints.
+func (m *Model) confirm() tea.Cmd {
+	if err := m.validate(); err != nil {
+		m.err = err
+		return nil
+	}
+	m.err = nil
+	m.canceled, m.quit = false, false
+	return m.done()
+}
+
+// paneView renders the given pane: its title, its shown items and its filter.
+func (m *Model) paneView(p Pane) string {
+	g := ui.Glyphs()
+	ps := m.panes[p]
+	visible := m.visible(p)
+	active := p == m.active && m.focused
+
+	total := len(m.Selected())
+	if p == Available {
+		total = len(m.items) - total
+	}
+	title := fmt.Sprintf("%s (%d)", m.titles[p], total)
+	if len(visible) != total {
+		title = fmt.Sprintf("%s (%d/%d)", m.titles[p], len(visible), total)
+	}
+	titleStyle := m.styles.Title
+	if active {
+		titleStyle = m.styles.ActiveTitle
+	}
+	lines := []string{titleStyle.Render(styles.Truncate(title, m.paneWidth))}
+
+	for row := 0; row < m.height; row++ {
+		i := ps.offset + row
+		if i >= len(visible) {
+			lines = append(lines, "")
+			continue
+		}
+		item := styles.Truncate(m.items[visible[i]], m.paneWidth-2)
+		switch {
+		case i == ps.cursor && active:
+			lines = append(lines, m.styles.Cursor.Render(g.SelectedLeft)+" "+m.styles.SelectedItem.Render(item))
+		case i == ps.cursor:
+			lines = append(lines, "  "+m.styles.InactiveCursor.Render(item))
+		default:
+			lines = append(lines, "  "+m.styles.Item.Render(item))
+		}
+	}
+
+	switch {
+	case m.filtering && p == m.active:
+		lines = append(lines, m.styles.Prompt.Render("/")+ps.filter+m.styles.Prompt.Render(g.Block))
+	case ps.filter != "":
+		lines = append(lines, m.styles.Hint.Render(styles.Truncate("filter: "+ps.filter, m.paneWidth)))
+	default:
+		lines = append(lines, "")
+	}
+	return lipgloss.NewStyle().Width(m.paneWidth).Render(strings.Join(lines, "\n"))
+}
+
+// View renders the label, the panes side by side, a hint line and the number of selected items if constrained.
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.label != "" {
+		fmt.Fprintf(&b, "%s\n", m.styles.Label.Render(m.label))
+	}
+
+	divider := strings.TrimSuffix(strings.Repeat(ui.Glyphs().Border.Left+"\n", m.height+2), "\n")
+	fmt.Fprintf(&b, "%s\n", lipgloss.JoinHorizontal(lipgloss.Top,
+		m.paneView(Available), " ", m.styles.Divider.Render(divider), " ", m.paneView(Selected)))
+
+	hint := "space move · >/< move all · tab switch · / filter · ctrl+s done"
+	if m.min > 0 || m.max > 0 {
+		status := fmt.Sprintf("%d selected", len(m.Selected()))
+		switch {
+		case m.max > 0 && m.min == m.max:
+			status += fmt.Sprintf(" (select %d)", m.max)
+		case m.max > 0:
+			status += fmt.Sprintf(" (select %d-%d)", m.min, m.max)
+		default:
+			status += fmt.Sprintf(" (select at least %d)", m.min)
+		}
+		hint = status + " · " + hint
+	}
+	fmt.Fprintf(&b, "%s\n", m.styles.Hint.Render(hint))
+	if m.err != nil {
+		fmt.Fprintf(&b, "%s\n", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// Run runs the model and returns the selected items. It implements ui.Prompt[[]string].
+func (m *Model) Run(ctx context.Context) ([]string, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return nil, err
+	}
+	return m.Selected(), nil
+}
+
+// RunAccessible lists the items and asks for the numbers or names of the items to select instead of using the
+// terminal UI. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	if m.label != "" {
+		p.Println(m.label)
+	}
+	var def []string
+	for i, item := range m.items {
+		p.Println(fmt.Sprintf("%3d) %s", i+1, item))
+		if m.selected[i] {
+			def = append(def, strconv.Itoa(i+1))
+		}
+	}
+
+	for {
+		s, err := p.Line("Select (numbers or names, separated by commas, - for none): ", strings.Join(def, ","))
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+
+		labels := []string{}
+		if strings.TrimSpace(s) != "-" {
+			for _, e := range strings.Split(s, ",") {
+				e = strings.TrimSpace(e)
+				if n, err := strconv.Atoi(e); err == nil && n >= 1 && n <= len(m.items) {
+					e = m.items[n-1]
+				}
+				if e != "" {
+					labels = append(labels, e)
+				}
+			}
+		}
+		if err := m.SetAnswer(labels); err != nil {
+			p.Println(fmt.Sprintf("Error: %v", err))
+			continue
+		}
+		return nil
+	}
+}
+
+// Showcase demonstrates the Model component by assigning users to a team.
+func Showcase() {
+	fmt.Println("=== Transfer List Showcase ===")
+
+	fmt.Println("\nAssign users to the team (space moves, / filters, ctrl+s confirms):")
+	var users []string
+	for _, first := range []string{"alice", "bob", "carol", "dave", "erin", "frank", "grace", "heidi"} {
+		for _, dept := range []string{"ops", "dev", "qa"} {
+			users = append(users, first+"."+dept)
+		}
+	}
+	m := New("Team members:", users).WithTitles("Users", "Team").WithSelected("alice.dev", "bob.ops").WithMin(1)
+	selected, err := m.Run(context.Background())
+	if ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Team: %v\n", selected)
+	}
+}
@@ -0,0 +1,23 @@
+package tree
+
+import (
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui/internal/benchview"
+)
+
+func BenchmarkView1000(b *testing.B) {
+	benchview.Run(b, func() tea.Model {
+		var roots []*Node
+		for i := 0; i < 100; i++ {
+			n := &Node{Label: fmt.Sprintf("node %d", i), Expanded: true}
+			for _, s := range benchview.Items(10) {
+				n.Children = append(n.Children, &Node{Label: s})
+			}
+			roots = append(roots, n)
+		}
+		return New("Tree", roots)
+	})
+}
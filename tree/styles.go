@@ -0,0 +1,46 @@
+package tree
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Label        lipgloss.Style // Label is the style of the label.
+	Branch       lipgloss.Style // Branch is the style of unselected nodes with children.
+	Leaf         lipgloss.Style // Leaf is the style of unselected nodes without children.
+	SelectedNode lipgloss.Style // SelectedNode is the style of the selected node.
+	Cursor       lipgloss.Style // Cursor is the style of the glyph marking the selected node.
+	Guide        lipgloss.Style // Guide is the style of the indentation guides.
+	Marker       lipgloss.Style // Marker is the style of the expanded and collapsed markers.
+	Status       lipgloss.Style // Status is the style of the loading indicator and the scroll position.
+	Error        lipgloss.Style // Error is the style of errors, e.g. when loading children failed.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Label:        lipgloss.NewStyle().Foreground(t.Label).Bold(true),
+		Branch:       lipgloss.NewStyle().Foreground(t.Text).Bold(true),
+		Leaf:         lipgloss.NewStyle().Foreground(t.Text),
+		SelectedNode: lipgloss.NewStyle().Foreground(t.Success),
+		Cursor:       lipgloss.NewStyle().Foreground(t.Accent),
+		Guide:        lipgloss.NewStyle().Faint(true),
+		Marker:       lipgloss.NewStyle().Foreground(t.Accent),
+		Status:       lipgloss.NewStyle().Faint(true),
+		Error:        t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
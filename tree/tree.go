@@ -0,0 +1,668 @@
+package tree
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/internal/plain"
+	"github.com/nmeilick/go-ui/styles"
+)
+
+var _ ui.Prompt[*Node] = (*Model)(nil)
+
+var (
+	expandKey   = key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "expand"))
+	collapseKey = key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "collapse"))
+	toggleKey   = key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "toggle"))
+	pageUpKey   = key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "page up"))
+	pageDownKey = key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdown", "page down"))
+)
+
+// Node is a node of the tree. Children are either given upfront or loaded lazily by Load when the node is expanded
+// for the first time.
+type Node struct {
+	Label    string                  // Label is shown for the node.
+	Value    any                     // Value is an arbitrary value associated with the node.
+	Children []*Node                 // Children holds the child nodes.
+	Load     func() ([]*Node, error) // Load loads the children on first expansion; nil if Children is complete
+	Expanded bool                    // Expanded indicates whether the children are shown.
+
+	parent  *Node // parent is the parent node, or nil for root nodes.
+	loading bool  // loading indicates whether the children are being loaded.
+	err     error // err is the error returned by Load.
+}
+
+// Parent returns the parent of the node, or nil for a root node.
+func (n *Node) Parent() *Node {
+	return n.parent
+}
+
+// Path returns the labels of the node and its ancestors, starting with the root.
+func (n *Node) Path() []string {
+	var path []string
+	for ; n != nil; n = n.parent {
+		path = append([]string{n.Label}, path...)
+	}
+	return path
+}
+
+// Branch returns whether the node has or may have children.
+func (n *Node) Branch() bool {
+	return len(n.Children) > 0 || n.Load != nil
+}
+
+// link sets the parent of the given children.
+func link(parent *Node, children []*Node) {
+	for _, c := range children {
+		c.parent = parent
+		link(c, c.Children)
+	}
+}
+
+// row is a visible node with the guides drawn before it.
+type row struct {
+	node   *Node
+	prefix string
+}
+
+// loadedMsg carries the children loaded for a node.
+type loadedMsg struct {
+	node     *Node
+	children []*Node
+	err      error
+}
+
+// Model represents a tree of nodes from which one node is selected.
+type Model struct {
+	label          string              // label is the label for the tree.
+	roots          []*Node             // roots holds the root nodes.
+	rows           []row               // rows holds the visible nodes in display order.
+	cursor         int                 // cursor is the position of the selected node in rows.
+	offset         int                 // offset is the position of the first visible row.
+	height         int                 // height is the number of visible rows.
+	selectable     func(*Node) bool    // selectable reports whether a node may be selected; nil allows all nodes
+	cancelable     bool                // cancelable determines if selection can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	id             string              // id identifies the prompt, e.g. for preset answers
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                // focused determines if the model handles key messages
+	keymap         ui.KeyMap           // keymap holds the key bindings of the model.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error               // err is shown below the model, e.g. why the previous answer was rejected
+
+	canceled bool // canceled indicates whether the selection was canceled
+	quit     bool // quit indicates whether the selection was quit
+}
+
+// New creates and returns a new Model showing the given root nodes, configured by the given options.
+func New(label string, roots []*Node, opts ...Option) *Model {
+	link(nil, roots)
+	m := &Model{
+		label:      label,
+		roots:      roots,
+		height:     15,
+		cancelable: true,
+		quitable:   true,
+		focused:    true,
+		keymap:     ui.DefaultKeyMap(),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.flatten()
+	return m
+}
+
+// flatten rebuilds the visible rows after nodes were expanded or collapsed. The selected node stays selected if it is
+// still visible.
+func (m *Model) flatten() {
+	selected := m.Selected()
+	m.rows = nil
+	g := ui.Glyphs()
+	var walk func(nodes []*Node, indent string)
+	walk = func(nodes []*Node, indent string) {
+		for i, n := range nodes {
+			prefix, childIndent := indent+g.TreeBranch, indent+g.TreeLine
+			if i == len(nodes)-1 {
+				prefix, childIndent = indent+g.TreeLast, indent+strings.Repeat(" ", styles.Width(g.TreeLast))
+			}
+			m.rows = append(m.rows, row{node: n, prefix: prefix})
+			if n.Expanded {
+				walk(n.Children, childIndent)
+			}
+		}
+	}
+	for _, root := range m.roots {
+		m.rows = append(m.rows, row{node: root})
+		if root.Expanded {
+			walk(root.Children, "")
+		}
+	}
+
+	m.cursor = min(m.cursor, max(len(m.rows)-1, 0))
+	for i, r := range m.rows {
+		if r.node == selected {
+			m.cursor = i
+		}
+	}
+	m.scroll()
+}
+
+// scroll adjusts the offset so that the cursor is visible.
+func (m *Model) scroll() {
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	} else if m.cursor >= m.offset+m.height {
+		m.offset = m.cursor - m.height + 1
+	}
+	m.offset = max(0, min(m.offset, len(m.rows)-m.height))
+}
+
+// WithLabel sets the label of the Model and returns a new Model with the updated label.
+func (m *Model) WithLabel(label string) *Model {
+	return m.With(Label(label))
+}
+
+// WithHeight sets the number of visible rows and returns a new Model with the updated height.
+func (m *Model) WithHeight(height int) *Model {
+	return m.With(Height(height))
+}
+
+// WithSelectable sets the function reporting whether a node may be selected, e.g. to only allow leaves, and returns
+// a new Model with the updated function.
+func (m *Model) WithSelectable(fn func(*Node) bool) *Model {
+	return m.With(Selectable(fn))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the label is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the model, overriding the default key map, and returns a new Model with the
+// updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// Selected returns the selected node, or nil if the tree is empty.
+func (m *Model) Selected() *Node {
+	if m.cursor < 0 || m.cursor >= len(m.rows) {
+		return nil
+	}
+	return m.rows[m.cursor].node
+}
+
+// Select selects the given node, expanding its ancestors. It returns false if the node is not part of the tree.
+func (m *Model) Select(n *Node) bool {
+	root := n
+	for root.parent != nil {
+		root = root.parent
+	}
+	found := false
+	for _, r := range m.roots {
+		found = found || r == root
+	}
+	if !found {
+		return false
+	}
+
+	for p := n.parent; p != nil; p = p.parent {
+		p.Expanded = true
+	}
+	m.flatten()
+	for i, r := range m.rows {
+		if r.node == n {
+			m.cursor = i
+		}
+	}
+	m.scroll()
+	return true
+}
+
+// Refresh rebuilds the visible nodes after nodes were changed, e.g. children were added or removed.
+func (m *Model) Refresh() {
+	link(nil, m.roots)
+	m.flatten()
+}
+
+// Key returns the ID of the prompt, or its label if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.label
+}
+
+// SetAnswer applies a preset answer, which is the path of the node with the labels separated by slashes, e.g.
+// "usr/local/bin". Nodes along the path are expanded, loading their children if needed. It implements
+// ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+	n, err := m.find(strings.Split(strings.Trim(s, "/"), "/"))
+	if err != nil {
+		return err
+	}
+	if m.selectable != nil && !m.selectable(n) {
+		return fmt.Errorf("node cannot be selected: %s", s)
+	}
+	m.Select(n)
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// find returns the node with the given path, loading children along the path if needed.
+func (m *Model) find(path []string) (*Node, error) {
+	nodes := m.roots
+	var n *Node
+	for i, label := range path {
+		if n != nil {
+			if err := load(n); err != nil {
+				return nil, err
+			}
+			nodes = n.Children
+		}
+		n = nil
+		for _, c := range nodes {
+			if c.Label == label {
+				n = c
+				break
+			}
+		}
+		if n == nil {
+			return nil, fmt.Errorf("no such node: %s", strings.Join(path[:i+1], "/"))
+		}
+	}
+	if n == nil {
+		return nil, errors.New("empty path")
+	}
+	return n, nil
+}
+
+// load loads the children of the node synchronously if they were not loaded yet.
+func load(n *Node) error {
+	if n.Load == nil {
+		return nil
+	}
+	children, err := n.Load()
+	if err != nil {
+		return err
+	}
+	n.Children, n.Load = children, nil
+	link(n, children)
+	return nil
+}
+
+// Answer returns the path of the selected node with the labels separated by slashes. It implements
+// ui.AnswerableModel.
+func (m *Model) Answer() any {
+	if n := m.Selected(); n != nil {
+		return strings.Join(n.Path(), "/")
+	}
+	return nil
+}
+
+// Focus focuses the model, so that it handles key messages. It returns no command and exists for compatibility with
+// other focusable models.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	return nil
+}
+
+// Blur removes the focus from the model, so that it ignores key messages.
+func (m *Model) Blur() {
+	m.focused = false
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// Init initializes the Model and returns a nil command.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// expand expands the node, returning the command loading its children if they were not loaded yet.
+func (m *Model) expand(n *Node) tea.Cmd {
+	if !n.Branch() || n.Expanded || n.loading {
+		return nil
+	}
+	if n.Load == nil {
+		n.Expanded = true
+		m.flatten()
+		return nil
+	}
+	n.loading, n.err = true, nil
+	fn := n.Load
+	return func() tea.Msg {
+		children, err := fn()
+		return loadedMsg{node: n, children: children, err: err}
+	}
+}
+
+// collapse collapses the node.
+func (m *Model) collapse(n *Node) {
+	n.Expanded = false
+	m.flatten()
+}
+
+// Update handles key messages, moving the selection and expanding or collapsing nodes, and loaded children.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case loadedMsg:
+		n := msg.node
+		n.loading = false
+		if msg.err != nil {
+			n.err = msg.err
+			return m, nil
+		}
+		n.Children, n.Load, n.Expanded = msg.children, nil, true
+		link(n, msg.children)
+		m.flatten()
+		return m, nil
+	case tea.KeyMsg:
+		if !m.focused {
+			return m, nil
+		}
+		n := m.Selected()
+		switch {
+		case key.Matches(msg, expandKey):
+			switch {
+			case n == nil:
+			case n.Expanded && len(n.Children) > 0:
+				m.cursor++
+			default:
+				return m, m.expand(n)
+			}
+		case key.Matches(msg, collapseKey):
+			switch {
+			case n == nil:
+			case n.Expanded:
+				m.collapse(n)
+			case n.parent != nil:
+				for i, r := range m.rows {
+					if r.node == n.parent {
+						m.cursor = i
+					}
+				}
+			}
+		case key.Matches(msg, toggleKey):
+			if n != nil && n.Expanded {
+				m.collapse(n)
+			} else if n != nil {
+				return m, m.expand(n)
+			}
+		case key.Matches(msg, m.keymap.Prev):
+			m.cursor = max(0, m.cursor-1)
+		case key.Matches(msg, m.keymap.Next):
+			m.cursor = min(len(m.rows)-1, m.cursor+1)
+		case key.Matches(msg, pageUpKey):
+			m.cursor = max(0, m.cursor-m.height)
+		case key.Matches(msg, pageDownKey):
+			m.cursor = max(0, min(len(m.rows)-1, m.cursor+m.height))
+		case key.Matches(msg, m.keymap.Confirm):
+			switch {
+			case n == nil:
+			case m.selectable == nil || m.selectable(n):
+				m.canceled, m.quit = false, false
+				return m, m.done()
+			case n.Expanded:
+				m.collapse(n)
+			default:
+				return m, m.expand(n)
+			}
+		case key.Matches(msg, m.keymap.Cancel):
+			if m.cancelable {
+				m.canceled, m.quit = true, false
+				return m, m.done()
+			}
+		case key.Matches(msg, m.keymap.Quit):
+			if m.quitable {
+				m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+				return m, m.done()
+			}
+		}
+		m.scroll()
+	}
+	return m, nil
+}
+
+// View renders the label and the visible nodes with their indentation guides.
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.label != "" {
+		fmt.Fprintf(&b, "%s\n", m.styles.Label.Render(m.label))
+	}
+
+	g := ui.Glyphs()
+	end := min(len(m.rows), m.offset+m.height)
+	for i := m.offset; i < end; i++ {
+		r := m.rows[i]
+		marker := " "
+		switch {
+		case r.node.Expanded:
+			marker = g.Expanded
+		case r.node.Branch():
+			marker = g.Collapsed
+		}
+
+		label := r.node.Label
+		switch {
+		case i == m.cursor:
+			label = m.styles.SelectedNode.Render(label)
+		case r.node.Branch():
+			label = m.styles.Branch.Render(label)
+		default:
+			label = m.styles.Leaf.Render(label)
+		}
+		cursor := "  "
+		if i == m.cursor {
+			cursor = m.styles.Cursor.Render(g.SelectedLeft) + " "
+		}
+		fmt.Fprintf(&b, "%s%s%s %s", cursor, m.styles.Guide.Render(r.prefix), m.styles.Marker.Render(marker), label)
+
+		switch {
+		case r.node.loading:
+			fmt.Fprintf(&b, " %s", m.styles.Status.Render("(loading"+g.Ellipsis+")"))
+		case r.node.err != nil:
+			fmt.Fprintf(&b, " %s", ui.RenderErrorWith(m.styles.Error, r.node.err))
+		}
+		b.WriteString("\n")
+	}
+	if len(m.rows) > m.height {
+		fmt.Fprintf(&b, "  %s\n", m.styles.Status.Render(fmt.Sprintf("%d-%d of %d", m.offset+1, end, len(m.rows))))
+	}
+	if m.err != nil {
+		fmt.Fprintf(&b, "%s\n", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// Run runs the model and returns the selected node. It implements ui.Prompt[*Node].
+func (m *Model) Run(ctx context.Context) (*Node, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return nil, err
+	}
+	return m.Selected(), nil
+}
+
+// RunAccessible lets the user descend the tree one level at a time instead of using the terminal UI: each level lists
+// the child nodes as numbered lines, and choosing a branch opens it. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	var current *Node
+	for {
+		nodes := m.roots
+		if current != nil {
+			if err := load(current); err != nil {
+				p.Println(fmt.Sprintf("Error: %v", err))
+				current = current.parent
+				continue
+			}
+			nodes = current.Children
+		}
+
+		var items []string
+		var targets []*Node
+		label := m.label
+		if current != nil {
+			label = strings.Join(current.Path(), "/")
+			if m.selectable == nil || m.selectable(current) {
+				items, targets = append(items, "(select "+current.Label+")"), append(targets, current)
+			}
+			items, targets = append(items, "(up)"), append(targets, nil)
+		}
+		for _, n := range nodes {
+			item := n.Label
+			if n.Branch() {
+				item += "/"
+			}
+			items, targets = append(items, item), append(targets, n)
+		}
+		if len(items) == 0 {
+			return errors.New("no nodes to select from")
+		}
+
+		i, err := p.Choice(label, items, 0)
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+
+		n := targets[i]
+		switch {
+		case n == nil:
+			current = current.parent
+		case n == current || !n.Branch():
+			if m.selectable != nil && !m.selectable(n) {
+				p.Println("Error: this node cannot be selected")
+				continue
+			}
+			m.Select(n)
+			m.canceled, m.quit = false, false
+			return nil
+		default:
+			current = n
+		}
+	}
+}
+
+// Showcase demonstrates the Model component with a static tree and lazily loaded children.
+func Showcase() {
+	fmt.Println("=== Tree Showcase ===")
+
+	lazy := func(prefix string, n int) func() ([]*Node, error) {
+		return func() ([]*Node, error) {
+			var nodes []*Node
+			for i := 1; i <= n; i++ {
+				nodes = append(nodes, &Node{Label: fmt.Sprintf("%s-%d", prefix, i)})
+			}
+			return nodes, nil
+		}
+	}
+	roots := []*Node{
+		{Label: "cluster", Expanded: true, Children: []*Node{
+			{Label: "default", Load: lazy("pod", 3)},
+			{Label: "kube-system", Load: lazy("coredns", 2)},
+			{Label: "monitoring", Children: []*Node{
+				{Label: "prometheus"},
+				{Label: "grafana"},
+			}},
+		}},
+	}
+
+	m := New("Select a resource (→ expands, ← collapses):", roots)
+	n, err := m.Run(context.Background())
+	if ui.Handle(err, ui.HandleOptions{}) == nil {
+		fmt.Printf("Selected: %s\n", strings.Join(n.Path(), "/"))
+	}
+}
@@ -0,0 +1,22 @@
+package ui
+
+import (
+	"io"
+	"os"
+
+	"github.com/charmbracelet/x/term" // Terminal detection
+)
+
+// terminalInput returns the input prompts read from. If the input was not set using WithInput and stdin is not a
+// terminal, e.g. because data is piped into the program, the controlling terminal is opened instead, so that the user
+// can still answer the prompts; the returned function closes it. Without a controlling terminal, stdin is used.
+func terminalInput(s *settings) (io.Reader, func()) {
+	if s.inputSet || term.IsTerminal(os.Stdin.Fd()) {
+		return s.input, func() {}
+	}
+	f, err := os.OpenFile(ttyPath, os.O_RDWR, 0)
+	if err != nil {
+		return s.input, func() {}
+	}
+	return f, func() { _ = f.Close() }
+}
@@ -0,0 +1,6 @@
+//go:build !windows
+
+package ui
+
+// ttyPath is the path of the controlling terminal of the process.
+const ttyPath = "/dev/tty"
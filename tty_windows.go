@@ -0,0 +1,6 @@
+//go:build windows
+
+package ui
+
+// ttyPath is the path of the console input of the process.
+const ttyPath = "CONIN$"
@@ -0,0 +1,23 @@
+//go:build windows
+
+package ui
+
+import (
+	"os"
+	"testing"
+)
+
+func TestLegacyOptionsConsoleInput(t *testing.T) {
+	f, err := os.OpenFile(ttyPath, os.O_RDWR, 0)
+	if err != nil {
+		t.Skipf("no console: %v", err)
+	}
+	defer f.Close()
+
+	SetLegacyConsole(true)
+	defer legacyMode.Store(legacyAuto)
+
+	if opts := legacyOptions(&settings{input: f}); opts != nil {
+		t.Errorf("console input %s: got %d options, want none", ttyPath, len(opts))
+	}
+}
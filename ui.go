@@ -1,7 +1,11 @@
 package ui
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"io"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
 )
@@ -12,19 +16,197 @@ var CanceledError = errors.New("canceled")
 // QuitError is returned when the user decided to quit the program.
 var QuitError = errors.New("quit")
 
+// TerminatedError is returned when the program received SIGINT or SIGTERM while a model was running. It wraps
+// QuitError, so errors.Is(err, QuitError) holds as well.
+var TerminatedError = fmt.Errorf("terminated: %w", QuitError)
+
+// TimeoutError is returned by RunContext when the deadline of the context expired before the model finished. It wraps
+// context.DeadlineExceeded, so errors.Is(err, context.DeadlineExceeded) holds as well.
+var TimeoutError = fmt.Errorf("timed out: %w", context.DeadlineExceeded)
+
+// StandardModel is implemented by all components. Canceled reports that the user canceled the prompt (esc by
+// default), Quit reports that the user requested to quit the whole program (ctrl+c by default, see QuitPolicy). At
+// most one of them is true.
 type StandardModel interface {
 	Canceled() bool
 	Quit() bool
 }
 
+// AccessibleModel is implemented by models supporting the accessible mode, in which the terminal UI is replaced by
+// simple line-based prompts reading from in and writing to out.
+type AccessibleModel interface {
+	RunAccessible(in io.Reader, out io.Writer) error
+}
+
+// Prompt is implemented by all components that ask the user for a value of type T. It allows higher-level code to
+// treat prompts uniformly, independent of the component used to answer them.
+type Prompt[T any] interface {
+	Run(ctx context.Context) (T, error)
+}
+
+// PromptFunc adapts an ordinary function to the Prompt interface.
+type PromptFunc[T any] func(ctx context.Context) (T, error)
+
+// Run calls f(ctx).
+func (f PromptFunc[T]) Run(ctx context.Context) (T, error) {
+	return f(ctx)
+}
+
+// Run runs the model in a new program with the given options. If the model implements StandardModel, its state is
+// translated into an error using ErrorOrValidate. SIGINT and SIGTERM are handled identically for all models: the
+// terminal is restored and TerminatedError is returned. In accessible mode, models implementing AccessibleModel are
+// run using line-based prompts instead. Models implementing AnswerableModel are not run at all if an answer was preset
+// for them. The model is run through the middleware added using Use. Prompts run from multiple goroutines are
+// serialized: Run waits until the terminal is no longer used by another prompt.
 func Run(m tea.Model, opts ...tea.ProgramOption) error {
-	_, err := tea.NewProgram(m, opts...).Run()
+	s := resolve(opts)
+	if s.err != nil {
+		return s.err
+	}
+	return withMiddleware(func(m tea.Model) error {
+		return runModel(m, s, opts)
+	})(m)
+}
+
+// runModel runs the model using the given settings, applying default and preset answers and recording events.
+func runModel(m tea.Model, s *settings, opts []tea.ProgramOption) error {
+	applyDefault(m, s.defaults)
+	applyTheme(m, s)
+	if s.plan != nil {
+		return s.plan.add(m, s)
+	}
+
+	var err error
+	start := time.Now()
+	_, isPrompt := m.(AnswerableModel)
+	key, preset := promptKey(m), false
+	if am, v, ok := presetAnswer(m, s); ok {
+		if err = am.SetAnswer(v); err != nil {
+			err = fmt.Errorf("%s: %w", key, err)
+		} else if s.echoAnswers {
+			fmt.Fprintln(s.output, answerSummary(am))
+		}
+		preset = true
+	} else {
+		if isPrompt {
+			record(Event{Type: EventShown, Key: key, Time: start})
+		}
+		s.memory = recall(m, s)
+		if err = run(m, s, opts); err == nil {
+			s.memory.save()
+		}
+	}
+	if isPrompt {
+		record(resultEvent(m, key, preset, start, err))
+	}
+	return err
+}
+
+// run runs the model either in a new program or, in accessible mode, using line-based prompts. Panics of the model
+// are returned as PanicError after the terminal was restored.
+func run(m tea.Model, s *settings, opts []tea.ProgramOption) error {
+	if err := acquireTerminal(s); err != nil {
+		return err
+	}
+	defer releaseTerminal(s)
+	in, closeInput := terminalInput(s)
+	defer closeInput()
+	if in != s.input {
+		s.input = in
+		opts = append(opts, tea.WithInput(in))
+	}
+
+	var err error
+	if am, ok := m.(AccessibleModel); ok && s.accessible {
+		detach := attachPrinters(s, nil)
+		err = am.RunAccessible(s.input, s.output)
+		detach()
+	} else {
+		sm, serr := newSafeModel(m, s)
+		if serr != nil {
+			return serr
+		}
+		opts = append(opts, legacyOptions(s)...)
+		p := tea.NewProgram(sm, append(opts, tea.WithoutSignalHandler())...)
+		sm.program = p
+		stop := handleSignals(p)
+		stopResize := forwardWindowSize(p, s)
+		detach, untrack := attachPrinters(s, p), track(p)
+		_, err = p.Run()
+		untrack()
+		detach()
+		stopResize()
+		if cerr := sm.close(); err == nil {
+			err = cerr
+		}
+		if stop() {
+			return TerminatedError
+		}
+		if perr := sm.panicErr(); perr != nil {
+			return perr
+		}
+	}
 	if m, ok := m.(StandardModel); ok {
 		err = ErrorOrValidate(err, m)
 	}
 	return err
 }
 
+// RunContext runs the model like Run, but aborts the program as soon as the given context is done, restoring the
+// terminal. If the deadline of the context expired, TimeoutError is returned, otherwise the error of the context, e.g.
+// context.Canceled. In accessible mode, the context is only checked before the model is run: a line-based prompt
+// waiting for input cannot be interrupted without losing the line read next.
+func RunContext(ctx context.Context, m tea.Model, opts ...tea.ProgramOption) error {
+	if ctx.Err() != nil {
+		return contextErr(ctx)
+	}
+	return contextResult(ctx, Run(m, contextOptions(ctx, opts)...))
+}
+
+// contextOptions returns the options with those aborting the program and waiting for the terminal when the context
+// is done.
+func contextOptions(ctx context.Context, opts []tea.ProgramOption) []tea.ProgramOption {
+	return append(opts[:len(opts):len(opts)], tea.WithContext(ctx), option(func(s *settings) {
+		s.ctx = ctx
+	}))
+}
+
+// contextResult returns the error of a run aborted by the done context as returned by contextErr, and err otherwise.
+func contextResult(ctx context.Context, err error) error {
+	if ctx.Err() != nil && (errors.Is(err, tea.ErrProgramKilled) || errors.Is(err, ctx.Err())) {
+		return contextErr(ctx)
+	}
+	return err
+}
+
+// contextErr returns the error of the done context, TimeoutError if its deadline expired.
+func contextErr(ctx context.Context) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return TimeoutError
+	}
+	return ctx.Err()
+}
+
+// promptKey returns the key identifying the model, or an empty string if the model does not provide one.
+func promptKey(m tea.Model) string {
+	if am, ok := m.(AnswerableModel); ok {
+		return am.Key()
+	}
+	return ""
+}
+
+// presetAnswer returns the preset answer for the model, if any.
+func presetAnswer(m tea.Model, s *settings) (AnswerableModel, any, bool) {
+	if am, ok := m.(AnswerableModel); ok {
+		if v, ok := lookupAnswer(s.answers, am.Key()); ok {
+			return am, v, true
+		}
+	}
+	return nil, nil, false
+}
+
+// ErrorOrValidate returns err if it is not nil, otherwise QuitError if quitting was requested, CanceledError if the
+// prompt was canceled and nil if it was answered.
 func ErrorOrValidate(err error, m StandardModel) error {
 	switch {
 	case err != nil:
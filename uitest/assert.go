@@ -0,0 +1,142 @@
+package uitest
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+)
+
+// eventually calls check until it returns true or the timeout expired, and returns the last result. Messages are
+// processed asynchronously, so the state may lag behind the keys sent.
+func (d *Driver) eventually(check func() bool) bool {
+	deadline := time.Now().Add(d.timeout)
+	for !check() {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(pollInterval)
+	}
+	return true
+}
+
+// WaitForText waits until the current frame contains the text, ignoring styles. The test fails with the last frame
+// if it does not appear in time.
+func (d *Driver) WaitForText(text string) {
+	d.t.Helper()
+	if !d.eventually(func() bool { return strings.Contains(d.Frame(), text) }) {
+		d.t.Fatalf("uitest: text %q did not appear within %s\n%s", text, d.timeout, d.describe())
+	}
+}
+
+// AssertFrame asserts that the current frame equals want, ignoring styles and trailing spaces. The frame is given
+// time to settle; on failure, the difference to the expected frame is reported line by line.
+func (d *Driver) AssertFrame(want string) {
+	d.t.Helper()
+	want = plainFrame(want)
+	if !d.eventually(func() bool { return d.Frame() == want }) {
+		d.t.Fatalf("uitest: frame differs (-want +got):\n%s", diff(want, d.Frame()))
+	}
+}
+
+// AssertCanceled waits until the model finished and asserts that it was canceled.
+func (d *Driver) AssertCanceled() {
+	d.t.Helper()
+	if err := d.Wait(); !errors.Is(err, ui.CanceledError) {
+		d.t.Fatalf("uitest: got error %v, want %v\n%s", err, ui.CanceledError, d.describe())
+	}
+}
+
+// AssertValue asserts that the answer of the model, which must implement ui.AnswerableModel, equals want, e.g. the
+// picked item or the entered text.
+func (d *Driver) AssertValue(want any) {
+	d.t.Helper()
+	var (
+		got        any
+		answerable bool
+	)
+	ok := d.eventually(func() bool {
+		d.Inspect(func(m tea.Model) {
+			var am ui.AnswerableModel
+			if am, answerable = m.(ui.AnswerableModel); answerable {
+				got = am.Answer()
+			}
+		})
+		return !answerable || reflect.DeepEqual(got, want)
+	})
+	switch {
+	case !answerable:
+		d.t.Fatalf("uitest: %T does not implement ui.AnswerableModel", d.finalModel())
+	case !ok:
+		d.t.Fatalf("uitest: got value %#v, want %#v\n%s", got, want, d.describe())
+	}
+}
+
+// AssertSelectedIndex asserts that the index of the selected item equals want. The model must have a SelectedIdx or
+// SelectedIndex method, like pick and table.
+func (d *Driver) AssertSelectedIndex(want int) {
+	d.t.Helper()
+	var (
+		got      int
+		selector bool
+	)
+	ok := d.eventually(func() bool {
+		d.Inspect(func(m tea.Model) {
+			selector = true
+			switch m := m.(type) {
+			case interface{ SelectedIdx() int }:
+				got = m.SelectedIdx()
+			case interface{ SelectedIndex() int }:
+				got = m.SelectedIndex()
+			default:
+				selector = false
+			}
+		})
+		return !selector || got == want
+	})
+	switch {
+	case !selector:
+		d.t.Fatalf("uitest: %T has no selected index", d.finalModel())
+	case !ok:
+		d.t.Fatalf("uitest: got selected index %d, want %d\n%s", got, want, d.describe())
+	}
+}
+
+// diff returns the line-based difference between the frames, marking lines missing from got with "-" and
+// unexpected lines with "+".
+func diff(want, got string) string {
+	a, b := strings.Split(want, "\n"), strings.Split(got, "\n")
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	var s strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			s.WriteString("    " + a[i] + "\n")
+			i, j = i+1, j+1
+		case j == len(b) || i < len(a) && lcs[i+1][j] >= lcs[i][j+1]:
+			s.WriteString("  - " + a[i] + "\n")
+			i++
+		default:
+			s.WriteString("  + " + b[j] + "\n")
+			j++
+		}
+	}
+	return strings.TrimRight(s.String(), "\n")
+}
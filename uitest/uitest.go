@@ -0,0 +1,256 @@
+// Package uitest drives components in tests: a Driver runs a model without a terminal, sends it keys like a user
+// and asserts on the rendered frames and the result, so that prompt flows can be covered by readable integration
+// tests:
+//
+//	func TestPickFruit(t *testing.T) {
+//		d := uitest.New(t, pick.New([]string{"Apple", "Banana", "Cherry"}))
+//		d.WaitForText("Apple")
+//		d.Press("down", "enter")
+//		d.AssertSelectedIndex(1)
+//		d.AssertValue("Banana")
+//	}
+//
+// Failing assertions stop the test and report the current frame, or a diff against the expected frame.
+package uitest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/charmbracelet/x/ansi"        // Measures and truncates text with escape sequences
+	"github.com/nmeilick/go-ui"
+)
+
+// pollInterval is how often WaitForText checks the current frame.
+const pollInterval = 5 * time.Millisecond
+
+// keyTypes maps the names of keys, e.g. "enter" or "ctrl+c", to their types.
+var keyTypes = func() map[string]tea.KeyType {
+	types := make(map[string]tea.KeyType)
+	for k := tea.KeyType(-256); k < 256; k++ {
+		if s := k.String(); s != "" && k != tea.KeyRunes {
+			types[s] = k
+		}
+	}
+	return types
+}()
+
+// Option configures a Driver.
+type Option func(*Driver)
+
+// Size sets the size of the simulated terminal. The default is 80x24.
+func Size(width, height int) Option {
+	return func(d *Driver) {
+		d.width, d.height = width, height
+	}
+}
+
+// Timeout sets how long the driver waits for text to appear and for the model to finish. The default is 2 seconds.
+func Timeout(timeout time.Duration) Option {
+	return func(d *Driver) {
+		d.timeout = timeout
+	}
+}
+
+// Driver runs a model in a program without a terminal and simulates the user. Messages are processed
+// asynchronously like in a real program, so assertions on frames should wait for the expected text first.
+type Driver struct {
+	t       testing.TB    // t receives the failures.
+	width   int           // width is the width of the simulated terminal.
+	height  int           // height is the height of the simulated terminal.
+	timeout time.Duration // timeout limits waiting for text and for the model to finish.
+	program *tea.Program  // program runs the model.
+	done    chan struct{} // done is closed when the program finished.
+
+	mu    sync.Mutex
+	model tea.Model // model is the current model, accessed by the program only while it runs.
+	frame string    // frame is the last view rendered by the model.
+	err   error     // err is the error the program finished with.
+}
+
+// New starts running the model and returns the driver simulating the user. The program is killed when the test
+// ends.
+func New(t testing.TB, m tea.Model, opts ...Option) *Driver {
+	t.Helper()
+	d := &Driver{t: t, width: 80, height: 24, timeout: 2 * time.Second, model: m, done: make(chan struct{})}
+	for _, opt := range opts {
+		opt(d)
+	}
+	d.program = tea.NewProgram(&driverModel{driver: d, model: m},
+		tea.WithInput(nil),
+		tea.WithOutput(io.Discard),
+		tea.WithoutRenderer(),
+		tea.WithoutSignalHandler())
+	go func() {
+		defer close(d.done)
+		_, err := d.program.Run()
+		d.mu.Lock()
+		d.err = err
+		d.mu.Unlock()
+	}()
+	d.program.Send(tea.WindowSizeMsg{Width: d.width, Height: d.height})
+	t.Cleanup(func() {
+		d.program.Kill()
+		<-d.done
+	})
+	return d
+}
+
+// Send sends the message to the model.
+func (d *Driver) Send(msg tea.Msg) {
+	d.program.Send(msg)
+}
+
+// Type types the text, one key per character.
+func (d *Driver) Type(text string) {
+	for _, r := range text {
+		d.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+// Press presses the keys with the given names, as used by key bindings, e.g. "enter", "down", "ctrl+c" or "alt+x".
+// Single characters are typed.
+func (d *Driver) Press(keys ...string) {
+	d.t.Helper()
+	for _, k := range keys {
+		msg, err := parseKey(k)
+		if err != nil {
+			d.t.Fatalf("uitest: %v", err)
+		}
+		d.Send(msg)
+	}
+}
+
+// parseKey returns the key message for the key with the given name.
+func parseKey(name string) (tea.KeyMsg, error) {
+	var msg tea.KeyMsg
+	if s, ok := strings.CutPrefix(name, "alt+"); ok && s != "" {
+		name, msg.Alt = s, true
+	}
+	if t, ok := keyTypes[name]; ok {
+		msg.Type = t
+		return msg, nil
+	}
+	if r := []rune(name); len(r) == 1 {
+		msg.Type, msg.Runes = tea.KeyRunes, r
+		return msg, nil
+	}
+	return msg, fmt.Errorf("unknown key: %s", name)
+}
+
+// Frame returns the last view rendered by the model without escape sequences and trailing spaces.
+func (d *Driver) Frame() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return plainFrame(d.frame)
+}
+
+// Inspect calls fn with the current model, e.g. for assertions not covered by this package. While the model is
+// running, fn is called between updates, so that it does not race with the program.
+func (d *Driver) Inspect(fn func(m tea.Model)) {
+	msg := inspectMsg{fn: fn, done: make(chan struct{})}
+	d.program.Send(msg)
+	select {
+	case <-msg.done:
+	case <-d.done:
+		select {
+		case <-msg.done:
+		default:
+			fn(d.finalModel())
+		}
+	}
+}
+
+// finalModel returns the model once the program finished.
+func (d *Driver) finalModel() tea.Model {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.model
+}
+
+// Wait waits until the model finished and returns the error Run would return for it, e.g. ui.CanceledError. The
+// test fails if the model does not finish in time.
+func (d *Driver) Wait() error {
+	d.t.Helper()
+	select {
+	case <-d.done:
+	case <-time.After(d.timeout):
+		d.t.Fatalf("uitest: model did not finish within %s\n%s", d.timeout, d.describe())
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	err := d.err
+	if errors.Is(err, tea.ErrProgramKilled) {
+		err = nil
+	}
+	if m, ok := d.model.(ui.StandardModel); ok {
+		err = ui.ErrorOrValidate(err, m)
+	}
+	return err
+}
+
+// describe returns the current frame for failure messages.
+func (d *Driver) describe() string {
+	return "frame:\n" + indent(d.Frame())
+}
+
+// plainFrame returns the view without escape sequences and trailing spaces.
+func plainFrame(view string) string {
+	lines := strings.Split(ansi.Strip(view), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+// indent indents the lines of s for failure messages.
+func indent(s string) string {
+	return "    " + strings.ReplaceAll(s, "\n", "\n    ")
+}
+
+// inspectMsg asks the driver model to call fn with the wrapped model.
+type inspectMsg struct {
+	fn   func(m tea.Model) // fn is called with the model.
+	done chan struct{}     // done is closed after fn returned.
+}
+
+// driverModel wraps the model run by a driver and records its state for the driver.
+type driverModel struct {
+	driver *Driver   // driver receives the state.
+	model  tea.Model // model is the wrapped model.
+}
+
+// Init initializes the wrapped model.
+func (m *driverModel) Init() tea.Cmd {
+	return m.model.Init()
+}
+
+// Update updates the wrapped model and records the result.
+func (m *driverModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(inspectMsg); ok {
+		msg.fn(m.model)
+		close(msg.done)
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.model, cmd = m.model.Update(msg)
+	m.driver.mu.Lock()
+	m.driver.model = m.model
+	m.driver.mu.Unlock()
+	return m, cmd
+}
+
+// View renders the wrapped model and records the frame.
+func (m *driverModel) View() string {
+	v := m.model.View()
+	m.driver.mu.Lock()
+	m.driver.frame = v
+	m.driver.mu.Unlock()
+	return v
+}
@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Validator validates values of type T. Components accepting validators show the returned error to the user and
+// refuse to finish until the value is valid.
+type Validator[T any] interface {
+	Validate(v T) error
+}
+
+// ValidatorFunc adapts an ordinary function to the Validator interface.
+type ValidatorFunc[T any] func(v T) error
+
+// Validate calls f(v).
+func (f ValidatorFunc[T]) Validate(v T) error {
+	return f(v)
+}
+
+// All returns a validator that passes if all given validators pass. It returns the first error encountered.
+func All[T any](validators ...Validator[T]) Validator[T] {
+	return ValidatorFunc[T](func(v T) error {
+		for _, validator := range validators {
+			if err := validator.Validate(v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Any returns a validator that passes if at least one of the given validators passes. Otherwise, the errors of all
+// validators are returned.
+func Any[T any](validators ...Validator[T]) Validator[T] {
+	return ValidatorFunc[T](func(v T) error {
+		var errs []error
+		for _, validator := range validators {
+			err := validator.Validate(v)
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, err)
+		}
+		return errors.Join(errs...)
+	})
+}
+
+// Optional returns a validator that accepts the zero value and passes all other values to the given validator.
+func Optional[T comparable](validator Validator[T]) Validator[T] {
+	return ValidatorFunc[T](func(v T) error {
+		var zero T
+		if v == zero {
+			return nil
+		}
+		return validator.Validate(v)
+	})
+}
+
+// NotEmpty returns a validator rejecting empty or whitespace-only strings.
+func NotEmpty() Validator[string] {
+	return ValidatorFunc[string](func(v string) error {
+		if strings.TrimSpace(v) == "" {
+			return errors.New("a value is required")
+		}
+		return nil
+	})
+}
+
+// MinLength returns a validator rejecting strings with less than n characters.
+func MinLength(n int) Validator[string] {
+	return ValidatorFunc[string](func(v string) error {
+		if utf8.RuneCountInString(v) < n {
+			return fmt.Errorf("at least %d characters are required", n)
+		}
+		return nil
+	})
+}
+
+// MaxLength returns a validator rejecting strings with more than n characters.
+func MaxLength(n int) Validator[string] {
+	return ValidatorFunc[string](func(v string) error {
+		if utf8.RuneCountInString(v) > n {
+			return fmt.Errorf("at most %d characters are allowed", n)
+		}
+		return nil
+	})
+}
+
+// Matches returns a validator rejecting strings not matching the regular expression with the given message.
+func Matches(re *regexp.Regexp, msg string) Validator[string] {
+	return ValidatorFunc[string](func(v string) error {
+		if !re.MatchString(v) {
+			return errors.New(msg)
+		}
+		return nil
+	})
+}
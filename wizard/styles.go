@@ -0,0 +1,44 @@
+package wizard
+
+import (
+	"github.com/charmbracelet/lipgloss" // Styles terminal UI components
+	"github.com/nmeilick/go-ui"
+)
+
+// Styles holds the styles of the model.
+type Styles struct {
+	Title       lipgloss.Style // Title is the style of the title of the wizard.
+	Progress    lipgloss.Style // Progress is the style of the step indicator, e.g. "Step 2 of 5".
+	Step        lipgloss.Style // Step is the style of the titles of the steps.
+	Description lipgloss.Style // Description is the style of the descriptions of the steps.
+	Key         lipgloss.Style // Key is the style of the field titles on the review page.
+	Value       lipgloss.Style // Value is the style of the values on the review page.
+	Help        lipgloss.Style // Help is the style of the key help below the wizard.
+	Error       lipgloss.Style // Error is the style of the error shown below the wizard.
+}
+
+// DefaultStyles returns the default styles, which use the colors of the current theme, see ui.CurrentTheme.
+func DefaultStyles() Styles {
+	return ThemedStyles(ui.CurrentTheme())
+}
+
+// ThemedStyles returns the default styles in the colors of the given theme.
+func ThemedStyles(t ui.Theme) Styles {
+	return Styles{
+		Title:       lipgloss.NewStyle().Foreground(t.Label).Bold(true).Underline(true),
+		Progress:    lipgloss.NewStyle().Faint(true),
+		Step:        lipgloss.NewStyle().Foreground(t.Accent).Bold(true),
+		Description: lipgloss.NewStyle().Faint(true),
+		Key:         lipgloss.NewStyle().Foreground(t.Label),
+		Value:       lipgloss.NewStyle().Foreground(t.Text),
+		Help:        lipgloss.NewStyle().Faint(true),
+		Error:       t.ErrorStyle(),
+	}
+}
+
+// restyle rebuilds the default styles from the theme, unless styles were set explicitly.
+func (m *Model) restyle(t ui.Theme) {
+	if !m.customStyles {
+		m.styles = ThemedStyles(t)
+	}
+}
@@ -0,0 +1,623 @@
+package wizard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"   // Manages key bindings
+	tea "github.com/charmbracelet/bubbletea" // Framework for building terminal applications
+	"github.com/nmeilick/go-ui"
+	"github.com/nmeilick/go-ui/form"
+	"github.com/nmeilick/go-ui/internal/plain"
+)
+
+var _ ui.Prompt[ui.Results] = (*Model)(nil)
+
+// Step is a page of the wizard, asking for the values of its fields like a form.
+type Step struct {
+	Title       string        // Title is shown above the fields of the step.
+	Description string        // Description is shown below the title, if set.
+	Fields      []*form.Field // Fields are the fields of the step.
+
+	// When reports whether the step is shown, given the answers of the previous steps. If nil, it is always shown.
+	When func(r ui.Results) bool
+}
+
+// Model represents a wizard guiding through a sequence of steps, each asking for several values. Steps can be
+// skipped depending on earlier answers, and all answers are listed on a review page before they are confirmed. All
+// steps run within a single program, so there is no flicker between them.
+type Model struct {
+	title          string              // title is shown above the steps.
+	steps          []Step              // steps are the steps of the wizard.
+	forms          []*form.Model       // forms hold the fields of each step.
+	current        int                 // current is the index of the shown step, len(steps) for the review page.
+	history        []int               // history holds the indexes of the previously shown steps, for going back.
+	review         bool                // review determines if the review page is shown after the last step.
+	showHelp       bool                // showHelp determines if the key help is shown below the wizard.
+	cancelable     bool                // cancelable determines if the wizard can be canceled with escape key
+	quitable       bool                // quitable determines if execution can be quit via ctrl+c
+	programOptions []tea.ProgramOption // programOptions are passed to the program running the model
+	id             string              // id identifies the prompt, e.g. for preset answers
+	embedded       bool                // embedded determines if a DoneMsg is emitted instead of quitting the program
+	focused        bool                // focused determines if the model handles key messages
+	keymap         ui.KeyMap           // keymap holds the key bindings of the review page and the key going back.
+	styles         Styles              // styles holds the styles of the model.
+	customStyles   bool                // customStyles is set if styles were set explicitly, which a theme switch keeps
+	err            error               // err is shown below the model, e.g. why the previous answer was rejected
+
+	canceled bool // canceled indicates whether the wizard was canceled
+	quit     bool // quit indicates whether the wizard was quit
+}
+
+// New creates and returns a new Model with the given title and steps, configured by the given options.
+func New(title string, steps []Step, opts ...Option) *Model {
+	m := &Model{
+		title:      title,
+		steps:      steps,
+		review:     true,
+		showHelp:   ui.HelpShown(),
+		cancelable: true,
+		quitable:   true,
+		focused:    true,
+		keymap:     ui.DefaultKeyMap(),
+		styles:     DefaultStyles(),
+
+		canceled: false,
+		quit:     false,
+	}
+	for _, s := range steps {
+		groups := []form.Group{{Fields: s.Fields}}
+		m.forms = append(m.forms, form.NewGroups("", groups, form.Embedded(), form.ShowHelp(false)))
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithTitle sets the title of the Model and returns a new Model with the updated title.
+func (m *Model) WithTitle(title string) *Model {
+	return m.With(Title(title))
+}
+
+// WithReview sets whether the review page is shown after the last step and returns a new Model with the updated flag.
+func (m *Model) WithReview(show bool) *Model {
+	return m.With(Review(show))
+}
+
+// WithShowHelp sets whether the key help is shown below the wizard and returns a new Model with the updated flag.
+func (m *Model) WithShowHelp(show bool) *Model {
+	return m.With(ShowHelp(show))
+}
+
+// WithCancel sets the cancelable flag and returns a new Model with the updated flag.
+func (m *Model) WithCancel(cancelable bool) *Model {
+	return m.With(Cancel(cancelable))
+}
+
+// WithQuit sets the quitable flag and returns a new Model with the updated flag.
+func (m *Model) WithQuit(quitable bool) *Model {
+	return m.With(Quit(quitable))
+}
+
+// WithProgramOptions sets the options passed to the program running the model and returns a new Model with the
+// updated options.
+func (m *Model) WithProgramOptions(opts ...tea.ProgramOption) *Model {
+	return m.With(ProgramOptions(opts...))
+}
+
+// WithID sets the ID identifying the prompt, e.g. for preset answers, and returns a new Model with the updated ID. If
+// no ID is set, the title is used instead.
+func (m *Model) WithID(id string) *Model {
+	return m.With(ID(id))
+}
+
+// WithEmbedded sets whether the model is embedded in another model and returns a new Model with the updated flag. In
+// embedded mode, a DoneMsg is emitted instead of quitting the program when the user finished the model.
+func (m *Model) WithEmbedded(embedded bool) *Model {
+	newModel := *m
+	newModel.embedded = embedded
+	return &newModel
+}
+
+// WithKeyMap sets the key bindings of the review page, overriding the default key map, and returns a new Model with
+// the updated bindings.
+func (m *Model) WithKeyMap(km ui.KeyMap) *Model {
+	return m.With(KeyMap(km))
+}
+
+// WithStyles sets all styles of the model and returns a new Model with the updated styles.
+func (m *Model) WithStyles(styles Styles) *Model {
+	return m.With(Styled(styles))
+}
+
+// Styles returns the styles of the model.
+func (m *Model) Styles() Styles {
+	return m.styles
+}
+
+// walk returns the indexes of the steps that are shown given the current answers, and the values of their fields.
+func (m *Model) walk() ([]int, ui.Results) {
+	var shown []int
+	results := ui.Results{}
+	for i, s := range m.steps {
+		if s.When != nil && !s.When(results) {
+			continue
+		}
+		shown = append(shown, i)
+		for k, v := range m.forms[i].Results() {
+			results[k] = v
+		}
+	}
+	return shown, results
+}
+
+// Results returns the typed values of the fields of all steps that are shown given the answers, keyed by field.
+func (m *Model) Results() ui.Results {
+	_, results := m.walk()
+	return results
+}
+
+// Decode stores the results in the struct pointed to by dst, see form.Model.Decode.
+func (m *Model) Decode(dst any) error {
+	return form.DecodeResults(m.Results(), dst)
+}
+
+// field returns the field with the given key and the index of its step, or nil and -1 if there is none.
+func (m *Model) field(key string) (*form.Field, int) {
+	for i, f := range m.forms {
+		if field := f.Field(key); field != nil {
+			return field, i
+		}
+	}
+	return nil, -1
+}
+
+// Key returns the ID of the prompt, or its title if no ID is set. It implements ui.AnswerableModel.
+func (m *Model) Key() string {
+	if m.id != "" {
+		return m.id
+	}
+	return m.title
+}
+
+// SetAnswer applies a preset answer, which is a map of field keys to values accepted by the components of the
+// fields, regardless of the step they belong to. Fields not in the map keep their value. It implements
+// ui.AnswerableModel.
+func (m *Model) SetAnswer(v any) error {
+	answers, ok := v.(map[string]any)
+	if !ok {
+		if results, isResults := v.(ui.Results); isResults {
+			answers, ok = results, true
+		}
+	}
+	if !ok {
+		return fmt.Errorf("invalid answer: %v", v)
+	}
+	perStep := make([]map[string]any, len(m.steps))
+	for k, v := range answers {
+		_, i := m.field(k)
+		if i < 0 {
+			return fmt.Errorf("unknown field: %s", k)
+		}
+		if perStep[i] == nil {
+			perStep[i] = map[string]any{}
+		}
+		perStep[i][k] = v
+	}
+	for i, a := range perStep {
+		if a == nil {
+			continue
+		}
+		if err := m.forms[i].SetAnswer(a); err != nil {
+			return err
+		}
+	}
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Answer returns the answers of the components of the fields of all shown steps, keyed by field. It implements
+// ui.AnswerableModel.
+func (m *Model) Answer() any {
+	answers := map[string]any{}
+	shown, _ := m.walk()
+	for _, i := range shown {
+		for k, v := range m.forms[i].Answer().(map[string]any) {
+			answers[k] = v
+		}
+	}
+	return answers
+}
+
+// Focus focuses the model and its current step, and returns the command of the step.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	if m.current < len(m.forms) {
+		return m.forms[m.current].Focus()
+	}
+	return nil
+}
+
+// Blur removes the focus from the model and its current step.
+func (m *Model) Blur() {
+	m.focused = false
+	if m.current < len(m.forms) {
+		m.forms[m.current].Blur()
+	}
+}
+
+// Focused returns whether the model has the focus.
+func (m *Model) Focused() bool {
+	return m.focused
+}
+
+// SetError sets an error shown below the model, e.g. why the previous answer was rejected. It implements
+// ui.ErrorSetter.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Canceled returns the canceled flag.
+func (m *Model) Canceled() bool {
+	return m.canceled
+}
+
+// Quit returns the quit flag.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// DoneMsg is emitted in embedded mode instead of quitting the program when the user finished the model. Use the
+// model's Canceled and Quit methods to determine how it was finished.
+type DoneMsg struct {
+	Model *Model // Model is the finished model.
+}
+
+// done returns the command finishing the model: tea.Quit, or a command emitting a DoneMsg in embedded mode.
+func (m *Model) done() tea.Cmd {
+	if m.embedded {
+		return func() tea.Msg { return DoneMsg{Model: m} }
+	}
+	return tea.Quit
+}
+
+// Init shows the first step that is shown given the answers and returns its command.
+func (m *Model) Init() tea.Cmd {
+	m.current, m.history = len(m.steps), nil
+	if shown, _ := m.walk(); len(shown) > 0 {
+		m.current = shown[0]
+	}
+	return m.enter()
+}
+
+// enter initializes and focuses the current step.
+func (m *Model) enter() tea.Cmd {
+	if m.current >= len(m.forms) {
+		return nil
+	}
+	f := m.forms[m.current]
+	f.Blur()
+	cmd := f.Init()
+	if !m.focused {
+		return cmd
+	}
+	return tea.Batch(cmd, f.Focus())
+}
+
+// leave blurs the current step.
+func (m *Model) leave() {
+	if m.current < len(m.forms) {
+		m.forms[m.current].Blur()
+	}
+}
+
+// next moves to the next step that is shown given the answers, or to the review page after the last step. Without
+// review page, the wizard is finished instead.
+func (m *Model) next() tea.Cmd {
+	shown, _ := m.walk()
+	next := len(m.steps)
+	for _, i := range shown {
+		if i > m.current {
+			next = i
+			break
+		}
+	}
+	if next == len(m.steps) && !m.review {
+		return m.finish()
+	}
+	m.leave()
+	m.history = append(m.history, m.current)
+	m.current = next
+	return m.enter()
+}
+
+// back moves to the previously shown step, keeping its answers.
+func (m *Model) back() tea.Cmd {
+	if len(m.history) == 0 {
+		return nil
+	}
+	m.leave()
+	m.current = m.history[len(m.history)-1]
+	m.history = m.history[:len(m.history)-1]
+	return m.enter()
+}
+
+// finish finishes the wizard successfully.
+func (m *Model) finish() tea.Cmd {
+	m.canceled, m.quit = false, false
+	return m.done()
+}
+
+// finishStep handles a finished step: cancelation and quitting are passed on to the wizard, otherwise the next step
+// is shown.
+func (m *Model) finishStep(f *form.Model) tea.Cmd {
+	switch {
+	case f.Quit():
+		if m.quitable {
+			m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+			return m.done()
+		}
+		return m.enter()
+	case f.Canceled():
+		if m.cancelable {
+			m.canceled, m.quit = true, false
+			return m.done()
+		}
+		return m.enter()
+	}
+	return m.next()
+}
+
+// Update handles the Back key (shift+tab by default) to go back to the previous step, unless the step uses it to move
+// to its previous field, and the keys of the review page, and passes all other messages to the current step. Window
+// size and theme messages are passed to all steps.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ui.ThemeMsg); ok {
+		m.restyle(msg.Theme)
+		var cmds []tea.Cmd
+		for _, f := range m.forms {
+			_, cmd := f.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+		return m, tea.Batch(cmds...)
+	}
+	switch msg := msg.(type) {
+	case form.DoneMsg:
+		if m.current < len(m.forms) && msg.Model == m.forms[m.current] {
+			return m, m.finishStep(msg.Model)
+		}
+		return m, nil
+	case tea.WindowSizeMsg:
+		var cmds []tea.Cmd
+		for _, f := range m.forms {
+			_, cmd := f.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+		return m, tea.Batch(cmds...)
+	case tea.KeyMsg:
+		if !m.focused {
+			return m, nil
+		}
+		if key.Matches(msg, m.keymap.Back) && (m.current >= len(m.forms) || !m.forms[m.current].UsesKey(msg)) {
+			return m, m.back()
+		}
+		if m.current == len(m.steps) {
+			switch {
+			case key.Matches(msg, m.keymap.Confirm):
+				return m, m.finish()
+			case key.Matches(msg, m.keymap.Cancel):
+				if m.cancelable {
+					m.canceled, m.quit = true, false
+					return m, m.done()
+				}
+			case key.Matches(msg, m.keymap.Quit):
+				if m.quitable {
+					m.canceled, m.quit = ui.DefaultQuitPolicy().Flags()
+					return m, m.done()
+				}
+			}
+			return m, nil
+		}
+	}
+
+	if m.current >= len(m.forms) {
+		return m, nil
+	}
+	_, cmd := m.forms[m.current].Update(msg)
+	return m, cmd
+}
+
+// formatValue formats a value for the review page.
+func formatValue(f *form.Field, v any) string {
+	if f.Masked() {
+		return "********"
+	}
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return "yes"
+		}
+		return "no"
+	case []string:
+		if len(v) == 0 {
+			return "-"
+		}
+		return strings.Join(v, ", ")
+	case string:
+		if v == "" {
+			return "-"
+		}
+		return v
+	}
+	return fmt.Sprint(v)
+}
+
+// summary returns the lines of the review page, listing the answers of all shown steps.
+func (m *Model) summary(shown []int, render bool) []string {
+	var lines []string
+	style := func(s string, st func(...string) string) string {
+		if render {
+			return st(s)
+		}
+		return s
+	}
+	for _, i := range shown {
+		if title := m.steps[i].Title; title != "" {
+			lines = append(lines, style(title, m.styles.Step.Render))
+		}
+		for _, f := range m.forms[i].Fields() {
+			lines = append(lines, fmt.Sprintf("  %s %s", style(f.Title()+":", m.styles.Key.Render),
+				style(formatValue(f, f.Value()), m.styles.Value.Render)))
+		}
+	}
+	return lines
+}
+
+// View renders the title, the step indicator and the current step, or the review page after the last step.
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.title != "" {
+		fmt.Fprintf(&b, "%s\n", m.styles.Title.Render(m.title))
+	}
+
+	shown, _ := m.walk()
+	back := m.keymap.Back.Help().Key
+	help := "enter next · " + back + " back · tab next field · esc cancel"
+	if m.current == len(m.steps) {
+		fmt.Fprintf(&b, "%s\n\n", m.styles.Progress.Render("Review"))
+		for _, line := range m.summary(shown, true) {
+			fmt.Fprintf(&b, "%s\n", line)
+		}
+		help = "enter confirm · " + back + " back · esc cancel"
+	} else {
+		step := m.steps[m.current]
+		progress := fmt.Sprintf("Step %d of %d", slices.Index(shown, m.current)+1, len(shown))
+		if step.Title != "" {
+			progress = fmt.Sprintf("%s %s", m.styles.Progress.Render(progress+" ·"), m.styles.Step.Render(step.Title))
+		} else {
+			progress = m.styles.Progress.Render(progress)
+		}
+		fmt.Fprintf(&b, "%s\n", progress)
+		if step.Description != "" {
+			fmt.Fprintf(&b, "%s\n", m.styles.Description.Render(step.Description))
+		}
+		fmt.Fprintf(&b, "%s\n", m.forms[m.current].View())
+	}
+
+	if m.err != nil {
+		fmt.Fprintf(&b, "\n%s\n", ui.RenderErrorWith(m.styles.Error, m.err))
+	}
+	if m.showHelp {
+		fmt.Fprintf(&b, "\n%s\n", m.styles.Help.Render(help))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// Run runs the model and returns the typed values of the fields of all shown steps. It implements
+// ui.Prompt[ui.Results].
+func (m *Model) Run(ctx context.Context) (ui.Results, error) {
+	if err := ui.RunContext(ctx, m, m.programOptions...); err != nil {
+		return nil, err
+	}
+	return m.Results(), nil
+}
+
+// RunAccessible asks for the fields of the shown steps one after another using line-based prompts instead of the
+// terminal UI, followed by a summary that has to be confirmed. If it is not confirmed, the steps are asked again
+// with the previous answers as defaults. It implements ui.AccessibleModel.
+func (m *Model) RunAccessible(in io.Reader, out io.Writer) error {
+	p := plain.New(in, out)
+	if m.title != "" {
+		p.Println(m.title)
+	}
+	for {
+		for i, s := range m.steps {
+			shown, _ := m.walk()
+			pos := slices.Index(shown, i)
+			if pos < 0 {
+				continue
+			}
+			header := fmt.Sprintf("Step %d of %d", pos+1, len(shown))
+			if s.Title != "" {
+				header += ": " + s.Title
+			}
+			p.Println("\n" + header)
+			if s.Description != "" {
+				p.Println(s.Description)
+			}
+			f := m.forms[i]
+			if err := f.RunAccessible(in, out); err != nil {
+				return err
+			}
+			if f.Canceled() || f.Quit() {
+				m.canceled, m.quit = f.Canceled(), f.Quit()
+				return nil
+			}
+		}
+		if !m.review {
+			break
+		}
+
+		shown, _ := m.walk()
+		p.Println("\nReview")
+		for _, line := range m.summary(shown, false) {
+			p.Println(line)
+		}
+		s, err := p.Line("Confirm? (y/n) ", "y")
+		switch {
+		case errors.Is(err, io.EOF):
+			m.canceled, m.quit = true, false
+			return nil
+		case err != nil:
+			return err
+		}
+		if s = strings.ToLower(strings.TrimSpace(s)); s == "y" || s == "yes" {
+			break
+		}
+	}
+	m.canceled, m.quit = false, false
+	return nil
+}
+
+// Showcase demonstrates the Model component with a conditional step and the review page.
+func Showcase() {
+	fmt.Println("=== Wizard Showcase ===")
+
+	var cfg struct {
+		Name     string
+		Platform string
+		Image    string
+		CPUs     int
+		Start    bool
+	}
+
+	m := New("Deploy an application", []Step{
+		{Title: "Application", Fields: []*form.Field{
+			form.Text("name", "Name").Validate(form.Check(ui.NotEmpty())),
+			form.Select("platform", "Platform", "container", "vm"),
+		}},
+		{Title: "Container", Description: "Settings of the container", Fields: []*form.Field{
+			form.Text("image", "Image").Default("nginx:latest").Validate(form.Check(ui.NotEmpty())),
+		}, When: func(r ui.Results) bool { return r["platform"] == "container" }},
+		{Title: "Virtual machine", Description: "Settings of the virtual machine", Fields: []*form.Field{
+			form.Int("cpus", "CPUs").Default(2),
+		}, When: func(r ui.Results) bool { return r["platform"] == "vm" }},
+		{Title: "Finish", Fields: []*form.Field{
+			form.Confirm("start", "Start after deployment?"),
+		}},
+	})
+	if _, err := m.Run(context.Background()); ui.Handle(err, ui.HandleOptions{}) != nil {
+		return
+	}
+	if err := m.Decode(&cfg); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Name: %s, platform: %s, image: %s, CPUs: %d, start: %t\n",
+		cfg.Name, cfg.Platform, cfg.Image, cfg.CPUs, cfg.Start)
+}